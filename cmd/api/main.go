@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	ohttp "net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
-	cpubsub "cloud.google.com/go/pubsub"
 	"github.com/aviseu/jobs-backoffice/internal/app/application/http"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/blocking"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/configuring"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/dispatching"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
-	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/messaging"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
 	"github.com/caarlos0/env/v11"
@@ -22,14 +26,12 @@ import (
 )
 
 type config struct {
-	PubSub struct {
-		ProjectID     string        `env:"PROJECT_ID" required:"true"`
-		ImportTopicID string        `env:"IMPORT_TOPIC_ID,required"`
-		Client        pubsub.Config `envPrefix:"CLIENT"`
-	} `envPrefix:"PUBSUB_"`
-	DB  storage.Config `envPrefix:"DB_"`
-	API http.Config    `envPrefix:"API_"`
-	Log struct {
+	Broker   messaging.Config   `envPrefix:"BROKER_"`
+	DB       storage.Config     `envPrefix:"DB_"`
+	API      http.Config        `envPrefix:"API_"`
+	Gateway  importing.Config   `envPrefix:"GATEWAY_"`
+	Dispatch dispatching.Config `envPrefix:"DISPATCH_"`
+	Log      struct {
 		Level slog.Level `env:"LEVEL" envDefault:"info"`
 	} `envPrefix:"LOG_"`
 }
@@ -75,23 +77,58 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
 
-	// pubsub
-	client, err := cpubsub.NewClient(ctx, cfg.PubSub.ProjectID)
+	// broker
+	b, err := messaging.SetupBroker(ctx, cfg.Broker)
 	if err != nil {
-		return fmt.Errorf("failed to build pubsub client for project %s: %w", cfg.PubSub.ProjectID, err)
+		return fmt.Errorf("failed to setup %s broker: %w", cfg.Broker.Kind, err)
 	}
-
-	pis := pubsub.NewImportService(client.Topic(cfg.PubSub.ImportTopicID), cfg.PubSub.Client)
+	defer func(b broker.Broker) {
+		err := b.Close()
+		if err != nil {
+			slog.Error(fmt.Errorf("failed to close broker: %w", err).Error())
+		}
+	}(b)
+	ip, err := b.Publisher(ctx, cfg.Broker.ImportTopic)
+	if err != nil {
+		return fmt.Errorf("failed to setup publisher for topic %s: %w", cfg.Broker.ImportTopic, err)
+	}
+	pis := broker.NewImportService(ip)
+	jp, err := b.Publisher(ctx, cfg.Broker.JobTopic)
+	if err != nil {
+		return fmt.Errorf("failed to setup publisher for topic %s: %w", cfg.Broker.JobTopic, err)
+	}
+	pjs := broker.NewJobService(jp)
 
 	// services
 	slog.Info("setting up services...")
 	chr := postgres.NewChannelRepository(db)
-	chs := configuring.NewService(chr)
 	ir := postgres.NewImportRepository(db)
-	ss := scheduling.NewService(ir, chr, pis, log)
+	jr := postgres.NewJobRepository(db)
+	br := postgres.NewBlocklistRepository(db)
+	lr := postgres.NewLinkRepository(db)
+	ler := postgres.NewLeaseRepository(db)
+	rr := postgres.NewScheduleRunRepository(db)
+
+	var ps scheduling.PubSubService
+	switch cfg.Dispatch.Mode {
+	case dispatching.ModeBroker:
+		ps = pis
+	case dispatching.ModeQueue:
+		ps = dispatching.NewQueue(ir, chr, postgres.NewImportQueueRepository(db))
+	default:
+		return fmt.Errorf("unknown dispatch mode %s", cfg.Dispatch.Mode)
+	}
+
+	ss := scheduling.NewService(ir, chr, rr, ps, scheduling.ServiceConfig{Workers: 1}, log)
+	bls := blocking.NewService(br, jr, pjs, log)
+	ips := importing.NewPreviewService(chr, jr, br, lr, ohttp.DefaultClient, cfg.Gateway, log)
+	for name, e := range importing.BuiltinEnrichers() {
+		ips.RegisterEnricher(name, e)
+	}
+	chs := configuring.NewService(chr, ips)
 
 	// start server
-	server := http.SetupServer(ctx, cfg.API, http.APIRootHandler(chs, chr, ir, ss, cfg.API, log))
+	server := http.SetupServer(ctx, cfg.API, http.APIRootHandler(chs, chr, ir, jr, ss, ips, bls, br, ler, rr, cfg.API, log))
 	serverErrors := make(chan error, 1)
 	go func() {
 		slog.Info("starting server...")
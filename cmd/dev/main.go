@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	ohttp "net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/application/http"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/blocking"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/configuring"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/memory"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/sink"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/filesystem"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
+	"github.com/caarlos0/env/v11"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+const (
+	importTopic        = "imports"
+	importSubscription = "dev"
+	jobTopic           = "jobs"
+)
+
+type config struct {
+	DB       storage.Config           `envPrefix:"DB_"`
+	API      http.Config              `envPrefix:"API_"`
+	Gateway  importing.Config         `envPrefix:"GATEWAY_"`
+	Archive  filesystem.Config        `envPrefix:"ARCHIVE_"`
+	Import   broker.SubscriberConfig  `envPrefix:"IMPORT_"`
+	Sink     sink.Config              `envPrefix:"SINK_"`
+	Schedule scheduling.ServiceConfig `envPrefix:"SCHEDULE_"`
+	Daemon   struct {
+		Enabled  bool              `env:"ENABLED" envDefault:"true"`
+		Schedule scheduling.Config `envPrefix:"SCHEDULE_"`
+	} `envPrefix:"DAEMON_"`
+	Log struct {
+		Level slog.Level `env:"LEVEL" envDefault:"info"`
+	} `envPrefix:"LOG_"`
+}
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{})))
+
+	if err := run(context.Background()); err != nil {
+		slog.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+// run wires the api, the scheduler and the importer together in a single process. Import commands go through
+// an in-memory queue and job events are written to a file or stdout, so Postgres is the only dependency.
+func run(ctx context.Context) error {
+	// load environment variables
+	slog.Info("loading environment variables...")
+	var cfg config
+	if err := env.Parse(&cfg); err != nil {
+		return fmt.Errorf("failed to load environment variables: %w", err)
+	}
+
+	// configure logging
+	slog.Info("configuring logging...")
+	log := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: cfg.Log.Level}))
+	slog.SetDefault(log)
+
+	// setup database
+	slog.Info("setting up database...")
+	db, err := storage.SetupDatabase(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("failed to setup database: %w", err)
+	}
+	defer func(db *sqlx.DB) {
+		err := db.Close()
+		if err != nil {
+			slog.Error(fmt.Errorf("failed to close database connection: %w", err).Error())
+		}
+	}(db)
+
+	// migrate db
+	slog.Info("migrating database...")
+	if err := storage.MigrateDB(db); err != nil {
+		return fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	// Imports are queued in memory, imports still queued when the process stops are marked as failed on the next start
+	b := memory.NewBroker(cfg.Import)
+	ip, err := b.Publisher(ctx, importTopic)
+	if err != nil {
+		return fmt.Errorf("failed to setup publisher for topic %s: %w", importTopic, err)
+	}
+	pis := broker.NewImportService(ip)
+	sub, err := b.Subscriber(ctx, importTopic, importSubscription)
+	if err != nil {
+		return fmt.Errorf("failed to setup subscriber %s: %w", importSubscription, err)
+	}
+
+	// job events
+	var w io.Writer = os.Stdout
+	if cfg.Sink.File != "" {
+		f, err := os.OpenFile(cfg.Sink.File, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open sink file %s: %w", cfg.Sink.File, err)
+		}
+		defer func(f *os.File) {
+			err := f.Close()
+			if err != nil {
+				slog.Error(fmt.Errorf("failed to close sink file: %w", err).Error())
+			}
+		}(f)
+		w = f
+	}
+	pjs := broker.NewJobService(sink.NewPublisher(w, jobTopic))
+
+	// services
+	slog.Info("setting up services...")
+	chr := postgres.NewChannelRepository(db)
+	ir := postgres.NewImportRepository(db)
+	jr := postgres.NewJobRepository(db)
+	br := postgres.NewBlocklistRepository(db)
+	lr := postgres.NewLinkRepository(db)
+	ler := postgres.NewLeaseRepository(db)
+	bs := filesystem.NewBlobStore(cfg.Archive)
+	rr := postgres.NewScheduleRunRepository(db)
+
+	ss := scheduling.NewService(ir, chr, rr, pis, cfg.Schedule, log)
+	bls := blocking.NewService(br, jr, pjs, log)
+	is := importing.NewService(chr, ir, jr, br, lr, ohttp.DefaultClient, cfg.Gateway, pjs, bs, log)
+	ips := importing.NewPreviewService(chr, jr, br, lr, ohttp.DefaultClient, cfg.Gateway, log)
+	for name, e := range importing.BuiltinEnrichers() {
+		is.RegisterEnricher(name, e)
+		ips.RegisterEnricher(name, e)
+	}
+	chs := configuring.NewService(chr, is)
+
+	// Nothing else runs the imports of this process, so imports left unfinished by a previous run never finish
+	failed, err := is.FailInterrupted(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fail interrupted imports: %w", err)
+	}
+	if failed > 0 {
+		slog.Info(fmt.Sprintf("marked %d imports interrupted by the previous run as failed", failed))
+	}
+
+	// start importer
+	receiveCtx, stopReceive := context.WithCancel(ctx)
+	defer stopReceive()
+	receiveErrors := make(chan error, 1)
+	go func() {
+		slog.Info("starting importer...")
+		receiveErrors <- sub.Receive(receiveCtx, broker.NewImportHandler(is, log))
+	}()
+
+	// start scheduler
+	daemonCtx, stopDaemon := context.WithCancel(ctx)
+	defer stopDaemon()
+	daemonErrors := make(chan error, 1)
+	if cfg.Daemon.Enabled {
+		if cfg.Daemon.Schedule.Holder == "" {
+			host, err := os.Hostname()
+			if err != nil {
+				return fmt.Errorf("failed to get hostname: %w", err)
+			}
+			cfg.Daemon.Schedule.Holder = fmt.Sprintf("%s-%d", host, os.Getpid())
+		}
+
+		sd := scheduling.NewDaemon(ss, chr, postgres.NewScheduleRepository(db, scheduling.LeaderLease), ler, cfg.Daemon.Schedule, log)
+		go func() {
+			slog.Info("starting daemon...")
+			daemonErrors <- sd.Run(daemonCtx)
+		}()
+	}
+
+	// start server
+	server := http.SetupServer(ctx, cfg.API, http.APIRootHandler(chs, chr, ir, jr, ss, ips, bls, br, ler, rr, cfg.API, log))
+	serverErrors := make(chan error, 1)
+	go func() {
+		slog.Info("starting server...")
+		serverErrors <- server.ListenAndServe()
+	}()
+
+	// shutdown
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err := <-serverErrors:
+		return fmt.Errorf("server error: %w", err)
+
+	case err := <-receiveErrors:
+		return fmt.Errorf("importer error: %w", err)
+
+	case err := <-daemonErrors:
+		return fmt.Errorf("daemon error: %w", err)
+
+	case <-done:
+		slog.Info("shutting down server...")
+
+		ctx, cancel := context.WithTimeout(ctx, cfg.API.ShutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(ctx); err != nil {
+			return fmt.Errorf("failed to shutdown server: %w", err)
+		}
+
+		if cfg.Daemon.Enabled {
+			stopDaemon()
+			if err := <-daemonErrors; err != nil {
+				return fmt.Errorf("failed to stop daemon: %w", err)
+			}
+		}
+
+		// running imports get the import shutdown timeout to finish
+		stopReceive()
+		if err := <-receiveErrors; err != nil {
+			return fmt.Errorf("failed to stop importer: %w", err)
+		}
+	}
+
+	return nil
+}
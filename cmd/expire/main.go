@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	ohttp "net/http"
+	"os"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/messaging"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
+	"github.com/caarlos0/env/v11"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+type config struct {
+	Broker  messaging.Config      `envPrefix:"BROKER_"`
+	DB      storage.Config        `envPrefix:"DB_"`
+	Gateway importing.Config      `envPrefix:"GATEWAY_"`
+	Archive storage.ArchiveConfig `envPrefix:"ARCHIVE_"`
+	Log     struct {
+		Level slog.Level `env:"LEVEL" envDefault:"info"`
+	} `envPrefix:"LOG_"`
+}
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{})))
+
+	if err := run(context.Background()); err != nil {
+		slog.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	// load environment variables
+	slog.Info("loading environment variables...")
+	var cfg config
+	if err := env.Parse(&cfg); err != nil {
+		return fmt.Errorf("failed to load environment variables: %w", err)
+	}
+
+	// configure logging
+	slog.Info("configuring logging...")
+	log := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: cfg.Log.Level}))
+	slog.SetDefault(log)
+
+	// setup database
+	slog.Info("setting up database...")
+	db, err := storage.SetupDatabase(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("failed to setup database: %w", err)
+	}
+	defer func(db *sqlx.DB) {
+		err := db.Close()
+		if err != nil {
+			slog.Error(fmt.Errorf("failed to close database connection: %w", err).Error())
+		}
+	}(db)
+
+	// migrate db
+	slog.Info("migrating database...")
+	if err := storage.MigrateDB(db); err != nil {
+		return fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	// broker
+	b, err := messaging.SetupBroker(ctx, cfg.Broker)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s broker: %w", cfg.Broker.Kind, err)
+	}
+	defer func(b broker.Broker) {
+		err := b.Close()
+		if err != nil {
+			slog.Error(fmt.Errorf("failed to close broker: %w", err).Error())
+		}
+	}(b)
+	jp, err := b.Publisher(ctx, cfg.Broker.JobTopic)
+	if err != nil {
+		return fmt.Errorf("failed to setup publisher for topic %s: %w", cfg.Broker.JobTopic, err)
+	}
+	pjs := broker.NewJobService(jp)
+
+	// archive
+	bs, err := storage.SetupBlobStore(ctx, cfg.Archive)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s archive: %w", cfg.Archive.Kind, err)
+	}
+	defer func(bs storage.BlobStore) {
+		err := bs.Close()
+		if err != nil {
+			slog.Error(fmt.Errorf("failed to close archive: %w", err).Error())
+		}
+	}(bs)
+
+	// services
+	slog.Info("setting up services...")
+	chr := postgres.NewChannelRepository(db)
+	ir := postgres.NewImportRepository(db)
+	jr := postgres.NewJobRepository(db)
+	br := postgres.NewBlocklistRepository(db)
+	lr := postgres.NewLinkRepository(db)
+	is := importing.NewService(chr, ir, jr, br, lr, ohttp.DefaultClient, cfg.Gateway, pjs, bs, log)
+
+	slog.Info("expiring jobs...")
+	n, err := is.ExpireJobs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire jobs: %w", err)
+	}
+
+	slog.Info(fmt.Sprintf("all done, expired %d jobs.", n))
+
+	return nil
+}
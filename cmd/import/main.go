@@ -10,10 +10,11 @@ import (
 	"os/signal"
 	"syscall"
 
-	cpubsub "cloud.google.com/go/pubsub"
 	"github.com/aviseu/jobs-backoffice/internal/app/application/http"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/dispatching"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
-	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/messaging"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
 	"github.com/caarlos0/env/v11"
@@ -24,15 +25,14 @@ import (
 )
 
 type config struct {
-	PubSub struct {
-		ProjectID  string        `env:"PROJECT_ID" required:"true"`
-		JobTopicID string        `env:"JOB_TOPIC_ID,required"`
-		Client     pubsub.Config `envPrefix:"CLIENT"`
-	} `envPrefix:"PUBSUB_"`
-	DB      storage.Config   `envPrefix:"DB_"`
-	Import  http.Config      `envPrefix:"IMPORT_"`
-	Gateway importing.Config `envPrefix:"GATEWAY_"`
-	Log     struct {
+	Broker      messaging.Config      `envPrefix:"BROKER_"`
+	ReceiveMode string                `env:"RECEIVE_MODE" envDefault:"push"`
+	DB          storage.Config        `envPrefix:"DB_"`
+	Import      http.Config           `envPrefix:"IMPORT_"`
+	Gateway     importing.Config      `envPrefix:"GATEWAY_"`
+	Archive     storage.ArchiveConfig `envPrefix:"ARCHIVE_"`
+	Dispatch    dispatching.Config    `envPrefix:"DISPATCH_"`
+	Log         struct {
 		Level slog.Level `env:"LEVEL" envDefault:"info"`
 	} `envPrefix:"LOG_"`
 }
@@ -78,20 +78,86 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
 
-	// pubsub
-	client, err := cpubsub.NewClient(ctx, cfg.PubSub.ProjectID)
+	// broker
+	b, err := messaging.SetupBroker(ctx, cfg.Broker)
 	if err != nil {
-		return fmt.Errorf("failed to build pubsub client for project %s: %w", cfg.PubSub.ProjectID, err)
+		return fmt.Errorf("failed to setup %s broker: %w", cfg.Broker.Kind, err)
 	}
-	pjs := pubsub.NewJobService(client.Topic(cfg.PubSub.JobTopicID), cfg.PubSub.Client)
+	defer func(b broker.Broker) {
+		err := b.Close()
+		if err != nil {
+			slog.Error(fmt.Errorf("failed to close broker: %w", err).Error())
+		}
+	}(b)
+	jp, err := b.Publisher(ctx, cfg.Broker.JobTopic)
+	if err != nil {
+		return fmt.Errorf("failed to setup publisher for topic %s: %w", cfg.Broker.JobTopic, err)
+	}
+	pjs := broker.NewJobService(jp)
+
+	// archive
+	bs, err := storage.SetupBlobStore(ctx, cfg.Archive)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s archive: %w", cfg.Archive.Kind, err)
+	}
+	defer func(bs storage.BlobStore) {
+		err := bs.Close()
+		if err != nil {
+			slog.Error(fmt.Errorf("failed to close archive: %w", err).Error())
+		}
+	}(bs)
 
 	// services
 	slog.Info("setting up services...")
 	chr := postgres.NewChannelRepository(db)
 	ir := postgres.NewImportRepository(db)
 	jr := postgres.NewJobRepository(db)
+	br := postgres.NewBlocklistRepository(db)
+	lr := postgres.NewLinkRepository(db)
 
-	is := importing.NewService(chr, ir, jr, ohttp.DefaultClient, cfg.Gateway, pjs, log)
+	is := importing.NewService(chr, ir, jr, br, lr, ohttp.DefaultClient, cfg.Gateway, pjs, bs, log)
+	for name, e := range importing.BuiltinEnrichers() {
+		is.RegisterEnricher(name, e)
+	}
+
+	// Queue mode claims imports from the import queue next to receiving import commands from the broker
+	dispatchCtx, stopDispatch := context.WithCancel(ctx)
+	defer stopDispatch()
+	dispatchErrors := make(chan error, 1)
+	switch cfg.Dispatch.Mode {
+	case dispatching.ModeBroker:
+	case dispatching.ModeQueue:
+		d := dispatching.NewDispatcher(postgres.NewImportQueueRepository(db), is, cfg.Dispatch, log)
+		go func() {
+			slog.Info("starting dispatcher...")
+			dispatchErrors <- d.Run(dispatchCtx)
+		}()
+	default:
+		return fmt.Errorf("unknown dispatch mode %s", cfg.Dispatch.Mode)
+	}
+
+	// Pull mode receives import commands from the subscription instead of having them pushed to the server,
+	// only pubsub can push
+	receiveCtx, stopReceive := context.WithCancel(ctx)
+	defer stopReceive()
+	receiveErrors := make(chan error, 1)
+	switch cfg.ReceiveMode {
+	case broker.ReceiveModePush:
+		if cfg.Broker.Kind != broker.KindPubSub {
+			return fmt.Errorf("receive mode %s is not supported by the %s broker", cfg.ReceiveMode, cfg.Broker.Kind)
+		}
+	case broker.ReceiveModePull:
+		sub, err := b.Subscriber(ctx, cfg.Broker.ImportTopic, cfg.Broker.ImportSubscription)
+		if err != nil {
+			return fmt.Errorf("failed to setup subscriber %s: %w", cfg.Broker.ImportSubscription, err)
+		}
+		go func() {
+			slog.Info("starting subscriber...")
+			receiveErrors <- sub.Receive(receiveCtx, broker.NewImportHandler(is, log))
+		}()
+	default:
+		return fmt.Errorf("unknown receive mode %s", cfg.ReceiveMode)
+	}
 
 	// start server
 	server := http.SetupServer(ctx, cfg.Import, http.ImportRootHandler(is, log))
@@ -118,6 +184,12 @@ func run(ctx context.Context) error {
 	case err := <-serverErrors:
 		return fmt.Errorf("server error: %w", err)
 
+	case err := <-dispatchErrors:
+		return fmt.Errorf("dispatcher error: %w", err)
+
+	case err := <-receiveErrors:
+		return fmt.Errorf("subscriber error: %w", err)
+
 	case <-done:
 		slog.Info("shutting down server...")
 
@@ -127,6 +199,24 @@ func run(ctx context.Context) error {
 		if err := server.Shutdown(ctx); err != nil {
 			return fmt.Errorf("failed to shutdown server: %w", err)
 		}
+
+		// in-flight imports get the subscriber shutdown timeout to finish before they are nacked
+		if cfg.ReceiveMode == broker.ReceiveModePull {
+			stopReceive()
+			if err := <-receiveErrors; err != nil {
+				return fmt.Errorf("failed to stop subscriber: %w", err)
+			}
+		}
+
+		// interrupted imports are requeued before the dispatcher returns
+		if cfg.Dispatch.Mode == dispatching.ModeQueue {
+			stopDispatch()
+			select {
+			case <-dispatchErrors:
+			case <-ctx.Done():
+				return fmt.Errorf("failed to stop dispatcher: %w", ctx.Err())
+			}
+		}
 	}
 
 	return nil
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
 
-	cpubsub "cloud.google.com/go/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/dispatching"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
-	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/messaging"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
 	"github.com/caarlos0/env/v11"
@@ -18,12 +21,14 @@ import (
 )
 
 type config struct {
-	PubSub struct {
-		ProjectID     string        `env:"PROJECT_ID" required:"true"`
-		ImportTopicID string        `env:"IMPORT_TOPIC_ID,required"`
-		Client        pubsub.Config `envPrefix:"CLIENT"`
-	} `envPrefix:"PUBSUB_"`
-	DB  storage.Config `envPrefix:"DB_"`
+	Broker   messaging.Config         `envPrefix:"BROKER_"`
+	DB       storage.Config           `envPrefix:"DB_"`
+	Schedule scheduling.ServiceConfig `envPrefix:"SCHEDULE_"`
+	Dispatch dispatching.Config       `envPrefix:"DISPATCH_"`
+	Daemon   struct {
+		Enabled  bool              `env:"ENABLED" envDefault:"false"`
+		Schedule scheduling.Config `envPrefix:"SCHEDULE_"`
+	} `envPrefix:"DAEMON_"`
 	Log struct {
 		Level slog.Level `env:"LEVEL" envDefault:"info"`
 	} `envPrefix:"LOG_"`
@@ -70,21 +75,72 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
 
-	// pubsub
-	client, err := cpubsub.NewClient(ctx, cfg.PubSub.ProjectID)
+	// broker
+	b, err := messaging.SetupBroker(ctx, cfg.Broker)
 	if err != nil {
-		return fmt.Errorf("failed to build pubsub client for project %s: %w", cfg.PubSub.ProjectID, err)
+		return fmt.Errorf("failed to setup %s broker: %w", cfg.Broker.Kind, err)
 	}
-	pis := pubsub.NewImportService(client.Topic(cfg.PubSub.ImportTopicID), cfg.PubSub.Client)
+	defer func(b broker.Broker) {
+		err := b.Close()
+		if err != nil {
+			slog.Error(fmt.Errorf("failed to close broker: %w", err).Error())
+		}
+	}(b)
+	ip, err := b.Publisher(ctx, cfg.Broker.ImportTopic)
+	if err != nil {
+		return fmt.Errorf("failed to setup publisher for topic %s: %w", cfg.Broker.ImportTopic, err)
+	}
+	pis := broker.NewImportService(ip)
 
 	// services
 	slog.Info("setting up services...")
 	chr := postgres.NewChannelRepository(db)
 	ir := postgres.NewImportRepository(db)
-	ss := scheduling.NewService(ir, chr, pis, log)
+
+	var ps scheduling.PubSubService
+	switch cfg.Dispatch.Mode {
+	case dispatching.ModeBroker:
+		ps = pis
+	case dispatching.ModeQueue:
+		ps = dispatching.NewQueue(ir, chr, postgres.NewImportQueueRepository(db))
+	default:
+		return fmt.Errorf("unknown dispatch mode %s", cfg.Dispatch.Mode)
+	}
+
+	ss := scheduling.NewService(ir, chr, postgres.NewScheduleRunRepository(db), ps, cfg.Schedule, log)
+
+	// Daemon mode keeps evaluating the schedules of channels until it is stopped
+	if cfg.Daemon.Enabled {
+		ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+
+		// Replicas compete for the same lease, each needs its own name
+		if cfg.Daemon.Schedule.Holder == "" {
+			host, err := os.Hostname()
+			if err != nil {
+				return fmt.Errorf("failed to get hostname: %w", err)
+			}
+			cfg.Daemon.Schedule.Holder = fmt.Sprintf("%s-%d", host, os.Getpid())
+		}
+
+		sd := scheduling.NewDaemon(ss, chr, postgres.NewScheduleRepository(db, scheduling.LeaderLease), postgres.NewLeaseRepository(db), cfg.Daemon.Schedule, log)
+
+		slog.Info("starting daemon...")
+		if err := sd.Run(ctx); err != nil {
+			return fmt.Errorf("failed to run daemon: %w", err)
+		}
+
+		slog.Info("daemon stopped.")
+
+		return nil
+	}
 
 	slog.Info("starting imports...")
-	if err := ss.ScheduleActiveChannels(ctx); err != nil {
+	run, err := ss.ScheduleActiveChannels(ctx)
+	if run != nil {
+		slog.Info(fmt.Sprintf("scheduled %d of %d channels [run: %s]", run.Scheduled, len(run.Results), run.ID))
+	}
+	if err != nil {
 		return fmt.Errorf("failed to import active channels: %w", err)
 	}
 
@@ -0,0 +1,2 @@
+drop table if exists import_staged_jobs;
+drop table if exists import_checkpoints;
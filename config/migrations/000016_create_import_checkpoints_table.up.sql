@@ -0,0 +1,18 @@
+create table if not exists import_checkpoints (
+    import_id uuid primary key,
+    pages int not null default 0,
+    jobs int not null default 0,
+    next_link text null,
+    completed bool not null default false,
+    updated_at timestamptz not null default now(),
+    foreign key (import_id) references imports (id)
+);
+
+create table if not exists import_staged_jobs (
+    import_id uuid not null,
+    job_id uuid not null,
+    page int not null,
+    job jsonb not null,
+    primary key (import_id, job_id),
+    foreign key (import_id) references imports (id)
+);
@@ -0,0 +1 @@
+alter table imports drop column if exists replay_of;
@@ -0,0 +1 @@
+alter table imports add column replay_of uuid null references imports (id);
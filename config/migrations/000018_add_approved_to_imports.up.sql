@@ -0,0 +1 @@
+alter table imports add column approved bool not null default false;
@@ -0,0 +1,4 @@
+alter table import_metadata drop column if exists pending_missing_jobs;
+alter table jobs drop column if exists missing_since;
+alter table jobs drop column if exists missed_imports;
+alter table channels drop column if exists settings;
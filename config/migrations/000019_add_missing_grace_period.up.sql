@@ -0,0 +1,4 @@
+alter table channels add column settings jsonb not null default '{}'::jsonb;
+alter table jobs add column missed_imports int not null default 0;
+alter table jobs add column missing_since timestamptz null;
+alter table import_metadata add column pending_missing_jobs int default 0;
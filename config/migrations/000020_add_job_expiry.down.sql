@@ -0,0 +1,2 @@
+alter table import_metadata drop column if exists expired_jobs;
+alter table jobs drop column if exists valid_through;
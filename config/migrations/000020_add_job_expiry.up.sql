@@ -0,0 +1,2 @@
+alter table jobs add column valid_through timestamptz null;
+alter table import_metadata add column expired_jobs int default 0;
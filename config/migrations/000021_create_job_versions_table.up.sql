@@ -0,0 +1,12 @@
+create table if not exists job_versions (
+    id uuid primary key,
+    job_id uuid not null,
+    import_id uuid not null,
+    version int not null,
+    reason int not null,
+    changes jsonb not null default '[]'::jsonb,
+    created_at timestamptz not null default now(),
+    unique (job_id, version),
+    foreign key (job_id) references jobs (id),
+    foreign key (import_id) references imports (id)
+);
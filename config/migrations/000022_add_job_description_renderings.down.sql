@@ -0,0 +1,2 @@
+alter table jobs drop column if exists description_markdown;
+alter table jobs drop column if exists description_text;
@@ -0,0 +1,2 @@
+alter table jobs add column description_text text not null default '';
+alter table jobs add column description_markdown text not null default '';
@@ -0,0 +1 @@
+alter table jobs add column enrichments jsonb not null default '{}'::jsonb;
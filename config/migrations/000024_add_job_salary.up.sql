@@ -0,0 +1 @@
+alter table jobs add column salary jsonb;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_jobs_remote_scope;
+DROP INDEX IF EXISTS idx_jobs_country_code_city;
+alter table jobs drop column if exists remote_scope;
+alter table jobs drop column if exists longitude;
+alter table jobs drop column if exists latitude;
+alter table jobs drop column if exists country_code;
+alter table jobs drop column if exists region;
+alter table jobs drop column if exists city;
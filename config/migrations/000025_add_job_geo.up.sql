@@ -0,0 +1,8 @@
+alter table jobs add column city text not null default '';
+alter table jobs add column region text not null default '';
+alter table jobs add column country_code text not null default '';
+alter table jobs add column latitude double precision null;
+alter table jobs add column longitude double precision null;
+alter table jobs add column remote_scope int not null default 0;
+CREATE INDEX IF NOT EXISTS idx_jobs_country_code_city ON jobs(country_code, city);
+CREATE INDEX IF NOT EXISTS idx_jobs_remote_scope ON jobs(remote_scope);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_jobs_language;
+alter table jobs drop column if exists language;
@@ -0,0 +1,2 @@
+alter table jobs add column language text not null default '';
+CREATE INDEX IF NOT EXISTS idx_jobs_language ON jobs(language);
@@ -0,0 +1,2 @@
+alter table import_metadata drop column if exists filtered_jobs;
+alter table jobs drop column if exists company;
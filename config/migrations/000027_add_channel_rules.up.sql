@@ -0,0 +1,2 @@
+alter table jobs add column company text not null default '';
+alter table import_metadata add column filtered_jobs int default 0;
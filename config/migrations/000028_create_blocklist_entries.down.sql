@@ -0,0 +1,3 @@
+alter table import_metadata drop column if exists blocked_jobs;
+DROP INDEX IF EXISTS idx_blocklist_entries_kind_value;
+drop table if exists blocklist_entries;
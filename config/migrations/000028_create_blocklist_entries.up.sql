@@ -0,0 +1,9 @@
+create table blocklist_entries (
+    id uuid primary key,
+    kind int not null,
+    value text not null,
+    reason text not null default '',
+    created_at timestamptz not null default now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_blocklist_entries_kind_value ON blocklist_entries(kind, lower(value));
+alter table import_metadata add column blocked_jobs int default 0;
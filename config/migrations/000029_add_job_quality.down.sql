@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_jobs_quality_score;
+alter table jobs drop column if exists quality;
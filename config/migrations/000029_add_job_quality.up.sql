@@ -0,0 +1,2 @@
+alter table jobs add column quality jsonb;
+CREATE INDEX IF NOT EXISTS idx_jobs_quality_score ON jobs((CAST(quality->>'score' AS int)));
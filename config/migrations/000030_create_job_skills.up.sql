@@ -0,0 +1,8 @@
+create table if not exists job_skills (
+    job_id uuid not null,
+    skill text not null,
+    count int not null,
+    primary key (job_id, skill),
+    foreign key (job_id) references jobs (id)
+);
+CREATE INDEX IF NOT EXISTS idx_job_skills_skill ON job_skills(lower(skill));
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_jobs_employment_type;
+DROP INDEX IF EXISTS idx_jobs_seniority;
+alter table jobs drop column if exists employment_type_confidence;
+alter table jobs drop column if exists employment_type;
+alter table jobs drop column if exists seniority_confidence;
+alter table jobs drop column if exists seniority;
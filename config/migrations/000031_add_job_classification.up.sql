@@ -0,0 +1,6 @@
+alter table jobs add column seniority int not null default 0;
+alter table jobs add column seniority_confidence double precision not null default 0;
+alter table jobs add column employment_type int not null default 0;
+alter table jobs add column employment_type_confidence double precision not null default 0;
+CREATE INDEX IF NOT EXISTS idx_jobs_seniority ON jobs(seniority);
+CREATE INDEX IF NOT EXISTS idx_jobs_employment_type ON jobs(employment_type);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_job_links_status;
+drop table if exists job_links;
@@ -0,0 +1,11 @@
+create table if not exists job_links (
+    job_id uuid primary key,
+    url text not null,
+    status int not null default 0,
+    status_code int not null default 0,
+    error text not null default '',
+    failures int not null default 0,
+    checked_at timestamptz not null,
+    foreign key (job_id) references jobs (id)
+);
+CREATE INDEX IF NOT EXISTS idx_job_links_status ON job_links(status);
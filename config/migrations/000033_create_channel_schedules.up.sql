@@ -0,0 +1,8 @@
+create table if not exists channel_schedules (
+    channel_id uuid primary key,
+    spec text not null,
+    next_run_at timestamptz not null,
+    last_run_at timestamptz,
+    last_import_id uuid,
+    foreign key (channel_id) references channels (id)
+);
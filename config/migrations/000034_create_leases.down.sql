@@ -0,0 +1,2 @@
+ALTER TABLE channel_schedules DROP COLUMN fence;
+drop table if exists leases;
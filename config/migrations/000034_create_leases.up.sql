@@ -0,0 +1,9 @@
+create table if not exists leases (
+    name text primary key,
+    holder text not null,
+    token bigint not null,
+    acquired_at timestamptz not null,
+    renewed_at timestamptz not null,
+    expires_at timestamptz not null
+);
+ALTER TABLE channel_schedules ADD COLUMN fence bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_schedule_runs_started_at;
+drop table if exists schedule_runs;
@@ -0,0 +1,9 @@
+create table if not exists schedule_runs (
+    id uuid primary key,
+    started_at timestamptz not null,
+    ended_at timestamptz not null,
+    scheduled int not null default 0,
+    failed int not null default 0,
+    results jsonb not null default '[]'::jsonb
+);
+CREATE INDEX IF NOT EXISTS idx_schedule_runs_started_at ON schedule_runs(started_at);
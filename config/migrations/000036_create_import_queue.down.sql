@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_import_queue_status_virtual_time;
+drop table if exists import_queue;
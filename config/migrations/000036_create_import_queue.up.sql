@@ -0,0 +1,11 @@
+create table if not exists import_queue (
+    import_id uuid primary key,
+    channel_id uuid not null,
+    integration int not null,
+    priority int not null default 1,
+    status int not null default 0,
+    virtual_time double precision not null default 0,
+    enqueued_at timestamptz not null,
+    started_at timestamptz null
+);
+CREATE INDEX IF NOT EXISTS idx_import_queue_status_virtual_time ON import_queue(status, virtual_time);
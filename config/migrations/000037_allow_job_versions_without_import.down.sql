@@ -0,0 +1,2 @@
+delete from job_versions where import_id is null;
+alter table job_versions alter column import_id set not null;
@@ -0,0 +1 @@
+alter table job_versions alter column import_id drop not null;
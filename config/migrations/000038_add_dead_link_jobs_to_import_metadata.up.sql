@@ -0,0 +1 @@
+alter table import_metadata add column dead_link_jobs int default 0;
@@ -0,0 +1 @@
+alter table import_queue drop column if exists heartbeat_at;
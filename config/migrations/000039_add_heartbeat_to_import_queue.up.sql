@@ -0,0 +1 @@
+alter table import_queue add column heartbeat_at timestamptz null;
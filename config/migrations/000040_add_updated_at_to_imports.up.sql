@@ -0,0 +1 @@
+alter table imports add column updated_at timestamptz not null default now();
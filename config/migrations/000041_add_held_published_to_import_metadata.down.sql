@@ -0,0 +1 @@
+alter table import_metadata drop column if exists held_published;
@@ -0,0 +1 @@
+alter table import_metadata add column held_published int default 0;
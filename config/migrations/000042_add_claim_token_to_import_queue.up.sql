@@ -0,0 +1 @@
+alter table import_queue add column claim_token uuid null;
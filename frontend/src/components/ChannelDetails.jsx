@@ -109,6 +109,14 @@ const ChannelDetails = () => {
                         <Link className="btn btn-sm btn-primary float-end me-2" role="button" to={"/channels/"+id+"/update"}>Update</Link>
                     </h2>
                     <h6 className="mb-3">Integration: {channel.integration}</h6>
+                    <h6 className="mb-3">Missing after: {channel.settings.missing_after_imports > 0 ? `${channel.settings.missing_after_imports} imports` : "first miss"}{channel.settings.missing_after !== "0s" && ` or ${channel.settings.missing_after}`}</h6>
+                    <h6 className="mb-3">Max age: {channel.settings.max_age !== "0s" ? channel.settings.max_age : "none"}</h6>
+                    <h6 className="mb-3">Enrichers: {channel.settings.enrichers.length > 0 ? channel.settings.enrichers.join(", ") : "none"}</h6>
+                    <h6 className="mb-3">Languages: {channel.settings.languages.length > 0 ? channel.settings.languages.join(", ") : "all"}</h6>
+                    <h6 className="mb-3">Seniority: {channel.settings.seniority || "classified"}</h6>
+                    <h6 className="mb-3">Employment type: {channel.settings.employment_type || "classified"}</h6>
+                    <h6 className="mb-3">Schedule: {channel.settings.schedule ? `${channel.settings.schedule.cron || `every ${channel.settings.schedule.interval}`}${channel.settings.schedule.jitter !== "0s" ? ` ~${channel.settings.schedule.jitter}` : ""}${channel.settings.schedule.catch_up ? ` (catch up: ${channel.settings.schedule.catch_up})` : ""}` : "default"}</h6>
+                    <h6 className="mb-3">Priority: {channel.settings.priority}</h6>
                 </div>
             </div>
         </div>
@@ -2,7 +2,7 @@ import React, { useState, useEffect } from "react";
 import { useParams } from "react-router-dom";
 import axios from "axios";
 import {Link, useLocation } from "react-router-dom";
-import { faSquarePlus, faPlus, faBan, faRetweet, faEquals, faQuestion, faCircleQuestion, faFolderPlus } from '@fortawesome/free-solid-svg-icons';
+import { faSquarePlus, faPlus, faBan, faRetweet, faEquals, faQuestion, faCircleQuestion, faFolderPlus, faHourglassHalf, faCalendarXmark, faFilter, faShieldHalved } from '@fortawesome/free-solid-svg-icons';
 import {FontAwesomeIcon} from "@fortawesome/react-fontawesome";
 
 
@@ -11,13 +11,14 @@ const ImportDetails = () => {
     const [importEntry, setImportEntry] = useState(null);
     const [loading, setLoading] = useState(true);
     const [error, setError] = useState(null);
+    const [updating, setUpdating] = useState(false);
     const location = useLocation();
 
     const fetchImport = async () => {
         try {
             const response = await axios.get(`${import.meta.env.VITE_BACKEND_URL}/api/imports/${id}`);
             setImportEntry(response.data);
-            if (response.data.status !== "completed" && response.data.status !== "failed" && window.location.pathname === "/imports/" + id && new Date() - Date.parse(response.data.started_at) < 5 * 60 * 1000) {
+            if (response.data.status !== "completed" && response.data.status !== "failed" && response.data.status !== "needs_review" && response.data.status !== "discarded" && window.location.pathname === "/imports/" + id && new Date() - Date.parse(response.data.started_at) < 5 * 60 * 1000) {
                 setTimeout(fetchImport, 500);
             }
         } catch (err) {
@@ -34,6 +35,27 @@ const ImportDetails = () => {
         }
     };
 
+    const review = async (action, event) => {
+        event.preventDefault();
+        setUpdating(true);
+        setError(null);
+        try {
+            await axios.put(`${import.meta.env.VITE_BACKEND_URL}/api/imports/${id}/${action}`);
+            await fetchImport();
+        } catch (err) {
+            console.log(err)
+            if (err.response) {
+                setError(err.response.data.error.message || "Submission failed. Please check your input.");
+            } else if (err.request) {
+                setError("Network error. Please check your connection.");
+            } else {
+                setError("An unexpected error occurred.");
+            }
+        } finally {
+            setUpdating(false);
+        }
+    }
+
     useEffect(() => {
         fetchImport();
     }, [id, location.pathname]);
@@ -59,6 +81,21 @@ const ImportDetails = () => {
                             <p>{importEntry.error || "An error occurred during the import."}</p>
                         </div>
                     )}
+                    {importEntry.status === "needs_review" && (
+                        <div className="alert alert-warning" role="alert">
+                            <h4 className="alert-heading">Import Needs Review</h4>
+                            <hr/>
+                            <p>{importEntry.error}</p>
+                            <button className="btn btn-sm btn-success me-2"
+                                    onClick={(event) => review("approve", event)} disabled={updating}>
+                                {updating ? <span className="spinner-border spinner-border-sm"></span> : "Approve"}
+                            </button>
+                            <button className="btn btn-sm btn-danger"
+                                    onClick={(event) => review("discard", event)} disabled={updating}>
+                                {updating ? <span className="spinner-border spinner-border-sm"></span> : "Discard"}
+                            </button>
+                        </div>
+                    )}
                     <ul className="list-group list-group-flush">
                         <li className="list-group-item d-flex">
                             <span><strong>Channel</strong></span>
@@ -86,6 +123,10 @@ const ImportDetails = () => {
                                 <th scope="col">Total</th>
                                 <th scope="col">Errors</th>
                                 <th scope="col">Missing</th>
+                                <th scope="col">Pending</th>
+                                <th scope="col">Expired</th>
+                                <th scope="col">Filtered</th>
+                                <th scope="col">Blocked</th>
                                 <th scope="col">P. Missing</th>
                                 <th scope="col">P. Info</th>
                                 <th scope="col">P. Late</th>
@@ -99,6 +140,10 @@ const ImportDetails = () => {
                                     <td>{importEntry.total_jobs}</td>
                                     <td><span className="me-1" title="failed"><FontAwesomeIcon icon={faBan} /> {importEntry.errors}</span></td>
                                     <td><span className="me-1" title="missing"><FontAwesomeIcon icon={faQuestion} /> {importEntry.missing_jobs}</span></td>
+                                    <td><span className="me-1" title="pending missing"><FontAwesomeIcon icon={faHourglassHalf} /> {importEntry.pending_missing_jobs}</span></td>
+                                    <td><span className="me-1" title="expired"><FontAwesomeIcon icon={faCalendarXmark} /> {importEntry.expired_jobs}</span></td>
+                                    <td><span className="me-1" title="filtered"><FontAwesomeIcon icon={faFilter} /> {importEntry.filtered_jobs}</span></td>
+                                    <td><span className="me-1" title="blocked"><FontAwesomeIcon icon={faShieldHalved} /> {importEntry.blocked_jobs}</span></td>
                                     <td><span className="me-1" title="missing published"><FontAwesomeIcon icon={faCircleQuestion} /> {importEntry.missing_published}</span></td>
                                     <td><span className="me-1" title="published"><FontAwesomeIcon icon={faSquarePlus} /> {importEntry.published}</span></td>
                                     <td><span className="me-1" title="late published"><FontAwesomeIcon icon={faFolderPlus} /> {importEntry.late_published}</span></td>
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/blocking"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/errs"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type BlocklistRepository interface {
+	All(ctx context.Context) ([]*aggregator.BlocklistEntry, error)
+}
+
+type BlocklistHandler struct {
+	bs  *blocking.Service
+	br  BlocklistRepository
+	log *slog.Logger
+}
+
+func NewBlocklistHandler(bs *blocking.Service, br BlocklistRepository, log *slog.Logger) *BlocklistHandler {
+	return &BlocklistHandler{
+		bs:  bs,
+		br:  br,
+		log: log,
+	}
+}
+
+func (h *BlocklistHandler) Routes() http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/", h.ListEntries)
+	r.Post("/", h.AddEntry)
+	r.Delete("/{id}", h.RemoveEntry)
+
+	return r
+}
+
+func (h *BlocklistHandler) ListEntries(w http.ResponseWriter, r *http.Request) {
+	entries, err := h.br.All(r.Context())
+	if err != nil {
+		h.handleError(w, fmt.Errorf("failed to get blocklist: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewListBlocklistResponse(entries)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *BlocklistHandler) AddEntry(w http.ResponseWriter, r *http.Request) {
+	var req addBlocklistEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleFail(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	e, n, err := h.bs.Add(r.Context(), blocking.NewAddEntryCommand(req.Kind, req.Value, req.Reason))
+	if err != nil {
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to add blocklist entry: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	resp := NewAddBlocklistEntryResponse(e, n)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *BlocklistHandler) RemoveEntry(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.bs.Remove(r.Context(), id); err != nil {
+		if errors.Is(err, blocking.ErrEntryNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to remove blocklist entry %s: %w", idStr, err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *BlocklistHandler) handleFail(w http.ResponseWriter, err error, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	resp := NewErrorResponse(err)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error(err.Error(), slog.Any("Error", err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *BlocklistHandler) handleError(w http.ResponseWriter, err error) {
+	h.log.Error(err.Error(), slog.Any("Error", err))
+
+	h.handleFail(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+}
@@ -0,0 +1,216 @@
+package api_test
+
+import (
+	"errors"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	oghttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBlocklistHandler(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BlocklistHandlerSuite))
+}
+
+type BlocklistHandlerSuite struct {
+	suite.Suite
+}
+
+func (suite *BlocklistHandlerSuite) Test_ListEntries_Success() {
+	// Prepare
+	id1 := uuid.New()
+	id2 := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithBlocklistEntry(aggregator.BlocklistKindKeyword, "crypto", testutils.WithBlocklistEntryID(id2)),
+		testutils.WithBlocklistEntry(aggregator.BlocklistKindCompany, "Shady MLM Ltd.", testutils.WithBlocklistEntryID(id1), testutils.WithBlocklistEntryReason("pyramid scheme")),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/blocklist", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"entries":[{"id":"`+id1.String()+`","kind":"company","value":"Shady MLM Ltd.","reason":"pyramid scheme","created_at":"2025-01-01T00:04:00Z"},{"id":"`+id2.String()+`","kind":"keyword","value":"crypto","reason":"","created_at":"2025-01-01T00:04:00Z"}]}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *BlocklistHandlerSuite) Test_ListEntries_RepositoryFail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithBlocklistRepositoryError(errors.New("boom!")),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/blocklist", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusInternalServerError, rr.Code)
+	suite.Equal("{\"error\":{\"message\":\"Internal Server Error\"}}\n", rr.Body.String())
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], `"level":"ERROR"`)
+	suite.Contains(lines[0], "failed to get blocklist: boom!")
+}
+
+func (suite *BlocklistHandlerSuite) Test_AddEntry_Success() {
+	// Prepare
+	jID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobCompany("Shady MLM Ltd."),
+		),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/blocklist", strings.NewReader(`{"kind":"company","value":"shady mlm ltd.","reason":"pyramid scheme"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert state change
+	entries := dsl.BlocklistEntries()
+	suite.Len(entries, 1)
+	e := entries[0]
+	suite.Equal(aggregator.BlocklistKindCompany, e.Kind)
+	suite.Equal("shady mlm ltd.", e.Value)
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(jID).Status)
+	suite.NotNil(dsl.PublishedJobBlocked(jID))
+
+	// Assert response
+	suite.Equal(oghttp.StatusCreated, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+e.ID.String()+`","kind":"company","value":"shady mlm ltd.","reason":"pyramid scheme","created_at":"`+e.CreatedAt.Format(time.RFC3339)+`","unpublished_jobs":1}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *BlocklistHandlerSuite) Test_AddEntry_InvalidRequestBody_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("POST", "/api/blocklist", strings.NewReader(`{"kind":`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"failed to decode request: unexpected EOF"}}`+"\n", rr.Body.String())
+	suite.Empty(dsl.BlocklistEntries())
+}
+
+func (suite *BlocklistHandlerSuite) Test_AddEntry_Validation_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("POST", "/api/blocklist", strings.NewReader(`{"kind":"domain","value":""}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"failed to find kind domain: invalid blocklist kind\nvalue is required"}}`+"\n", rr.Body.String())
+	suite.Empty(dsl.BlocklistEntries())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *BlocklistHandlerSuite) Test_AddEntry_Duplicate_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithBlocklistEntry(aggregator.BlocklistKindKeyword, "crypto"),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/blocklist", strings.NewReader(`{"kind":"keyword","value":"Crypto"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"keyword Crypto: value is already blocked"}}`+"\n", rr.Body.String())
+	suite.Len(dsl.BlocklistEntries(), 1)
+}
+
+func (suite *BlocklistHandlerSuite) Test_RemoveEntry_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithBlocklistEntry(aggregator.BlocklistKindKeyword, "crypto", testutils.WithBlocklistEntryID(id)),
+	)
+
+	req, err := oghttp.NewRequest("DELETE", "/api/blocklist/"+id.String(), nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNoContent, rr.Code)
+	suite.Empty(rr.Body.String())
+	suite.Empty(dsl.BlocklistEntries())
+}
+
+func (suite *BlocklistHandlerSuite) Test_RemoveEntry_NotFound_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	id := uuid.New()
+
+	req, err := oghttp.NewRequest("DELETE", "/api/blocklist/"+id.String(), nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"blocklist entry not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *BlocklistHandlerSuite) Test_RemoveEntry_InvalidID_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("DELETE", "/api/blocklist/abc", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"failed to parse uuid abc: invalid UUID length: 3"}}`+"\n", rr.Body.String())
+}
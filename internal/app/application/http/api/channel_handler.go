@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/configuring"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
@@ -22,19 +25,26 @@ type ChannelRepository interface {
 	Find(ctx context.Context, id uuid.UUID) (*aggregator.Channel, error)
 }
 
+const (
+	defaultPreviewSamples = 5
+	maxPreviewSamples     = 50
+)
+
 type ChannelHandler struct {
 	gs  *configuring.Service
 	chr ChannelRepository
 	ss  *scheduling.Service
+	ps  *importing.PreviewService
 	log *slog.Logger
 }
 
-func NewChannelHandler(gs *configuring.Service, chr ChannelRepository, ss *scheduling.Service, log *slog.Logger) *ChannelHandler {
+func NewChannelHandler(gs *configuring.Service, chr ChannelRepository, ss *scheduling.Service, ps *importing.PreviewService, log *slog.Logger) *ChannelHandler {
 	return &ChannelHandler{
 		gs:  gs,
 		chr: chr,
 		log: log,
 		ss:  ss,
+		ps:  ps,
 	}
 }
 
@@ -47,8 +57,17 @@ func (h *ChannelHandler) Routes() http.Handler {
 	r.Patch("/{id}", h.UpdateChannel)
 	r.Put("/{id}/activate", h.ActivateChannel)
 	r.Put("/{id}/deactivate", h.DeactivateChannel)
+	r.Put("/{id}/settings", h.UpdateChannelSettings)
+	r.Put("/{id}/enrichers", h.UpdateChannelEnrichers)
+	r.Put("/{id}/languages", h.UpdateChannelLanguages)
+	r.Get("/{id}/rules", h.GetChannelRules)
+	r.Put("/{id}/rules", h.UpdateChannelRules)
+	r.Put("/{id}/classification", h.UpdateChannelClassification)
+	r.Put("/{id}/import-schedule", h.UpdateChannelSchedule)
+	r.Put("/{id}/priority", h.UpdateChannelPriority)
 
 	r.Put("/{id}/schedule", h.ScheduleImport)
+	r.Post("/{id}/preview", h.PreviewImport)
 
 	return r
 }
@@ -165,6 +184,356 @@ func (h *ChannelHandler) UpdateChannel(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *ChannelHandler) UpdateChannelSettings(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	var req updateChannelSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleFail(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	var missingAfter time.Duration
+	if req.MissingAfter != "" {
+		missingAfter, err = time.ParseDuration(req.MissingAfter)
+		if err != nil {
+			h.handleFail(w, fmt.Errorf("failed to parse missing_after %s: %w", req.MissingAfter, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var maxAge time.Duration
+	if req.MaxAge != "" {
+		maxAge, err = time.ParseDuration(req.MaxAge)
+		if err != nil {
+			h.handleFail(w, fmt.Errorf("failed to parse max_age %s: %w", req.MaxAge, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, req.MissingAfterImports, missingAfter, maxAge, req.MaxMissingPercentage, req.MinQualityScore)
+	ch, err := h.gs.UpdateSettings(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, configuring.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to update settings of channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	resp := NewChannelResponse(ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode channel %s: %w", idStr, err))
+		return
+	}
+}
+
+func (h *ChannelHandler) UpdateChannelEnrichers(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	var req updateChannelEnrichersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleFail(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	cmd := configuring.NewUpdateChannelEnrichersCommand(id, req.Enrichers)
+	ch, err := h.gs.UpdateEnrichers(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, configuring.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to update enrichers of channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	resp := NewChannelResponse(ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode channel %s: %w", idStr, err))
+		return
+	}
+}
+
+func (h *ChannelHandler) UpdateChannelLanguages(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	var req updateChannelLanguagesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleFail(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	cmd := configuring.NewUpdateChannelLanguagesCommand(id, req.Languages)
+	ch, err := h.gs.UpdateLanguages(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, configuring.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to update languages of channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	resp := NewChannelResponse(ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode channel %s: %w", idStr, err))
+		return
+	}
+}
+
+func (h *ChannelHandler) GetChannelRules(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	ch, err := h.chr.Find(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to find channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	resp := NewRulesResponse(ch.Settings.Rules)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode rules of channel %s: %w", idStr, err))
+		return
+	}
+}
+
+func (h *ChannelHandler) UpdateChannelRules(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	var req updateChannelRulesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleFail(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	rules, err := req.toAggregator()
+	if err != nil {
+		h.handleFail(w, err, http.StatusBadRequest)
+		return
+	}
+
+	cmd := configuring.NewUpdateChannelRulesCommand(id, rules)
+	ch, err := h.gs.UpdateRules(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, configuring.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to update rules of channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	resp := NewRulesResponse(ch.Settings.Rules)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode rules of channel %s: %w", idStr, err))
+		return
+	}
+}
+
+func (h *ChannelHandler) UpdateChannelClassification(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	var req updateChannelClassificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleFail(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	cmd := configuring.NewUpdateChannelClassificationCommand(id, req.Seniority, req.EmploymentType)
+	ch, err := h.gs.UpdateClassification(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, configuring.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to update classification of channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	resp := NewChannelResponse(ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode channel %s: %w", idStr, err))
+		return
+	}
+}
+
+func (h *ChannelHandler) UpdateChannelSchedule(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	var req updateChannelScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleFail(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	var interval time.Duration
+	if req.Interval != "" {
+		interval, err = time.ParseDuration(req.Interval)
+		if err != nil {
+			h.handleFail(w, fmt.Errorf("failed to parse interval %s: %w", req.Interval, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var jitter time.Duration
+	if req.Jitter != "" {
+		jitter, err = time.ParseDuration(req.Jitter)
+		if err != nil {
+			h.handleFail(w, fmt.Errorf("failed to parse jitter %s: %w", req.Jitter, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	cmd := configuring.NewUpdateChannelScheduleCommand(id, req.Cron, interval, jitter, req.CatchUp)
+	ch, err := h.gs.UpdateSchedule(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, configuring.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to update schedule of channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	resp := NewChannelResponse(ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode channel %s: %w", idStr, err))
+		return
+	}
+}
+
+func (h *ChannelHandler) UpdateChannelPriority(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	var req updateChannelPriorityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.handleFail(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	cmd := configuring.NewUpdateChannelPriorityCommand(id, req.Priority)
+	ch, err := h.gs.UpdatePriority(r.Context(), cmd)
+	if err != nil {
+		if errors.Is(err, configuring.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to update priority of channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	resp := NewChannelResponse(ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode channel %s: %w", idStr, err))
+		return
+	}
+}
+
 func (h *ChannelHandler) ActivateChannel(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 
@@ -249,6 +618,48 @@ func (h *ChannelHandler) ScheduleImport(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+func (h *ChannelHandler) PreviewImport(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("failed to parse uuid %s: %w", idStr, err), http.StatusBadRequest)
+		return
+	}
+
+	samples := defaultPreviewSamples
+	if v := r.URL.Query().Get("samples"); v != "" {
+		samples, err = strconv.Atoi(v)
+		if err != nil || samples < 0 || samples > maxPreviewSamples {
+			h.handleFail(w, fmt.Errorf("samples must be a number between 0 and %d", maxPreviewSamples), http.StatusBadRequest)
+			return
+		}
+	}
+
+	p, err := h.ps.Preview(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, importing.ErrChannelNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			h.handleFail(w, fmt.Errorf("failed to preview channel %s in time: %w", idStr, err), http.StatusGatewayTimeout)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to preview channel %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewPreviewResponse(p, samples)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
 func (h *ChannelHandler) handleFail(w http.ResponseWriter, err error, code int) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
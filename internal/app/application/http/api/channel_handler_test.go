@@ -48,7 +48,7 @@ func (suite *ChannelHandlerSuite) Test_Create_Success() {
 	// Assert response
 	suite.Equal(oghttp.StatusCreated, rr.Code)
 	suite.Equal("application/json", rr.Header().Get("Content-Type"))
-	suite.Equal(`{"id":"`+ch.ID.String()+`","name":"Channel Name","integration":"arbeitnow","status":"inactive","created_at":"`+ch.CreatedAt.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+	suite.Equal(`{"id":"`+ch.ID.String()+`","name":"Channel Name","integration":"arbeitnow","status":"inactive","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"","employment_type":"","schedule":null,"priority":1},"created_at":"`+ch.CreatedAt.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
 
 	// Assert log
 	suite.Empty(dsl.LogLines())
@@ -191,7 +191,7 @@ func (suite *ChannelHandlerSuite) Test_GetChannels_Success() {
 	// Assert
 	suite.Equal(oghttp.StatusOK, rr.Code)
 	suite.Equal("application/json", rr.Header().Get("Content-Type"))
-	suite.Equal(`{"channels":[{"id":"`+id1.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","created_at":"`+dsl.Channel(id1).CreatedAt.Format(time.RFC3339)+`","updated_at":"`+dsl.Channel(id1).UpdatedAt.Format(time.RFC3339)+`"},{"id":"`+id2.String()+`","name":"channel 2","integration":"arbeitnow","status":"inactive","created_at":"`+dsl.Channel(id2).CreatedAt.Format(time.RFC3339)+`","updated_at":"`+dsl.Channel(id2).UpdatedAt.Format(time.RFC3339)+`"}]}`+"\n", rr.Body.String())
+	suite.Equal(`{"channels":[{"id":"`+id1.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"","employment_type":"","schedule":null,"priority":1},"created_at":"`+dsl.Channel(id1).CreatedAt.Format(time.RFC3339)+`","updated_at":"`+dsl.Channel(id1).UpdatedAt.Format(time.RFC3339)+`"},{"id":"`+id2.String()+`","name":"channel 2","integration":"arbeitnow","status":"inactive","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"","employment_type":"","schedule":null,"priority":1},"created_at":"`+dsl.Channel(id2).CreatedAt.Format(time.RFC3339)+`","updated_at":"`+dsl.Channel(id2).UpdatedAt.Format(time.RFC3339)+`"}]}`+"\n", rr.Body.String())
 
 	// Assert log
 	suite.Empty(dsl.LogLines())
@@ -314,7 +314,7 @@ func (suite *ChannelHandlerSuite) Test_FindChannel_Success() {
 	// Assert
 	suite.Equal(oghttp.StatusOK, rr.Code)
 	suite.Equal("application/json", rr.Header().Get("Content-Type"))
-	suite.Equal(`{"id":"`+id.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+uat.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+	suite.Equal(`{"id":"`+id.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"","employment_type":"","schedule":null,"priority":1},"created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+uat.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
 
 	// Assert log
 	suite.Empty(dsl.LogLines())
@@ -447,7 +447,7 @@ func (suite *ChannelHandlerSuite) Test_UpdateChannel_Success() {
 	// Assert response
 	suite.Equal(oghttp.StatusOK, rr.Code)
 	suite.Equal("application/json", rr.Header().Get("Content-Type"))
-	suite.Equal(`{"id":"`+id.String()+`","name":"NewChannel Name","integration":"arbeitnow","status":"active","created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+	suite.Equal(`{"id":"`+id.String()+`","name":"NewChannel Name","integration":"arbeitnow","status":"active","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"","employment_type":"","schedule":null,"priority":1},"created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
 
 	// Assert log
 	suite.Empty(dsl.LogLines())
@@ -1058,3 +1058,801 @@ func (suite *ChannelHandlerSuite) Test_ScheduleImport_BadResponseWriter() {
 	suite.Contains(lines[2], `"level":"ERROR"`)
 	suite.Contains(lines[2], `"msg":"bad response writer"`)
 }
+
+func (suite *ChannelHandlerSuite) Test_PreviewImport_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelDeactivated(),
+		),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/channels/"+id.String()+"/preview?samples=1", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	var resp api.PreviewResponse
+	err = json.NewDecoder(rr.Body).Decode(&resp)
+	suite.NoError(err)
+	suite.Equal(id.String(), resp.ChannelID)
+	suite.Equal(2, resp.Pages)
+	suite.Equal(3, resp.TotalJobs)
+	suite.Equal(3, resp.NewJobs)
+	suite.Equal(0, resp.UpdatedJobs)
+	suite.Equal(0, resp.NoChangeJobs)
+	suite.Equal(0, resp.MissingJobs)
+	suite.Empty(resp.Problems)
+	suite.Len(resp.Samples.New, 1)
+	suite.Equal("Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)", resp.Samples.New[0].Title)
+	suite.Empty(resp.Samples.Updated)
+	suite.Empty(resp.Samples.Missing)
+
+	// Assert nothing was persisted or published
+	suite.Empty(dsl.Jobs())
+	suite.Empty(dsl.Imports())
+	suite.Empty(dsl.PublishedJobInformations())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_PreviewImport_Truncated() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithPreviewLimit(1, 5*time.Second),
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/channels/"+id.String()+"/preview", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	var resp api.PreviewResponse
+	err = json.NewDecoder(rr.Body).Decode(&resp)
+	suite.NoError(err)
+	suite.Equal(1, resp.Pages)
+	suite.True(resp.Truncated)
+	suite.Equal(2, resp.TotalJobs)
+}
+
+func (suite *ChannelHandlerSuite) Test_PreviewImport_TimeoutFail() {
+	// Prepare
+	id := uuid.MustParse(testutils.ArbeitnowSlow)
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithPreviewLimit(1, 50*time.Millisecond),
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/channels/"+id.String()+"/preview", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusGatewayTimeout, rr.Code)
+	suite.Contains(rr.Body.String(), "failed to preview channel "+id.String()+" in time")
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_PreviewImport_InvalidSamples() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("POST", "/api/channels/"+uuid.New().String()+"/preview?samples=100", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"samples must be a number between 0 and 50"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_PreviewImport_ChannelNotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("POST", "/api/channels/"+uuid.New().String()+"/preview", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_PreviewImport_GatewayFail() {
+	// Prepare
+	id := uuid.MustParse(testutils.ArbeitnowMethodNotFound)
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/channels/"+id.String()+"/preview", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusInternalServerError, rr.Code)
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to preview channel "+id.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSettings_Success() {
+	// Prepare
+	id := uuid.New()
+	cat := time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)
+	uat := time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelTimestamps(cat, uat),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/settings", strings.NewReader(`{"missing_after_imports":3,"missing_after":"48h","max_age":"720h"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert state change
+	ch := dsl.FirstChannel()
+	suite.Equal(3, ch.Settings.MissingAfterImports)
+	suite.Equal(48*time.Hour, ch.Settings.MissingAfter)
+	suite.Equal(720*time.Hour, ch.Settings.MaxAge)
+
+	// Assert response
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","settings":{"missing_after":"48h0m0s","missing_after_imports":3,"max_age":"720h0m0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"","employment_type":"","schedule":null,"priority":1},"created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSettings_InvalidDurationFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/settings", strings.NewReader(`{"missing_after":"two days"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "failed to parse missing_after two days")
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSettings_NegativeFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/settings", strings.NewReader(`{"missing_after_imports":-2}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "missing grace period cannot be negative")
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSettings_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+uuid.New().String()+"/settings", strings.NewReader(`{"missing_after_imports":2,"max_age":"0s"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSettings_InvalidMaxAgeFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/settings", strings.NewReader(`{"max_age":"a month"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "failed to parse max_age a month")
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelEnrichers_Success() {
+	// Prepare
+	id := uuid.New()
+	cat := time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)
+	uat := time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithNoopEnrichers("salary", "location"),
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelTimestamps(cat, uat),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MissingAfterImports: 2}),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/enrichers", strings.NewReader(`{"enrichers":["salary","location"]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert state change
+	ch := dsl.FirstChannel()
+	suite.Equal([]string{"salary", "location"}, ch.Settings.Enrichers)
+	suite.Equal(2, ch.Settings.MissingAfterImports)
+
+	// Assert response
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","settings":{"missing_after":"0s","missing_after_imports":2,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":["salary","location"],"languages":[],"seniority":"","employment_type":"","schedule":null,"priority":1},"created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelEnrichers_DuplicateFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithNoopEnrichers("salary"),
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/enrichers", strings.NewReader(`{"enrichers":["salary","salary"]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "enrichers cannot be configured more than once")
+	suite.Empty(dsl.FirstChannel().Settings.Enrichers)
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelEnrichers_UnknownFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/enrichers", strings.NewReader(`{"enrichers":["horoscope"]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "failed to find enricher horoscope: unknown enricher")
+	suite.Empty(dsl.FirstChannel().Settings.Enrichers)
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelEnrichers_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+uuid.New().String()+"/enrichers", strings.NewReader(`{"enrichers":["salary"]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelLanguages_Success() {
+	// Prepare
+	id := uuid.New()
+	cat := time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)
+	uat := time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelTimestamps(cat, uat),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Enrichers: []string{"salary"}}),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/languages", strings.NewReader(`{"languages":["de","en"]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert state change
+	ch := dsl.FirstChannel()
+	suite.Equal([]string{"de", "en"}, ch.Settings.Languages)
+	suite.Equal([]string{"salary"}, ch.Settings.Enrichers)
+
+	// Assert response
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":["salary"],"languages":["de","en"],"seniority":"","employment_type":"","schedule":null,"priority":1},"created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelLanguages_UnsupportedFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/languages", strings.NewReader(`{"languages":["xx"]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "language is not supported")
+	suite.Empty(dsl.FirstChannel().Settings.Languages)
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelLanguages_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+uuid.New().String()+"/languages", strings.NewReader(`{"languages":["de"]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_GetChannelRules_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Rules: []*aggregator.Rule{
+				{Name: "remote only", Action: aggregator.RuleActionInclude, Condition: &aggregator.RuleCondition{Field: "remote", Op: "equals", Value: "true"}},
+			}}),
+		),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/channels/"+id.String()+"/rules", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"rules":[{"name":"remote only","action":"include","condition":{"field":"remote","op":"equals","value":"true"}}]}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_GetChannelRules_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("GET", "/api/channels/"+uuid.New().String()+"/rules", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelRules_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	body := `{"rules":[{"name":"no students","action":"exclude","condition":{"any":[{"field":"title","op":"matches","value":"praktikum|werkstudent"},{"not":{"field":"company","op":"in","values":["ACME","Hays"]}}]}}]}`
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/rules", strings.NewReader(body))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert state change
+	suite.Equal([]*aggregator.Rule{
+		{Name: "no students", Action: aggregator.RuleActionExclude, Condition: &aggregator.RuleCondition{Any: []*aggregator.RuleCondition{
+			{Field: "title", Op: "matches", Value: "praktikum|werkstudent"},
+			{Not: &aggregator.RuleCondition{Field: "company", Op: "in", Values: []string{"ACME", "Hays"}}},
+		}}},
+	}, dsl.FirstChannel().Settings.Rules)
+
+	// Assert response
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(body+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelRules_InvalidActionFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/rules", strings.NewReader(`{"rules":[{"name":"r","action":"drop","condition":{"field":"title","op":"exists"}}]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"invalid rule action drop"}}`+"\n", rr.Body.String())
+	suite.Empty(dsl.FirstChannel().Settings.Rules)
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelRules_InvalidRuleFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/rules", strings.NewReader(`{"rules":[{"name":"r","action":"exclude","condition":{"field":"salary","op":"exists"}}]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"failed to update rules of channel: invalid rules\nrule r: unknown field \"salary\""}}`+"\n", rr.Body.String())
+	suite.Empty(dsl.FirstChannel().Settings.Rules)
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelRules_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+uuid.New().String()+"/rules", strings.NewReader(`{"rules":[]}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelClassification_Success() {
+	// Prepare
+	id := uuid.New()
+	cat := time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)
+	uat := time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelTimestamps(cat, uat),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/classification", strings.NewReader(`{"seniority":"intern","employment_type":"working_student"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert state change
+	ch := dsl.FirstChannel()
+	suite.Equal("intern", ch.Settings.Seniority)
+	suite.Equal("working_student", ch.Settings.EmploymentType)
+
+	// Assert response
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"intern","employment_type":"working_student","schedule":null,"priority":1},"created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelClassification_InvalidFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/classification", strings.NewReader(`{"seniority":"guru","employment_type":""}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"failed to update classification of channel: invalid seniority"}}`+"\n", rr.Body.String())
+	suite.Empty(dsl.FirstChannel().Settings.Seniority)
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelClassification_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+uuid.New().String()+"/classification", strings.NewReader(`{"seniority":"senior"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSchedule_Success() {
+	// Prepare
+	id := uuid.New()
+	cat := time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)
+	uat := time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelTimestamps(cat, uat),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/import-schedule", strings.NewReader(`{"interval":"2h","jitter":"10m","catch_up":"skip"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert state change
+	ch := dsl.FirstChannel()
+	suite.Equal(&aggregator.ChannelSchedule{Interval: 2 * time.Hour, Jitter: 10 * time.Minute, CatchUp: "skip"}, ch.Settings.Schedule)
+
+	// Assert response
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"","employment_type":"","schedule":{"cron":"","interval":"2h0m0s","jitter":"10m0s","catch_up":"skip"},"priority":1},"created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSchedule_InvalidDurationFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/import-schedule", strings.NewReader(`{"interval":"often"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"failed to parse interval often: time: invalid duration \"often\""}}`+"\n", rr.Body.String())
+	suite.Nil(dsl.FirstChannel().Settings.Schedule)
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSchedule_InvalidFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/import-schedule", strings.NewReader(`{"cron":"@daily","interval":"1h"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"failed to update schedule of channel: invalid schedule\na cron expression and an interval cannot be combined"}}`+"\n", rr.Body.String())
+	suite.Nil(dsl.FirstChannel().Settings.Schedule)
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelSchedule_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+uuid.New().String()+"/import-schedule", strings.NewReader(`{"cron":"@daily"}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelPriority_Success() {
+	// Prepare
+	id := uuid.New()
+	cat := time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)
+	uat := time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelTimestamps(cat, uat),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/priority", strings.NewReader(`{"priority":7}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert state change
+	ch := dsl.FirstChannel()
+	suite.Equal(7, ch.Settings.Priority)
+
+	// Assert response
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","name":"channel 1","integration":"arbeitnow","status":"active","settings":{"missing_after":"0s","missing_after_imports":0,"max_age":"0s","max_missing_percentage":0,"min_quality_score":0,"enrichers":[],"languages":[],"seniority":"","employment_type":"","schedule":null,"priority":7},"created_at":"`+cat.Format(time.RFC3339)+`","updated_at":"`+ch.UpdatedAt.Format(time.RFC3339)+`"}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelPriority_InvalidFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+id.String()+"/priority", strings.NewReader(`{"priority":-1}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"failed to update priority of channel: priority must be between 1 and 10"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ChannelHandlerSuite) Test_UpdateChannelPriority_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/channels/"+uuid.New().String()+"/priority", strings.NewReader(`{"priority":3}`))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"channel not found"}}`+"\n", rr.Body.String())
+}
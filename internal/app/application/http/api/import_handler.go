@@ -8,8 +8,10 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/errs"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 )
@@ -22,13 +24,15 @@ type ImportRepository interface {
 type ImportHandler struct {
 	ir  ImportRepository
 	chr ChannelRepository
+	ss  *scheduling.Service
 	log *slog.Logger
 }
 
-func NewImportHandler(chr ChannelRepository, ir ImportRepository, log *slog.Logger) *ImportHandler {
+func NewImportHandler(chr ChannelRepository, ir ImportRepository, ss *scheduling.Service, log *slog.Logger) *ImportHandler {
 	return &ImportHandler{
 		chr: chr,
 		ir:  ir,
+		ss:  ss,
 		log: log,
 	}
 }
@@ -38,6 +42,10 @@ func (h *ImportHandler) Routes() http.Handler {
 
 	r.Get("/", h.ListImports)
 	r.Get("/{id}", h.FindImport)
+	r.Put("/{id}/resume", h.ResumeImport)
+	r.Post("/{id}/replay", h.ReplayImport)
+	r.Put("/{id}/approve", h.ApproveImport)
+	r.Put("/{id}/discard", h.DiscardImport)
 
 	return r
 }
@@ -103,6 +111,187 @@ func (h *ImportHandler) FindImport(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *ImportHandler) ResumeImport(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		h.handleFail(w, errors.New("missing import id"), http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("invalid import id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	i, err := h.ss.ResumeImport(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, scheduling.ErrImportNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to resume import %s: %w", idStr, err))
+		return
+	}
+
+	ch, err := h.chr.Find(r.Context(), i.ChannelID)
+	if err != nil {
+		h.handleError(w, fmt.Errorf("failed to find channel: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewImportResponse(i, ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *ImportHandler) ReplayImport(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		h.handleFail(w, errors.New("missing import id"), http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("invalid import id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	i, err := h.ss.ScheduleReplay(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, scheduling.ErrImportNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to replay import %s: %w", idStr, err))
+		return
+	}
+
+	ch, err := h.chr.Find(r.Context(), i.ChannelID)
+	if err != nil {
+		h.handleError(w, fmt.Errorf("failed to find channel: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	resp := NewImportResponse(i, ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *ImportHandler) ApproveImport(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		h.handleFail(w, errors.New("missing import id"), http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("invalid import id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	i, err := h.ss.ApproveImport(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, scheduling.ErrImportNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, scheduling.ErrImportSuperseded) {
+			h.handleFail(w, err, http.StatusConflict)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to approve import %s: %w", idStr, err))
+		return
+	}
+
+	ch, err := h.chr.Find(r.Context(), i.ChannelID)
+	if err != nil {
+		h.handleError(w, fmt.Errorf("failed to find channel: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewImportResponse(i, ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *ImportHandler) DiscardImport(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		h.handleFail(w, errors.New("missing import id"), http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("invalid import id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	i, err := h.ss.DiscardImport(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, scheduling.ErrImportNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		if errs.IsValidationError(err) {
+			h.handleFail(w, err, http.StatusBadRequest)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to discard import %s: %w", idStr, err))
+		return
+	}
+
+	ch, err := h.chr.Find(r.Context(), i.ChannelID)
+	if err != nil {
+		h.handleError(w, fmt.Errorf("failed to find channel: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewImportResponse(i, ch)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
 func (h *ImportHandler) handleFail(w http.ResponseWriter, err error, code int) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
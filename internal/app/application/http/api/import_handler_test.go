@@ -6,6 +6,7 @@ import (
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/guregu/null.v3"
 	oghttp "net/http"
 	"net/http/httptest"
 	"testing"
@@ -74,7 +75,7 @@ func (suite *ImportHandlerSuite) Test_List_Success() {
 	// Assert
 	suite.Equal(oghttp.StatusOK, rr.Code)
 	suite.Equal("application/json", rr.Header().Get("Content-Type"))
-	suite.Equal(`{"imports":[{"id":"`+id1.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"completed","started_at":"2020-01-01T00:00:03Z","ended_at":"2020-01-01T00:00:04Z","error":"happened this error","new_jobs":1,"updated_jobs":2,"no_change_jobs":3,"missing_jobs":4,"total_jobs":6,"errors":5,"published":6,"late_published":7,"missing_published":8},{"id":"`+id2.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"pending","started_at":"2020-01-01T00:00:02Z","ended_at":null,"error":null,"new_jobs":0,"updated_jobs":0,"no_change_jobs":0,"missing_jobs":0,"total_jobs":0,"errors":0,"published":0,"late_published":0,"missing_published":0},{"id":"`+id3.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"pending","started_at":"2020-01-01T00:00:01Z","ended_at":null,"error":null,"new_jobs":0,"updated_jobs":0,"no_change_jobs":0,"missing_jobs":0,"total_jobs":0,"errors":0,"published":0,"late_published":0,"missing_published":0}]}`+"\n", rr.Body.String())
+	suite.Equal(`{"imports":[{"id":"`+id1.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"completed","started_at":"2020-01-01T00:00:03Z","ended_at":"2020-01-01T00:00:04Z","error":"happened this error","new_jobs":1,"updated_jobs":2,"no_change_jobs":3,"missing_jobs":4,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":6,"errors":5,"published":6,"late_published":7,"missing_published":8,"held_published":0},{"id":"`+id2.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"pending","started_at":"2020-01-01T00:00:02Z","ended_at":null,"error":null,"new_jobs":0,"updated_jobs":0,"no_change_jobs":0,"missing_jobs":0,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":0,"errors":0,"published":0,"late_published":0,"missing_published":0,"held_published":0},{"id":"`+id3.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"pending","started_at":"2020-01-01T00:00:01Z","ended_at":null,"error":null,"new_jobs":0,"updated_jobs":0,"no_change_jobs":0,"missing_jobs":0,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":0,"errors":0,"published":0,"late_published":0,"missing_published":0,"held_published":0}]}`+"\n", rr.Body.String())
 
 	// Assert log
 	suite.Empty(dsl.LogLines())
@@ -253,7 +254,7 @@ func (suite *ImportHandlerSuite) Test_Find_Metric_Success() {
 	// Assert
 	suite.Equal(oghttp.StatusOK, rr.Code)
 	suite.Equal("application/json", rr.Header().Get("Content-Type"))
-	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"completed","started_at":"2020-01-01T00:00:01Z","ended_at":"2020-01-01T00:00:02Z","error":"happened this error","new_jobs":1,"updated_jobs":2,"no_change_jobs":3,"missing_jobs":4,"total_jobs":6,"errors":5,"published":6,"late_published":7,"missing_published":8}`+"\n", rr.Body.String())
+	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"completed","started_at":"2020-01-01T00:00:01Z","ended_at":"2020-01-01T00:00:02Z","error":"happened this error","new_jobs":1,"updated_jobs":2,"no_change_jobs":3,"missing_jobs":4,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":6,"errors":5,"published":6,"late_published":7,"missing_published":8,"held_published":0}`+"\n", rr.Body.String())
 
 	// Assert log
 	suite.Empty(dsl.LogLines())
@@ -297,7 +298,7 @@ func (suite *ImportHandlerSuite) Test_Find_Metadata_Success() {
 	// Assert
 	suite.Equal(oghttp.StatusOK, rr.Code)
 	suite.Equal("application/json", rr.Header().Get("Content-Type"))
-	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"completed","started_at":"2020-01-01T00:00:01Z","ended_at":"2020-01-01T00:00:02Z","error":"happened this error","new_jobs":1,"updated_jobs":2,"no_change_jobs":3,"missing_jobs":4,"total_jobs":6,"errors":5,"published":6,"late_published":7,"missing_published":8}`+"\n", rr.Body.String())
+	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"completed","started_at":"2020-01-01T00:00:01Z","ended_at":"2020-01-01T00:00:02Z","error":"happened this error","new_jobs":1,"updated_jobs":2,"no_change_jobs":3,"missing_jobs":4,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":6,"errors":5,"published":6,"late_published":7,"missing_published":8,"held_published":0}`+"\n", rr.Body.String())
 
 	// Assert log
 	suite.Empty(dsl.LogLines())
@@ -349,7 +350,7 @@ func (suite *ImportHandlerSuite) Test_Find_WithMetadataAndMetric_Success() {
 	// Assert
 	suite.Equal(oghttp.StatusOK, rr.Code)
 	suite.Equal("application/json", rr.Header().Get("Content-Type"))
-	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"completed","started_at":"2020-01-01T00:00:01Z","ended_at":"2020-01-01T00:00:02Z","error":"happened this error","new_jobs":1,"updated_jobs":2,"no_change_jobs":3,"missing_jobs":4,"total_jobs":6,"errors":5,"published":6,"late_published":7,"missing_published":8}`+"\n", rr.Body.String())
+	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"completed","started_at":"2020-01-01T00:00:01Z","ended_at":"2020-01-01T00:00:02Z","error":"happened this error","new_jobs":1,"updated_jobs":2,"no_change_jobs":3,"missing_jobs":4,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":6,"errors":5,"published":6,"late_published":7,"missing_published":8,"held_published":0}`+"\n", rr.Body.String())
 
 	// Assert log
 	suite.Empty(dsl.LogLines())
@@ -422,3 +423,361 @@ func (suite *ImportHandlerSuite) Test_Find_ImportRepositoryFail() {
 	suite.Contains(lines[0], `"level":"ERROR"`)
 	suite.Contains(lines[0], `failed to find import `+id.String()+`: boom`)
 }
+
+func (suite *ImportHandlerSuite) Test_Find_WithCheckpoint_Success() {
+	// Prepare
+	chID := uuid.New()
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelName("Channel Name"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC)),
+			testutils.WithImportEndedAt(time.Date(2020, 1, 1, 0, 0, 2, 0, time.UTC)),
+			testutils.WithImportError("happened this error"),
+		),
+	)
+	dsl.ImportRepository.AddCheckpoint(&aggregator.ImportCheckpoint{
+		ImportID:  id,
+		NextLink:  null.StringFrom("https://www.arbeitnow.com/api/job-board-api?page=3"),
+		Pages:     2,
+		Jobs:      200,
+		UpdatedAt: time.Date(2020, 1, 1, 0, 0, 2, 0, time.UTC),
+	})
+
+	req, err := oghttp.NewRequest("GET", "/api/imports/"+id.String(), nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"failed","started_at":"2020-01-01T00:00:01Z","ended_at":"2020-01-01T00:00:02Z","error":"happened this error","new_jobs":0,"updated_jobs":0,"no_change_jobs":0,"missing_jobs":0,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":0,"errors":0,"published":0,"late_published":0,"missing_published":0,"held_published":0,"checkpoint":{"next_link":"https://www.arbeitnow.com/api/job-board-api?page=3","updated_at":"2020-01-01T00:00:02Z","pages":2,"jobs":200,"completed":false}}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ImportHandlerSuite) Test_Resume_Success() {
+	// Prepare
+	chID := uuid.New()
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelName("Channel Name"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC)),
+			testutils.WithImportEndedAt(time.Date(2020, 1, 1, 0, 0, 2, 0, time.UTC)),
+			testutils.WithImportError("happened this error"),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/imports/"+id.String()+"/resume", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"pending","started_at":"2020-01-01T00:00:01Z","ended_at":null,"error":null,"new_jobs":0,"updated_jobs":0,"no_change_jobs":0,"missing_jobs":0,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":0,"errors":0,"published":0,"late_published":0,"missing_published":0,"held_published":0}`+"\n", rr.Body.String())
+
+	// Assert state change
+	suite.Equal(aggregator.ImportStatusPending, dsl.FirstImport().Status)
+	suite.Len(dsl.PublishedImports(), 1)
+	suite.Equal(id, dsl.PublishedImports()[0])
+}
+
+func (suite *ImportHandlerSuite) Test_Resume_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/imports/"+uuid.New().String()+"/resume", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"error":{"message":"import not found"}}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ImportHandlerSuite) Test_Resume_NotFailedFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/imports/"+id.String()+"/resume", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Contains(rr.Body.String(), "only failed imports can be resumed")
+
+	// Assert state change
+	suite.Equal(aggregator.ImportStatusCompleted, dsl.FirstImport().Status)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ImportHandlerSuite) Test_Replay_Success() {
+	// Prepare
+	chID := uuid.New()
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelName("Channel Name"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+			testutils.WithImportCheckpoint(2, true),
+		),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/imports/"+id.String()+"/replay", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusCreated, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Contains(rr.Body.String(), `"channel_id":"`+chID.String()+`"`)
+	suite.Contains(rr.Body.String(), `"status":"pending"`)
+	suite.Contains(rr.Body.String(), `"replay_of":"`+id.String()+`"`)
+
+	// Assert state change
+	suite.Len(dsl.Imports(), 2)
+	suite.Len(dsl.PublishedImports(), 1)
+}
+
+func (suite *ImportHandlerSuite) Test_Replay_NotFinishedFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportStatus(aggregator.ImportStatusFetching),
+		),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/imports/"+id.String()+"/replay", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "only finished imports can be replayed")
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ImportHandlerSuite) Test_Replay_PartialArchiveFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+			testutils.WithImportCheckpoint(1, false),
+		),
+	)
+
+	req, err := oghttp.NewRequest("POST", "/api/imports/"+id.String()+"/replay", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "only imports that fetched every page can be replayed")
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ImportHandlerSuite) Test_Approve_Success() {
+	// Prepare
+	chID := uuid.New()
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelName("Channel Name"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusNeedsReview),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC)),
+			testutils.WithImportEndedAt(time.Date(2020, 1, 1, 0, 0, 2, 0, time.UTC)),
+			testutils.WithImportError("2 of 2 active jobs (100%) would be marked missing, more than the allowed 50%"),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/imports/"+id.String()+"/approve", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","channel_name":"Channel Name","integration":"arbeitnow","status":"pending","started_at":"2020-01-01T00:00:01Z","ended_at":null,"error":null,"new_jobs":0,"updated_jobs":0,"no_change_jobs":0,"missing_jobs":0,"pending_missing_jobs":0,"expired_jobs":0,"filtered_jobs":0,"blocked_jobs":0,"dead_link_jobs":0,"total_jobs":0,"errors":0,"published":0,"late_published":0,"missing_published":0,"held_published":0,"approved":true}`+"\n", rr.Body.String())
+
+	// Assert state change
+	suite.Equal(aggregator.ImportStatusPending, dsl.FirstImport().Status)
+	suite.Len(dsl.PublishedImports(), 1)
+	suite.Equal(id, dsl.PublishedImports()[0])
+}
+
+func (suite *ImportHandlerSuite) Test_Approve_NotInReviewFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/imports/"+id.String()+"/approve", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), "only imports that need review can be approved or discarded")
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ImportHandlerSuite) Test_Approve_SupersededFail() {
+	// Prepare
+	chID := uuid.New()
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusNeedsReview),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		),
+		testutils.WithImport(
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/imports/"+id.String()+"/approve", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusConflict, rr.Code)
+	suite.Contains(rr.Body.String(), "a newer import of the channel completed, the import can only be discarded")
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ImportHandlerSuite) Test_Discard_Success() {
+	// Prepare
+	chID := uuid.New()
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelName("Channel Name"),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(id),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusNeedsReview),
+		),
+	)
+
+	req, err := oghttp.NewRequest("PUT", "/api/imports/"+id.String()+"/discard", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Contains(rr.Body.String(), `"status":"discarded"`)
+	suite.Contains(rr.Body.String(), `"error":"discarded after review"`)
+
+	// Assert state change
+	suite.Equal(aggregator.ImportStatusDiscarded, dsl.FirstImport().Status)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ImportHandlerSuite) Test_Discard_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("PUT", "/api/imports/"+uuid.New().String()+"/discard", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"import not found"}}`+"\n", rr.Body.String())
+}
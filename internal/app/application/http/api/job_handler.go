@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/skills"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultJobsLimit = 100
+	maxJobsLimit     = 1000
+)
+
+type JobRepository interface {
+	GetJobs(ctx context.Context, f *aggregator.JobFilter) ([]*aggregator.Job, error)
+	Find(ctx context.Context, id uuid.UUID) (*aggregator.Job, error)
+	GetVersions(ctx context.Context, jobID uuid.UUID) ([]*aggregator.JobVersion, error)
+}
+
+type JobHandler struct {
+	jr  JobRepository
+	log *slog.Logger
+}
+
+func NewJobHandler(jr JobRepository, log *slog.Logger) *JobHandler {
+	return &JobHandler{
+		jr:  jr,
+		log: log,
+	}
+}
+
+func (h *JobHandler) Routes() http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/", h.ListJobs)
+	r.Get("/{id}", h.FindJob)
+	r.Get("/{id}/history", h.JobHistory)
+
+	return r
+}
+
+func (h *JobHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	f, err := parseJobFilter(r.URL.Query())
+	if err != nil {
+		h.handleFail(w, err, http.StatusBadRequest)
+		return
+	}
+
+	jj, err := h.jr.GetJobs(r.Context(), f)
+	if err != nil {
+		h.handleError(w, fmt.Errorf("failed to get jobs: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewJobsResponse(jj)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func parseJobFilter(q url.Values) (*aggregator.JobFilter, error) {
+	f := &aggregator.JobFilter{
+		CountryCode: strings.ToUpper(q.Get("country")),
+		Region:      q.Get("region"),
+		City:        q.Get("city"),
+		Language:    strings.ToLower(q.Get("language")),
+		Limit:       defaultJobsLimit,
+	}
+
+	if v := q.Get("channel_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel id: %w", err)
+		}
+		f.ChannelID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	if v := q.Get("status"); v != "" {
+		var s aggregator.JobStatus
+		switch v {
+		case aggregator.JobStatusActive.String():
+			s = aggregator.JobStatusActive
+		case aggregator.JobStatusInactive.String():
+			s = aggregator.JobStatusInactive
+		default:
+			return nil, fmt.Errorf("invalid status %s", v)
+		}
+		f.Status = &s
+	}
+
+	if v := q.Get("remote_scope"); v != "" {
+		s, ok := aggregator.ParseRemoteScope(v)
+		if !ok {
+			return nil, fmt.Errorf("invalid remote scope %s", v)
+		}
+		f.RemoteScope = &s
+	}
+
+	if v := q.Get("seniority"); v != "" {
+		s, ok := aggregator.ParseSeniority(v)
+		if !ok {
+			return nil, fmt.Errorf("invalid seniority %s", v)
+		}
+		f.Seniority = &s
+	}
+
+	if v := q.Get("employment_type"); v != "" {
+		t, ok := aggregator.ParseEmploymentType(v)
+		if !ok {
+			return nil, fmt.Errorf("invalid employment type %s", v)
+		}
+		f.EmploymentType = &t
+	}
+
+	// Skills are matched on their canonical name, so synonyms like golang find Go
+	for _, v := range q["skill"] {
+		if name, ok := skills.Canonical(v); ok {
+			v = name
+		}
+		f.Skills = append(f.Skills, v)
+	}
+
+	if v := q.Get("min_quality"); v != "" {
+		score, err := strconv.Atoi(v)
+		if err != nil || score < 0 || score > 100 {
+			return nil, errors.New("min quality must be a number between 0 and 100")
+		}
+		f.MinQuality = score
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxJobsLimit {
+			return nil, fmt.Errorf("limit must be a number between 1 and %d", maxJobsLimit)
+		}
+		f.Limit = limit
+	}
+
+	return f, nil
+}
+
+func (h *JobHandler) FindJob(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		h.handleFail(w, errors.New("missing job id"), http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("invalid job id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	j, err := h.jr.Find(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrJobNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to find job %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewJobResponse(j)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *JobHandler) JobHistory(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		h.handleFail(w, errors.New("missing job id"), http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("invalid job id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	j, err := h.jr.Find(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrJobNotFound) {
+			h.handleFail(w, err, http.StatusNotFound)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to find job %s: %w", idStr, err))
+		return
+	}
+
+	vv, err := h.jr.GetVersions(r.Context(), j.ID)
+	if err != nil {
+		h.handleError(w, fmt.Errorf("failed to get versions of job %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewJobHistoryResponse(j, vv)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *JobHandler) handleFail(w http.ResponseWriter, err error, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	resp := NewErrorResponse(err)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error(err.Error(), slog.Any("Error", err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *JobHandler) handleError(w http.ResponseWriter, err error) {
+	h.log.Error(err.Error(), slog.Any("Error", err))
+
+	h.handleFail(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+}
@@ -0,0 +1,373 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	oghttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/application/http/api"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestJobHandler(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(JobHandlerSuite))
+}
+
+type JobHandlerSuite struct {
+	suite.Suite
+}
+
+func (suite *JobHandlerSuite) Test_List_Success() {
+	// Prepare
+	id1 := uuid.New()
+	id2 := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(id1),
+			testutils.WithJobPostedAt(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(id2),
+			testutils.WithJobPostedAt(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
+		),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	var resp api.JobsResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.Len(resp.Jobs, 2)
+	suite.Equal(id2.String(), resp.Jobs[0].ID)
+	suite.Equal(id1.String(), resp.Jobs[1].ID)
+	suite.Equal(&api.GeoResponse{City: "Munich", Region: "Bavaria", CountryCode: "DE", Latitude: ptr(48.13743), Longitude: ptr(11.57549), RemoteScope: "country"}, resp.Jobs[0].Geo)
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *JobHandlerSuite) Test_List_Filters_Success() {
+	// Prepare
+	chID := uuid.New()
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(id),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobLocation("Remote - EU"),
+			testutils.WithJobGeo(aggregator.Geo{RemoteScope: aggregator.RemoteScopeEU}),
+		),
+		testutils.WithJob(
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobLocation("Berlin"),
+			testutils.WithJobGeo(aggregator.Geo{City: "Berlin", Region: "Berlin", CountryCode: "DE"}),
+			testutils.WithJobLanguage("en"),
+			testutils.WithJobQuality(&aggregator.Quality{Score: 40}),
+			testutils.WithJobSkills(aggregator.Skills{{Name: "Go", Count: 2}, {Name: "Excel", Count: 1}}),
+			testutils.WithJobClassification(aggregator.Classification{Seniority: aggregator.SenioritySenior, EmploymentType: aggregator.EmploymentTypeContract}),
+		),
+		testutils.WithJob(),
+	)
+
+	cases := []struct {
+		query    string
+		expected int
+	}{
+		{query: "country=de", expected: 2},
+		{query: "country=DE&city=berlin", expected: 1},
+		{query: "region=Bavaria", expected: 1},
+		{query: "remote_scope=eu", expected: 1},
+		{query: "channel_id=" + chID.String(), expected: 2},
+		{query: "channel_id=" + chID.String() + "&remote_scope=eu&status=active", expected: 1},
+		{query: "status=inactive", expected: 0},
+		{query: "language=en", expected: 1},
+		{query: "language=DE", expected: 2},
+		{query: "min_quality=50", expected: 2},
+		{query: "skill=golang", expected: 1},
+		{query: "skill=Go&skill=excel", expected: 1},
+		{query: "skill=excel", expected: 3},
+		{query: "skill=rust", expected: 0},
+		{query: "seniority=senior", expected: 1},
+		{query: "seniority=mid&employment_type=full_time", expected: 2},
+		{query: "employment_type=internship", expected: 0},
+		{query: "limit=1", expected: 1},
+	}
+
+	for _, c := range cases {
+		req, err := oghttp.NewRequest("GET", "/api/jobs?"+c.query, nil)
+		suite.NoError(err)
+		rr := httptest.NewRecorder()
+
+		// Execute
+		dsl.APIServer.ServeHTTP(rr, req)
+
+		// Assert
+		suite.Equal(oghttp.StatusOK, rr.Code, c.query)
+		var resp api.JobsResponse
+		suite.NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+		suite.Len(resp.Jobs, c.expected, c.query)
+	}
+}
+
+func (suite *JobHandlerSuite) Test_List_InvalidFilterFail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	cases := map[string]string{
+		"remote_scope=mars": `{"error":{"message":"invalid remote scope mars"}}`,
+		"status=gone":       `{"error":{"message":"invalid status gone"}}`,
+		"limit=0":           `{"error":{"message":"limit must be a number between 1 and 1000"}}`,
+		"channel_id=abc":    `{"error":{"message":"invalid channel id: invalid UUID length: 3"}}`,
+		"min_quality=101":   `{"error":{"message":"min quality must be a number between 0 and 100"}}`,
+		"seniority=guru":    `{"error":{"message":"invalid seniority guru"}}`,
+		"employment_type=x": `{"error":{"message":"invalid employment type x"}}`,
+	}
+
+	for query, expected := range cases {
+		req, err := oghttp.NewRequest("GET", "/api/jobs?"+query, nil)
+		suite.NoError(err)
+		rr := httptest.NewRecorder()
+
+		// Execute
+		dsl.APIServer.ServeHTTP(rr, req)
+
+		// Assert
+		suite.Equal(oghttp.StatusBadRequest, rr.Code, query)
+		suite.Equal(expected+"\n", rr.Body.String(), query)
+	}
+}
+
+func (suite *JobHandlerSuite) Test_List_JobRepositoryFail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithJobRepositoryError(errors.New("boom")),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusInternalServerError, rr.Code)
+	suite.Equal(`{"error":{"message":"Internal Server Error"}}`+"\n", rr.Body.String())
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to get jobs: boom")
+}
+
+func (suite *JobHandlerSuite) Test_Find_Success() {
+	// Prepare
+	chID := uuid.New()
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(id),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobURL("https://example.com/job"),
+			testutils.WithJobTitle("Job Title"),
+			testutils.WithJobDescription("<p>Job <strong>Description</strong></p>"),
+			testutils.WithJobDescriptionRenderings("Job Description", "Job **Description**"),
+			testutils.WithJobPostedAt(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			testutils.WithJobQuality(&aggregator.Quality{Score: 60, Signals: []*aggregator.QualitySignal{{Name: "description_length", Penalty: 40, Reason: "description has only 15 characters"}}}),
+		),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs/"+id.String(), nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"id":"`+id.String()+`","channel_id":"`+chID.String()+`","status":"active","publish_status":"published","url":"https://example.com/job","title":"Job Title","description":"\u003cp\u003eJob \u003cstrong\u003eDescription\u003c/strong\u003e\u003c/p\u003e","description_text":"Job Description","description_markdown":"Job **Description**","source":"arbeitnow","company":"OPUS ONE Recruitment GmbH","location":"Munich","language":"de","geo":{"city":"Munich","region":"Bavaria","country_code":"DE","latitude":48.13743,"longitude":11.57549,"remote_scope":"country"},"classification":{"seniority":"mid","seniority_confidence":0.3,"employment_type":"full_time","employment_type_confidence":0.3},"quality":{"score":60,"signals":[{"name":"description_length","penalty":40,"reason":"description has only 15 characters"}]},"skills":[{"name":"Excel","count":1}],"remote":true,"posted_at":"2025-01-01T00:00:00Z"}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *JobHandlerSuite) Test_Find_WithSalary_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(id),
+			testutils.WithJobSalary(&aggregator.Salary{Currency: "EUR", Min: 3500, Max: 4000, AnnualMin: 42000, AnnualMax: 48000, Period: aggregator.SalaryPeriodMonth, Net: true}),
+		),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs/"+id.String(), nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"salary":{"currency":"EUR","period":"month","type":"net","min":3500,"max":4000,"annual_min":42000,"annual_max":48000}`)
+}
+
+func (suite *JobHandlerSuite) Test_Find_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs/"+uuid.New().String(), nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"error":{"message":"job not found"}}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *JobHandlerSuite) Test_History_Success() {
+	// Prepare
+	id := uuid.New()
+	iID1 := uuid.New()
+	iID2 := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(id),
+		),
+		testutils.WithJobVersion(id, iID1, aggregator.ImportMetricTypeNew),
+		testutils.WithJobVersion(id, iID2, aggregator.ImportMetricTypeUpdated,
+			&aggregator.JobFieldChange{Field: "title", Old: "Old Title", New: "New Title"},
+			&aggregator.JobFieldChange{Field: "remote", Old: "false", New: "true"},
+		),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs/"+id.String()+"/history", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"job_id":"`+id.String()+`","versions":[`+
+		`{"import_id":"`+iID1.String()+`","reason":"new","created_at":"2025-01-01T00:03:00Z","changes":[],"version":1},`+
+		`{"import_id":"`+iID2.String()+`","reason":"updated","created_at":"2025-01-01T00:03:00Z","changes":[{"field":"title","old":"Old Title","new":"New Title"},{"field":"remote","old":"false","new":"true"}],"version":2}`+
+		`]}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *JobHandlerSuite) Test_History_NoVersions_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(id),
+		),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs/"+id.String()+"/history", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal(`{"job_id":"`+id.String()+`","versions":[]}`+"\n", rr.Body.String())
+}
+
+func (suite *JobHandlerSuite) Test_History_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs/"+uuid.New().String()+"/history", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"job not found"}}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *JobHandlerSuite) Test_History_InvalidIDFail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs/abc/history", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"invalid job id: invalid UUID length: 3"}}`+"\n", rr.Body.String())
+}
+
+func (suite *JobHandlerSuite) Test_History_JobRepositoryFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(id),
+		),
+		testutils.WithJobRepositoryError(errors.New("boom")),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/jobs/"+id.String()+"/history", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusInternalServerError, rr.Code)
+	suite.Equal(`{"error":{"message":"Internal Server Error"}}`+"\n", rr.Body.String())
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], `"level":"ERROR"`)
+	suite.Contains(lines[0], `failed to find job `+id.String()+`: boom`)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -1,5 +1,11 @@
 package api
 
+import (
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
 type createChannelRequest struct {
 	Name        string `json:"name"`
 	Integration string `json:"integration"`
@@ -8,3 +14,107 @@ type createChannelRequest struct {
 type updateChannelRequest struct {
 	Name string `json:"name"`
 }
+
+type updateChannelSettingsRequest struct {
+	MissingAfter         string  `json:"missing_after"`
+	MaxAge               string  `json:"max_age"`
+	MissingAfterImports  int     `json:"missing_after_imports"`
+	MaxMissingPercentage float64 `json:"max_missing_percentage"`
+	MinQualityScore      int     `json:"min_quality_score"`
+}
+
+type updateChannelEnrichersRequest struct {
+	Enrichers []string `json:"enrichers"`
+}
+
+type updateChannelClassificationRequest struct {
+	Seniority      string `json:"seniority"`
+	EmploymentType string `json:"employment_type"`
+}
+
+type updateChannelScheduleRequest struct {
+	Cron     string `json:"cron"`
+	Interval string `json:"interval"`
+	Jitter   string `json:"jitter"`
+	CatchUp  string `json:"catch_up"`
+}
+
+type updateChannelPriorityRequest struct {
+	Priority int `json:"priority"`
+}
+
+type updateChannelLanguagesRequest struct {
+	Languages []string `json:"languages"`
+}
+
+type addBlocklistEntryRequest struct {
+	Kind   string `json:"kind"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+type ruleConditionRequest struct {
+	Field  string                  `json:"field"`
+	Op     string                  `json:"op"`
+	Value  string                  `json:"value"`
+	Values []string                `json:"values"`
+	All    []*ruleConditionRequest `json:"all"`
+	Any    []*ruleConditionRequest `json:"any"`
+	Not    *ruleConditionRequest   `json:"not"`
+}
+
+func (c *ruleConditionRequest) toAggregator() *aggregator.RuleCondition {
+	if c == nil {
+		return nil
+	}
+
+	cond := &aggregator.RuleCondition{
+		Field:  c.Field,
+		Op:     c.Op,
+		Value:  c.Value,
+		Values: c.Values,
+		Not:    c.Not.toAggregator(),
+	}
+	if c.All != nil {
+		cond.All = make([]*aggregator.RuleCondition, 0, len(c.All))
+		for _, a := range c.All {
+			cond.All = append(cond.All, a.toAggregator())
+		}
+	}
+	if c.Any != nil {
+		cond.Any = make([]*aggregator.RuleCondition, 0, len(c.Any))
+		for _, a := range c.Any {
+			cond.Any = append(cond.Any, a.toAggregator())
+		}
+	}
+
+	return cond
+}
+
+type ruleRequest struct {
+	Name      string                `json:"name"`
+	Action    string                `json:"action"`
+	Condition *ruleConditionRequest `json:"condition"`
+}
+
+type updateChannelRulesRequest struct {
+	Rules []*ruleRequest `json:"rules"`
+}
+
+func (req *updateChannelRulesRequest) toAggregator() ([]*aggregator.Rule, error) {
+	result := make([]*aggregator.Rule, 0, len(req.Rules))
+	for _, r := range req.Rules {
+		action, ok := aggregator.ParseRuleAction(r.Action)
+		if !ok {
+			return nil, fmt.Errorf("invalid rule action %s", r.Action)
+		}
+
+		result = append(result, &aggregator.Rule{
+			Name:      r.Name,
+			Action:    action,
+			Condition: r.Condition.toAggregator(),
+		})
+	}
+
+	return result, nil
+}
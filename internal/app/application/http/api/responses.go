@@ -7,24 +7,135 @@ import (
 	"gopkg.in/guregu/null.v3"
 )
 
+type ChannelScheduleResponse struct {
+	Cron     string `json:"cron"`
+	Interval string `json:"interval"`
+	Jitter   string `json:"jitter"`
+	CatchUp  string `json:"catch_up"`
+}
+
+func NewChannelScheduleResponse(s *aggregator.ChannelSchedule) *ChannelScheduleResponse {
+	if s == nil {
+		return nil
+	}
+
+	return &ChannelScheduleResponse{
+		Cron:     s.Cron,
+		Interval: s.Interval.String(),
+		Jitter:   s.Jitter.String(),
+		CatchUp:  s.CatchUp,
+	}
+}
+
+type ChannelSettingsResponse struct {
+	MissingAfter         string                   `json:"missing_after"`
+	MissingAfterImports  int                      `json:"missing_after_imports"`
+	MaxAge               string                   `json:"max_age"`
+	MaxMissingPercentage float64                  `json:"max_missing_percentage"`
+	MinQualityScore      int                      `json:"min_quality_score"`
+	Enrichers            []string                 `json:"enrichers"`
+	Languages            []string                 `json:"languages"`
+	Seniority            string                   `json:"seniority"`
+	EmploymentType       string                   `json:"employment_type"`
+	Schedule             *ChannelScheduleResponse `json:"schedule"`
+	Priority             int                      `json:"priority"`
+}
+
 type ChannelResponse struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Integration string `json:"integration"`
-	Status      string `json:"status"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	Integration string                  `json:"integration"`
+	Status      string                  `json:"status"`
+	Settings    ChannelSettingsResponse `json:"settings"`
+	CreatedAt   string                  `json:"created_at"`
+	UpdatedAt   string                  `json:"updated_at"`
 }
 
 func NewChannelResponse(ch *aggregator.Channel) *ChannelResponse {
+	enrichers := make([]string, 0, len(ch.Settings.Enrichers))
+	enrichers = append(enrichers, ch.Settings.Enrichers...)
+	languages := make([]string, 0, len(ch.Settings.Languages))
+	languages = append(languages, ch.Settings.Languages...)
+
 	return &ChannelResponse{
 		ID:          ch.ID.String(),
 		Name:        ch.Name,
 		Integration: ch.Integration.String(),
 		Status:      ch.Status.String(),
-		CreatedAt:   ch.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   ch.UpdatedAt.Format(time.RFC3339),
+		Settings: ChannelSettingsResponse{
+			MissingAfter:         ch.Settings.MissingAfter.String(),
+			MissingAfterImports:  ch.Settings.MissingAfterImports,
+			MaxAge:               ch.Settings.MaxAge.String(),
+			MaxMissingPercentage: ch.Settings.MaxMissingPercentage,
+			MinQualityScore:      ch.Settings.MinQualityScore,
+			Enrichers:            enrichers,
+			Languages:            languages,
+			Seniority:            ch.Settings.Seniority,
+			EmploymentType:       ch.Settings.EmploymentType,
+			Schedule:             NewChannelScheduleResponse(ch.Settings.Schedule),
+			Priority:             ch.Settings.PriorityOrDefault(),
+		},
+		CreatedAt: ch.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: ch.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+type RuleConditionResponse struct {
+	Field  string                   `json:"field,omitempty"`
+	Op     string                   `json:"op,omitempty"`
+	Value  string                   `json:"value,omitempty"`
+	Values []string                 `json:"values,omitempty"`
+	All    []*RuleConditionResponse `json:"all,omitempty"`
+	Any    []*RuleConditionResponse `json:"any,omitempty"`
+	Not    *RuleConditionResponse   `json:"not,omitempty"`
+}
+
+func NewRuleConditionResponse(c *aggregator.RuleCondition) *RuleConditionResponse {
+	if c == nil {
+		return nil
+	}
+
+	resp := &RuleConditionResponse{
+		Field:  c.Field,
+		Op:     c.Op,
+		Value:  c.Value,
+		Values: c.Values,
+		Not:    NewRuleConditionResponse(c.Not),
+	}
+	for _, a := range c.All {
+		resp.All = append(resp.All, NewRuleConditionResponse(a))
+	}
+	for _, a := range c.Any {
+		resp.Any = append(resp.Any, NewRuleConditionResponse(a))
+	}
+
+	return resp
+}
+
+type RuleResponse struct {
+	Name      string                 `json:"name"`
+	Action    string                 `json:"action"`
+	Condition *RuleConditionResponse `json:"condition"`
+}
+
+type RulesResponse struct {
+	Rules []*RuleResponse `json:"rules"`
+}
+
+func NewRulesResponse(rules []*aggregator.Rule) *RulesResponse {
+	resp := &RulesResponse{
+		Rules: make([]*RuleResponse, 0, len(rules)),
+	}
+
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, &RuleResponse{
+			Name:      r.Name,
+			Action:    r.Action.String(),
+			Condition: NewRuleConditionResponse(r.Condition),
+		})
 	}
+
+	return resp
 }
 
 type ErrorResponse struct {
@@ -59,6 +170,52 @@ func NewListChannelsResponse(channels []*aggregator.Channel) *ListChannelsRespon
 	return resp
 }
 
+type BlocklistEntryResponse struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind"`
+	Value     string `json:"value"`
+	Reason    string `json:"reason"`
+	CreatedAt string `json:"created_at"`
+}
+
+func NewBlocklistEntryResponse(e *aggregator.BlocklistEntry) *BlocklistEntryResponse {
+	return &BlocklistEntryResponse{
+		ID:        e.ID.String(),
+		Kind:      e.Kind.String(),
+		Value:     e.Value,
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+type ListBlocklistResponse struct {
+	Entries []*BlocklistEntryResponse `json:"entries"`
+}
+
+func NewListBlocklistResponse(entries []*aggregator.BlocklistEntry) *ListBlocklistResponse {
+	resp := &ListBlocklistResponse{
+		Entries: make([]*BlocklistEntryResponse, 0, len(entries)),
+	}
+
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, NewBlocklistEntryResponse(e))
+	}
+
+	return resp
+}
+
+type AddBlocklistEntryResponse struct {
+	*BlocklistEntryResponse
+	UnpublishedJobs int `json:"unpublished_jobs"`
+}
+
+func NewAddBlocklistEntryResponse(e *aggregator.BlocklistEntry, unpublished int) *AddBlocklistEntryResponse {
+	return &AddBlocklistEntryResponse{
+		BlocklistEntryResponse: NewBlocklistEntryResponse(e),
+		UnpublishedJobs:        unpublished,
+	}
+}
+
 type IntegrationsResponse struct {
 	Integrations []string `json:"integrations"`
 }
@@ -75,24 +232,55 @@ func NewListIntegrationsResponse(integrations []aggregator.Integration) *Integra
 	return resp
 }
 
+type ImportCheckpointResponse struct {
+	NextLink  null.String `json:"next_link"`
+	UpdatedAt string      `json:"updated_at"`
+	Pages     int         `json:"pages"`
+	Jobs      int         `json:"jobs"`
+	Completed bool        `json:"completed"`
+}
+
+func NewImportCheckpointResponse(cp *aggregator.ImportCheckpoint) *ImportCheckpointResponse {
+	if cp == nil {
+		return nil
+	}
+
+	return &ImportCheckpointResponse{
+		Pages:     cp.Pages,
+		Jobs:      cp.Jobs,
+		NextLink:  cp.NextLink,
+		Completed: cp.Completed,
+		UpdatedAt: cp.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
 type ImportResponse struct {
-	ID               string      `json:"id"`
-	ChannelID        string      `json:"channel_id"`
-	ChannelName      string      `json:"channel_name"`
-	Integration      string      `json:"integration"`
-	Status           string      `json:"status"`
-	StartedAt        string      `json:"started_at"`
-	EndedAt          null.String `json:"ended_at"`
-	Error            null.String `json:"error"`
-	NewJobs          int         `json:"new_jobs"`
-	UpdatedJobs      int         `json:"updated_jobs"`
-	NoChangeJobs     int         `json:"no_change_jobs"`
-	MissingJobs      int         `json:"missing_jobs"`
-	TotalJobs        int         `json:"total_jobs"`
-	Errors           int         `json:"errors"`
-	Published        int         `json:"published"`
-	LatePublished    int         `json:"late_published"`
-	MissingPublished int         `json:"missing_published"`
+	ID               string                    `json:"id"`
+	ChannelID        string                    `json:"channel_id"`
+	ChannelName      string                    `json:"channel_name"`
+	Integration      string                    `json:"integration"`
+	Status           string                    `json:"status"`
+	StartedAt        string                    `json:"started_at"`
+	EndedAt          null.String               `json:"ended_at"`
+	Error            null.String               `json:"error"`
+	NewJobs          int                       `json:"new_jobs"`
+	UpdatedJobs      int                       `json:"updated_jobs"`
+	NoChangeJobs     int                       `json:"no_change_jobs"`
+	MissingJobs      int                       `json:"missing_jobs"`
+	PendingMissing   int                       `json:"pending_missing_jobs"`
+	ExpiredJobs      int                       `json:"expired_jobs"`
+	FilteredJobs     int                       `json:"filtered_jobs"`
+	BlockedJobs      int                       `json:"blocked_jobs"`
+	DeadLinkJobs     int                       `json:"dead_link_jobs"`
+	TotalJobs        int                       `json:"total_jobs"`
+	Errors           int                       `json:"errors"`
+	Published        int                       `json:"published"`
+	LatePublished    int                       `json:"late_published"`
+	MissingPublished int                       `json:"missing_published"`
+	HeldPublished    int                       `json:"held_published"`
+	ReplayOf         *string                   `json:"replay_of,omitempty"`
+	Approved         bool                      `json:"approved,omitempty"`
+	Checkpoint       *ImportCheckpointResponse `json:"checkpoint,omitempty"`
 }
 
 func NewImportResponse(i *aggregator.Import, ch *aggregator.Channel) *ImportResponse {
@@ -101,6 +289,12 @@ func NewImportResponse(i *aggregator.Import, ch *aggregator.Channel) *ImportResp
 		ended = null.StringFrom(i.EndedAt.Time.Format(time.RFC3339))
 	}
 
+	var replayOf *string
+	if i.ReplayOf.Valid {
+		id := i.ReplayOf.UUID.String()
+		replayOf = &id
+	}
+
 	return &ImportResponse{
 		ID:               i.ID.String(),
 		ChannelID:        i.ChannelID.String(),
@@ -114,11 +308,20 @@ func NewImportResponse(i *aggregator.Import, ch *aggregator.Channel) *ImportResp
 		UpdatedJobs:      i.UpdatedJobs(),
 		NoChangeJobs:     i.NoChangeJobs(),
 		MissingJobs:      i.MissingJobs(),
+		PendingMissing:   i.PendingMissingJobs(),
+		ExpiredJobs:      i.ExpiredJobs(),
+		FilteredJobs:     i.FilteredJobs(),
+		BlockedJobs:      i.BlockedJobs(),
+		DeadLinkJobs:     i.DeadLinkJobs(),
 		TotalJobs:        i.TotalJobs(),
 		Errors:           i.Errors(),
 		Published:        i.Published(),
 		LatePublished:    i.LatePublished(),
 		MissingPublished: i.MissingPublished(),
+		HeldPublished:    i.HeldPublished(),
+		ReplayOf:         replayOf,
+		Approved:         i.Approved,
+		Checkpoint:       NewImportCheckpointResponse(i.Checkpoint),
 	}
 }
 
@@ -142,3 +345,388 @@ func NewImportsResponse(imports []*aggregator.Import, channels []*aggregator.Cha
 
 	return resp
 }
+
+type SalaryResponse struct {
+	Currency  string  `json:"currency"`
+	Period    string  `json:"period"`
+	Type      string  `json:"type"`
+	Min       float64 `json:"min"`
+	Max       float64 `json:"max"`
+	AnnualMin float64 `json:"annual_min"`
+	AnnualMax float64 `json:"annual_max"`
+}
+
+func NewSalaryResponse(s *aggregator.Salary) *SalaryResponse {
+	if s == nil {
+		return nil
+	}
+
+	return &SalaryResponse{
+		Currency:  s.Currency,
+		Period:    s.Period.String(),
+		Type:      s.Type(),
+		Min:       s.Min,
+		Max:       s.Max,
+		AnnualMin: s.AnnualMin,
+		AnnualMax: s.AnnualMax,
+	}
+}
+
+type QualitySignalResponse struct {
+	Name    string `json:"name"`
+	Penalty int    `json:"penalty"`
+	Reason  string `json:"reason"`
+}
+
+type QualityResponse struct {
+	Score   int                      `json:"score"`
+	Signals []*QualitySignalResponse `json:"signals"`
+}
+
+func NewQualityResponse(q *aggregator.Quality) *QualityResponse {
+	if q == nil {
+		return nil
+	}
+
+	resp := &QualityResponse{
+		Score:   q.Score,
+		Signals: make([]*QualitySignalResponse, 0, len(q.Signals)),
+	}
+	for _, s := range q.Signals {
+		resp.Signals = append(resp.Signals, &QualitySignalResponse{Name: s.Name, Penalty: s.Penalty, Reason: s.Reason})
+	}
+
+	return resp
+}
+
+type GeoResponse struct {
+	City        string   `json:"city"`
+	Region      string   `json:"region"`
+	CountryCode string   `json:"country_code"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+	RemoteScope string   `json:"remote_scope"`
+}
+
+func NewGeoResponse(g aggregator.Geo) *GeoResponse {
+	return &GeoResponse{
+		City:        g.City,
+		Region:      g.Region,
+		CountryCode: g.CountryCode,
+		Latitude:    g.Latitude.Ptr(),
+		Longitude:   g.Longitude.Ptr(),
+		RemoteScope: g.RemoteScope.String(),
+	}
+}
+
+type ClassificationResponse struct {
+	Seniority                string  `json:"seniority"`
+	SeniorityConfidence      float64 `json:"seniority_confidence"`
+	EmploymentType           string  `json:"employment_type"`
+	EmploymentTypeConfidence float64 `json:"employment_type_confidence"`
+}
+
+func NewClassificationResponse(c aggregator.Classification) *ClassificationResponse {
+	return &ClassificationResponse{
+		Seniority:                c.Seniority.String(),
+		SeniorityConfidence:      c.SeniorityConfidence,
+		EmploymentType:           c.EmploymentType.String(),
+		EmploymentTypeConfidence: c.EmploymentTypeConfidence,
+	}
+}
+
+type SkillResponse struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func NewSkillsResponse(skills aggregator.Skills) []*SkillResponse {
+	if len(skills) == 0 {
+		return nil
+	}
+
+	resp := make([]*SkillResponse, 0, len(skills))
+	for _, sk := range skills {
+		resp = append(resp, &SkillResponse{Name: sk.Name, Count: sk.Count})
+	}
+
+	return resp
+}
+
+type JobResponse struct {
+	ID                  string                  `json:"id"`
+	ChannelID           string                  `json:"channel_id"`
+	Status              string                  `json:"status"`
+	PublishStatus       string                  `json:"publish_status"`
+	URL                 string                  `json:"url"`
+	Title               string                  `json:"title"`
+	Description         string                  `json:"description"`
+	DescriptionText     string                  `json:"description_text"`
+	DescriptionMarkdown string                  `json:"description_markdown"`
+	Source              string                  `json:"source"`
+	Company             string                  `json:"company"`
+	Location            string                  `json:"location"`
+	Language            string                  `json:"language"`
+	Geo                 *GeoResponse            `json:"geo"`
+	Classification      *ClassificationResponse `json:"classification"`
+	Enrichments         map[string]string       `json:"enrichments,omitempty"`
+	Salary              *SalaryResponse         `json:"salary,omitempty"`
+	Quality             *QualityResponse        `json:"quality,omitempty"`
+	Skills              []*SkillResponse        `json:"skills,omitempty"`
+	Remote              bool                    `json:"remote"`
+	PostedAt            string                  `json:"posted_at"`
+	ValidThrough        *string                 `json:"valid_through,omitempty"`
+}
+
+func NewJobResponse(j *aggregator.Job) *JobResponse {
+	var validThrough *string
+	if j.ValidThrough.Valid {
+		v := j.ValidThrough.Time.Format(time.RFC3339)
+		validThrough = &v
+	}
+
+	return &JobResponse{
+		ID:                  j.ID.String(),
+		ChannelID:           j.ChannelID.String(),
+		Status:              j.Status.String(),
+		PublishStatus:       j.PublishStatus.String(),
+		URL:                 j.URL,
+		Title:               j.Title,
+		Description:         j.Description,
+		DescriptionText:     j.DescriptionText,
+		DescriptionMarkdown: j.DescriptionMarkdown,
+		Source:              j.Source,
+		Company:             j.Company,
+		Location:            j.Location,
+		Language:            j.Language,
+		Geo:                 NewGeoResponse(j.Geo),
+		Classification:      NewClassificationResponse(j.Classification),
+		Enrichments:         j.Enrichments,
+		Salary:              NewSalaryResponse(j.Salary),
+		Quality:             NewQualityResponse(j.Quality),
+		Skills:              NewSkillsResponse(j.Skills),
+		Remote:              j.Remote,
+		PostedAt:            j.PostedAt.Format(time.RFC3339),
+		ValidThrough:        validThrough,
+	}
+}
+
+type JobsResponse struct {
+	Jobs []*JobResponse `json:"jobs"`
+}
+
+func NewJobsResponse(jobs []*aggregator.Job) *JobsResponse {
+	return &JobsResponse{
+		Jobs: newJobResponses(jobs, len(jobs)),
+	}
+}
+
+func newJobResponses(jobs []*aggregator.Job, limit int) []*JobResponse {
+	resp := make([]*JobResponse, 0, min(len(jobs), limit))
+	for _, j := range jobs[:min(len(jobs), limit)] {
+		resp = append(resp, NewJobResponse(j))
+	}
+
+	return resp
+}
+
+type JobFieldChangeResponse struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+type JobVersionResponse struct {
+	ImportID  string                    `json:"import_id,omitempty"`
+	Reason    string                    `json:"reason"`
+	CreatedAt string                    `json:"created_at"`
+	Changes   []*JobFieldChangeResponse `json:"changes"`
+	Version   int                       `json:"version"`
+}
+
+type JobHistoryResponse struct {
+	JobID    string                `json:"job_id"`
+	Versions []*JobVersionResponse `json:"versions"`
+}
+
+func NewJobHistoryResponse(j *aggregator.Job, vv []*aggregator.JobVersion) *JobHistoryResponse {
+	versions := make([]*JobVersionResponse, 0, len(vv))
+	for _, v := range vv {
+		changes := make([]*JobFieldChangeResponse, 0, len(v.Changes))
+		for _, c := range v.Changes {
+			changes = append(changes, &JobFieldChangeResponse{Field: c.Field, Old: c.Old, New: c.New})
+		}
+
+		// Versions recorded outside an import, like expiry, have no import
+		importID := ""
+		if v.ImportID.Valid {
+			importID = v.ImportID.UUID.String()
+		}
+
+		versions = append(versions, &JobVersionResponse{
+			Version:   v.Version,
+			ImportID:  importID,
+			Reason:    v.Reason.String(),
+			CreatedAt: v.CreatedAt.Format(time.RFC3339),
+			Changes:   changes,
+		})
+	}
+
+	return &JobHistoryResponse{
+		JobID:    j.ID.String(),
+		Versions: versions,
+	}
+}
+
+type JobProblemResponse struct {
+	JobID   string `json:"job_id"`
+	URL     string `json:"url"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type PreviewResponse struct {
+	ChannelID    string                `json:"channel_id"`
+	Pages        int                   `json:"pages"`
+	Truncated    bool                  `json:"truncated"`
+	TotalJobs    int                   `json:"total_jobs"`
+	NewJobs      int                   `json:"new_jobs"`
+	UpdatedJobs  int                   `json:"updated_jobs"`
+	NoChangeJobs int                   `json:"no_change_jobs"`
+	MissingJobs  int                   `json:"missing_jobs"`
+	PendingJobs  int                   `json:"pending_missing_jobs"`
+	ExpiredJobs  int                   `json:"expired_jobs"`
+	FilteredJobs int                   `json:"filtered_jobs"`
+	BlockedJobs  int                   `json:"blocked_jobs"`
+	DeadLinkJobs int                   `json:"dead_link_jobs"`
+	Problems     []*JobProblemResponse `json:"problems"`
+	Samples      struct {
+		New     []*JobResponse `json:"new"`
+		Updated []*JobResponse `json:"updated"`
+		Missing []*JobResponse `json:"missing"`
+	} `json:"samples"`
+}
+
+func NewPreviewResponse(p *aggregator.ImportPreview, samples int) *PreviewResponse {
+	resp := &PreviewResponse{
+		ChannelID:    p.ChannelID.String(),
+		Pages:        p.Pages,
+		Truncated:    p.Truncated,
+		TotalJobs:    p.Total,
+		NewJobs:      len(p.New),
+		UpdatedJobs:  len(p.Updated),
+		NoChangeJobs: p.NoChange,
+		MissingJobs:  len(p.Missing),
+		PendingJobs:  p.Pending,
+		ExpiredJobs:  p.Expired,
+		FilteredJobs: p.Filtered,
+		BlockedJobs:  p.Blocked,
+		DeadLinkJobs: p.DeadLinks,
+		Problems:     make([]*JobProblemResponse, 0, len(p.Problems)),
+	}
+
+	for _, problem := range p.Problems {
+		resp.Problems = append(resp.Problems, &JobProblemResponse{
+			JobID:   problem.JobID.String(),
+			URL:     problem.URL,
+			Field:   problem.Field,
+			Message: problem.Message,
+		})
+	}
+
+	resp.Samples.New = newJobResponses(p.New, samples)
+	resp.Samples.Updated = newJobResponses(p.Updated, samples)
+	resp.Samples.Missing = newJobResponses(p.Missing, samples)
+
+	return resp
+}
+
+type LeaderResponse struct {
+	Holder     string `json:"holder"`
+	Token      int64  `json:"token"`
+	Expired    bool   `json:"expired"`
+	AcquiredAt string `json:"acquired_at"`
+	RenewedAt  string `json:"renewed_at"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+type SchedulerStatusResponse struct {
+	Leader *LeaderResponse `json:"leader"`
+}
+
+func NewSchedulerStatusResponse(l *aggregator.Lease, now time.Time) *SchedulerStatusResponse {
+	if l == nil {
+		return &SchedulerStatusResponse{}
+	}
+
+	return &SchedulerStatusResponse{
+		Leader: &LeaderResponse{
+			Holder:     l.Holder,
+			Token:      l.Token,
+			Expired:    l.IsExpired(now),
+			AcquiredAt: l.AcquiredAt.Format(time.RFC3339),
+			RenewedAt:  l.RenewedAt.Format(time.RFC3339),
+			ExpiresAt:  l.ExpiresAt.Format(time.RFC3339),
+		},
+	}
+}
+
+type ScheduleRunResultResponse struct {
+	ChannelID   string      `json:"channel_id"`
+	ChannelName string      `json:"channel_name"`
+	ImportID    null.String `json:"import_id"`
+	Error       null.String `json:"error"`
+}
+
+type ScheduleRunResponse struct {
+	ID        string                       `json:"id"`
+	Status    string                       `json:"status"`
+	Scheduled int                          `json:"scheduled"`
+	Failed    int                          `json:"failed"`
+	Results   []*ScheduleRunResultResponse `json:"results"`
+	StartedAt string                       `json:"started_at"`
+	EndedAt   string                       `json:"ended_at"`
+}
+
+func NewScheduleRunResponse(run *aggregator.ScheduleRun) *ScheduleRunResponse {
+	results := make([]*ScheduleRunResultResponse, 0, len(run.Results))
+	for _, res := range run.Results {
+		importID := null.NewString("", false)
+		if res.ImportID.Valid {
+			importID = null.StringFrom(res.ImportID.UUID.String())
+		}
+
+		results = append(results, &ScheduleRunResultResponse{
+			ChannelID:   res.ChannelID.String(),
+			ChannelName: res.ChannelName,
+			ImportID:    importID,
+			Error:       null.NewString(res.Error, res.Error != ""),
+		})
+	}
+
+	return &ScheduleRunResponse{
+		ID:        run.ID.String(),
+		Status:    run.Status().String(),
+		Scheduled: run.Scheduled,
+		Failed:    run.Failed,
+		Results:   results,
+		StartedAt: run.StartedAt.Format(time.RFC3339),
+		EndedAt:   run.EndedAt.Format(time.RFC3339),
+	}
+}
+
+type ListScheduleRunsResponse struct {
+	Runs []*ScheduleRunResponse `json:"runs"`
+}
+
+func NewListScheduleRunsResponse(runs []*aggregator.ScheduleRun) *ListScheduleRunsResponse {
+	resp := &ListScheduleRunsResponse{
+		Runs: make([]*ScheduleRunResponse, 0, len(runs)),
+	}
+
+	for _, run := range runs {
+		resp.Runs = append(resp.Runs, NewScheduleRunResponse(run))
+	}
+
+	return resp
+}
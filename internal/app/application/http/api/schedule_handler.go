@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultScheduleRunsLimit = 20
+	maxScheduleRunsLimit     = 100
+)
+
+type ScheduleRunRepository interface {
+	GetRecent(ctx context.Context, limit int) ([]*aggregator.ScheduleRun, error)
+	Find(ctx context.Context, id uuid.UUID) (*aggregator.ScheduleRun, error)
+}
+
+type ScheduleHandler struct {
+	rr  ScheduleRunRepository
+	log *slog.Logger
+}
+
+func NewScheduleHandler(rr ScheduleRunRepository, log *slog.Logger) *ScheduleHandler {
+	return &ScheduleHandler{
+		rr:  rr,
+		log: log,
+	}
+}
+
+func (h *ScheduleHandler) Routes() http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/", h.ListScheduleRuns)
+	r.Get("/{id}", h.FindScheduleRun)
+
+	return r
+}
+
+func (h *ScheduleHandler) ListScheduleRuns(w http.ResponseWriter, r *http.Request) {
+	limit := defaultScheduleRunsLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l < 1 || l > maxScheduleRunsLimit {
+			h.handleFail(w, fmt.Errorf("limit must be a number between 1 and %d", maxScheduleRunsLimit), http.StatusBadRequest)
+			return
+		}
+		limit = l
+	}
+
+	runs, err := h.rr.GetRecent(r.Context(), limit)
+	if err != nil {
+		h.handleError(w, fmt.Errorf("failed to get schedule runs: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewListScheduleRunsResponse(runs)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *ScheduleHandler) FindScheduleRun(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.handleFail(w, fmt.Errorf("invalid schedule run id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	run, err := h.rr.Find(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrScheduleRunNotFound) {
+			h.handleFail(w, infrastructure.ErrScheduleRunNotFound, http.StatusNotFound)
+			return
+		}
+
+		h.handleError(w, fmt.Errorf("failed to find schedule run %s: %w", idStr, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewScheduleRunResponse(run)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *ScheduleHandler) handleFail(w http.ResponseWriter, err error, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	resp := NewErrorResponse(err)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error(err.Error(), slog.Any("Error", err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *ScheduleHandler) handleError(w http.ResponseWriter, err error) {
+	h.log.Error(err.Error(), slog.Any("Error", err))
+
+	h.handleFail(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+}
@@ -0,0 +1,193 @@
+package api_test
+
+import (
+	"errors"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	oghttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestScheduleHandler(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ScheduleHandlerSuite))
+}
+
+type ScheduleHandlerSuite struct {
+	suite.Suite
+}
+
+func newScheduleRun(id uuid.UUID, startedAt time.Time, results ...*aggregator.ScheduleRunResult) *aggregator.ScheduleRun {
+	run := &aggregator.ScheduleRun{
+		ID:        id,
+		StartedAt: startedAt,
+		EndedAt:   startedAt.Add(time.Second),
+		Results:   results,
+	}
+	for _, res := range results {
+		if res.Error != "" {
+			run.Failed++
+		} else {
+			run.Scheduled++
+		}
+	}
+
+	return run
+}
+
+func (suite *ScheduleHandlerSuite) Test_ListScheduleRuns_Success() {
+	// Prepare
+	run1ID := uuid.New()
+	run2ID := uuid.New()
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithScheduleRun(newScheduleRun(run1ID, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			&aggregator.ScheduleRunResult{ChannelID: chID, ChannelName: "channel 1", Error: "failed to publish import"},
+		)),
+		testutils.WithScheduleRun(newScheduleRun(run2ID, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			&aggregator.ScheduleRunResult{ChannelID: chID, ChannelName: "channel 1", ImportID: uuid.NullUUID{UUID: iID, Valid: true}},
+		)),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/schedules", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"runs":[`+
+		`{"id":"`+run2ID.String()+`","status":"succeeded","scheduled":1,"failed":0,"results":[{"channel_id":"`+chID.String()+`","channel_name":"channel 1","import_id":"`+iID.String()+`","error":null}],"started_at":"2025-01-02T00:00:00Z","ended_at":"2025-01-02T00:00:01Z"},`+
+		`{"id":"`+run1ID.String()+`","status":"failed","scheduled":0,"failed":1,"results":[{"channel_id":"`+chID.String()+`","channel_name":"channel 1","import_id":null,"error":"failed to publish import"}],"started_at":"2025-01-01T00:00:00Z","ended_at":"2025-01-01T00:00:01Z"}`+
+		`]}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ScheduleHandlerSuite) Test_ListScheduleRuns_Limit_Success() {
+	// Prepare
+	runID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithScheduleRun(newScheduleRun(uuid.New(), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))),
+		testutils.WithScheduleRun(newScheduleRun(runID, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/schedules?limit=1", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal(`{"runs":[{"id":"`+runID.String()+`","status":"succeeded","scheduled":0,"failed":0,"results":[],"started_at":"2025-01-02T00:00:00Z","ended_at":"2025-01-02T00:00:01Z"}]}`+"\n", rr.Body.String())
+}
+
+func (suite *ScheduleHandlerSuite) Test_ListScheduleRuns_InvalidLimit_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("GET", "/api/schedules?limit=1000", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"limit must be a number between 1 and 100"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ScheduleHandlerSuite) Test_ListScheduleRuns_RepositoryFail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithScheduleRunRepositoryError(errors.New("boom!")),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/schedules", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusInternalServerError, rr.Code)
+	suite.Equal("{\"error\":{\"message\":\"Internal Server Error\"}}\n", rr.Body.String())
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to get schedule runs: boom!")
+}
+
+func (suite *ScheduleHandlerSuite) Test_FindScheduleRun_Success() {
+	// Prepare
+	runID := uuid.New()
+	ch1ID := uuid.New()
+	ch2ID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithScheduleRun(newScheduleRun(runID, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			&aggregator.ScheduleRunResult{ChannelID: ch1ID, ChannelName: "channel 1", Error: "boom"},
+			&aggregator.ScheduleRunResult{ChannelID: ch2ID, ChannelName: "channel 2", ImportID: uuid.NullUUID{UUID: iID, Valid: true}},
+		)),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/schedules/"+runID.String(), nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal(`{"id":"`+runID.String()+`","status":"partially_failed","scheduled":1,"failed":1,"results":[`+
+		`{"channel_id":"`+ch1ID.String()+`","channel_name":"channel 1","import_id":null,"error":"boom"},`+
+		`{"channel_id":"`+ch2ID.String()+`","channel_name":"channel 2","import_id":"`+iID.String()+`","error":null}`+
+		`],"started_at":"2025-01-01T00:00:00Z","ended_at":"2025-01-01T00:00:01Z"}`+"\n", rr.Body.String())
+}
+
+func (suite *ScheduleHandlerSuite) Test_FindScheduleRun_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("GET", "/api/schedules/"+uuid.New().String(), nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusNotFound, rr.Code)
+	suite.Equal(`{"error":{"message":"schedule run not found"}}`+"\n", rr.Body.String())
+}
+
+func (suite *ScheduleHandlerSuite) Test_FindScheduleRun_InvalidID_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("GET", "/api/schedules/abc", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusBadRequest, rr.Code)
+	suite.Equal(`{"error":{"message":"invalid schedule run id: invalid UUID length: 3"}}`+"\n", rr.Body.String())
+}
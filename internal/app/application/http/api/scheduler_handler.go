@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/go-chi/chi/v5"
+)
+
+type LeaseRepository interface {
+	Find(ctx context.Context, name string) (*aggregator.Lease, error)
+}
+
+type SchedulerHandler struct {
+	lr  LeaseRepository
+	log *slog.Logger
+}
+
+func NewSchedulerHandler(lr LeaseRepository, log *slog.Logger) *SchedulerHandler {
+	return &SchedulerHandler{
+		lr:  lr,
+		log: log,
+	}
+}
+
+func (h *SchedulerHandler) Routes() http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/", h.Status)
+
+	return r
+}
+
+func (h *SchedulerHandler) Status(w http.ResponseWriter, r *http.Request) {
+	// No daemon has been elected leader yet
+	l, err := h.lr.Find(r.Context(), scheduling.LeaderLease)
+	if err != nil && !errors.Is(err, infrastructure.ErrLeaseNotFound) {
+		h.handleError(w, fmt.Errorf("failed to find leader of scheduler: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	resp := NewSchedulerStatusResponse(l, time.Now())
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.handleError(w, fmt.Errorf("failed to encode response: %w", err))
+	}
+}
+
+func (h *SchedulerHandler) handleFail(w http.ResponseWriter, err error, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	resp := NewErrorResponse(err)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.log.Error(err.Error(), slog.Any("Error", err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *SchedulerHandler) handleError(w http.ResponseWriter, err error) {
+	h.log.Error(err.Error(), slog.Any("Error", err))
+
+	h.handleFail(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+}
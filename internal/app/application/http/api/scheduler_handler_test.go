@@ -0,0 +1,102 @@
+package api_test
+
+import (
+	"errors"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/stretchr/testify/suite"
+	oghttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSchedulerHandler(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SchedulerHandlerSuite))
+}
+
+type SchedulerHandlerSuite struct {
+	suite.Suite
+}
+
+func (suite *SchedulerHandlerSuite) Test_Status_Success() {
+	// Prepare
+	expiresAt := time.Date(2125, 1, 1, 0, 1, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithLease(scheduling.LeaderLease, "replica-1", 3, expiresAt),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/scheduler", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.Equal(`{"leader":{"holder":"replica-1","token":3,"expired":false,"acquired_at":"2125-01-01T00:00:00Z","renewed_at":"2125-01-01T00:00:00Z","expires_at":"2125-01-01T00:01:00Z"}}`+"\n", rr.Body.String())
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *SchedulerHandlerSuite) Test_Status_Expired_Success() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithLease(scheduling.LeaderLease, "replica-1", 3, time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/scheduler", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert the last leader is shown until a standby takes over
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal(`{"leader":{"holder":"replica-1","token":3,"expired":true,"acquired_at":"2025-01-01T00:00:00Z","renewed_at":"2025-01-01T00:00:00Z","expires_at":"2025-01-01T00:01:00Z"}}`+"\n", rr.Body.String())
+}
+
+func (suite *SchedulerHandlerSuite) Test_Status_NoLeader_Success() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	req, err := oghttp.NewRequest("GET", "/api/scheduler", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusOK, rr.Code)
+	suite.Equal(`{"leader":null}`+"\n", rr.Body.String())
+}
+
+func (suite *SchedulerHandlerSuite) Test_Status_RepositoryFail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithLeaseRepositoryError(errors.New("boom!")),
+	)
+
+	req, err := oghttp.NewRequest("GET", "/api/scheduler", nil)
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.APIServer.ServeHTTP(rr, req)
+
+	// Assert
+	suite.Equal(oghttp.StatusInternalServerError, rr.Code)
+	suite.Equal("{\"error\":{\"message\":\"Internal Server Error\"}}\n", rr.Body.String())
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], `"level":"ERROR"`)
+	suite.Contains(lines[0], "failed to find leader of scheduler: boom!")
+}
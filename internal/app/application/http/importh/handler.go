@@ -8,20 +8,19 @@ import (
 	"net/http"
 
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
-	"github.com/aviseu/jobs-protobuf/build/gen/go/commands/imports"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
 	"github.com/go-chi/chi/v5"
-	"github.com/google/uuid"
-	"google.golang.org/protobuf/proto"
 )
 
+// Handler receives import commands pushed by the subscription, they are handled like pulled ones
 type Handler struct {
-	is  *importing.Service
+	h   broker.Handler
 	log *slog.Logger
 }
 
 func NewHandler(is *importing.Service, log *slog.Logger) *Handler {
 	return &Handler{
-		is:  is,
+		h:   broker.NewImportHandler(is, log),
 		log: log,
 	}
 }
@@ -37,8 +36,9 @@ func (h *Handler) Routes() http.Handler {
 type pubSubMessage struct {
 	Subscription string `json:"subscription"`
 	Message      struct {
-		ID   string `json:"id"`
-		Data []byte `json:"data,omitempty"`
+		Attributes map[string]string `json:"attributes,omitempty"`
+		ID         string            `json:"id"`
+		Data       []byte            `json:"data,omitempty"`
 	} `json:"message"`
 }
 
@@ -58,27 +58,10 @@ func (h *Handler) Import(w http.ResponseWriter, r *http.Request) {
 		}
 	}(r.Body)
 
-	var data imports.ExecuteImportChannel
-	if err := proto.Unmarshal(msg.Message.Data, &data); err != nil {
-		h.log.Error(fmt.Errorf("failed to unmarshal pubsub message: %w", err).Error())
-		http.Error(w, "skipped message", http.StatusOK) // 200 will ack message
-		return
-	}
-
-	importID, err := uuid.Parse(data.ImportId)
-	if err != nil {
-		h.log.Error(fmt.Errorf("failed to convert import id %s to uuid: %w", data.ImportId, err).Error())
-		http.Error(w, "skipped message", http.StatusOK) // 200 will ack message
-		return
-	}
-
-	h.log.Info("processing import " + importID.String())
-	if err := h.is.Import(r.Context(), importID); err != nil {
-		h.log.Error(fmt.Errorf("failed to execute import %s: %w", importID, err).Error())
-		http.Error(w, "skipped message", http.StatusOK) // 200 will ack message
+	if err := h.h(r.Context(), &broker.Message{ID: msg.Message.ID, Data: msg.Message.Data, Attributes: msg.Message.Attributes}); err != nil {
+		http.Error(w, "interrupted message", http.StatusServiceUnavailable) // non 2xx will redeliver message
 		return
 	}
-	h.log.Info("completed import " + importID.String())
 
 	w.WriteHeader(http.StatusOK)
 }
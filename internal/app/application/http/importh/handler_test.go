@@ -2,6 +2,7 @@ package importh_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
@@ -137,7 +138,7 @@ func (suite *HandlerSuite) Test_Import_ServerFail() {
 
 	// Assert response
 	suite.Equal(oghttp.StatusOK, rr.Code)
-	suite.Equal("skipped message\n", rr.Body.String())
+	suite.Empty(rr.Body.String())
 
 	// Assert state change
 	suite.Len(dsl.Imports(), 1)
@@ -159,6 +160,44 @@ func (suite *HandlerSuite) Test_Import_ServerFail() {
 	suite.Contains(lines[1], "processing import "+iID.String())
 }
 
+func (suite *HandlerSuite) Test_Import_Interrupted_Redelivers() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL()
+
+	data, err := proto.Marshal(&imports.ExecuteImportChannel{
+		ImportId: iID.String(),
+	})
+	suite.NoError(err)
+	msg := &pubSubMessage{
+		Message: struct {
+			Data []byte `json:"data,omitempty"`
+			ID   string `json:"id"`
+		}{
+			Data: data,
+			ID:   "1",
+		},
+	}
+	msgJson, err := json.Marshal(msg)
+	suite.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := oghttp.NewRequestWithContext(ctx, "POST", "/import", bytes.NewBuffer(msgJson))
+	suite.NoError(err)
+	rr := httptest.NewRecorder()
+
+	// Execute
+	dsl.ImportServer.ServeHTTP(rr, req)
+
+	// Assert imports interrupted by a shutdown are handed back like pulled ones
+	suite.Equal(oghttp.StatusServiceUnavailable, rr.Code)
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Contains(lines[len(lines)-1], "interrupted import "+iID.String())
+}
+
 func (suite *HandlerSuite) Test_Import_BadPubSubMessageFail() {
 	// Prepare
 	chID := uuid.New()
@@ -248,7 +287,7 @@ func (suite *HandlerSuite) Test_Import_ProtoDecodeFail() {
 
 	// Assert response
 	suite.Equal(oghttp.StatusOK, rr.Code)
-	suite.Equal("skipped message\n", rr.Body.String())
+	suite.Empty(rr.Body.String())
 
 	// Assert state change
 	suite.Len(dsl.Imports(), 1)
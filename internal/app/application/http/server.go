@@ -9,6 +9,7 @@ import (
 
 	"github.com/aviseu/jobs-backoffice/internal/app/application/http/api"
 	"github.com/aviseu/jobs-backoffice/internal/app/application/http/importh"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/blocking"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/configuring"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
@@ -33,7 +34,7 @@ func SetupServer(ctx context.Context, cfg Config, h http.Handler) http.Server {
 	}
 }
 
-func APIRootHandler(chs *configuring.Service, chr api.ChannelRepository, ir api.ImportRepository, is *scheduling.Service, cfg Config, log *slog.Logger) http.Handler {
+func APIRootHandler(chs *configuring.Service, chr api.ChannelRepository, ir api.ImportRepository, jr api.JobRepository, is *scheduling.Service, ps *importing.PreviewService, bs *blocking.Service, br api.BlocklistRepository, lr api.LeaseRepository, rr api.ScheduleRunRepository, cfg Config, log *slog.Logger) http.Handler {
 	r := chi.NewRouter()
 
 	if cfg.Cors {
@@ -47,9 +48,13 @@ func APIRootHandler(chs *configuring.Service, chr api.ChannelRepository, ir api.
 		}))
 	}
 
-	r.Mount("/api/channels", api.NewChannelHandler(chs, chr, is, log).Routes())
+	r.Mount("/api/channels", api.NewChannelHandler(chs, chr, is, ps, log).Routes())
 	r.Mount("/api/integrations", api.NewIntegrationHandler(chs, log).Routes())
-	r.Mount("/api/imports", api.NewImportHandler(chr, ir, log).Routes())
+	r.Mount("/api/imports", api.NewImportHandler(chr, ir, is, log).Routes())
+	r.Mount("/api/jobs", api.NewJobHandler(jr, log).Routes())
+	r.Mount("/api/blocklist", api.NewBlocklistHandler(bs, br, log).Routes())
+	r.Mount("/api/scheduler", api.NewSchedulerHandler(lr, log).Routes())
+	r.Mount("/api/schedules", api.NewScheduleHandler(rr, log).Routes())
 
 	return r
 }
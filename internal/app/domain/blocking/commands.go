@@ -0,0 +1,15 @@
+package blocking
+
+type AddEntryCommand struct {
+	Kind   string
+	Value  string
+	Reason string
+}
+
+func NewAddEntryCommand(kind, value, reason string) *AddEntryCommand {
+	return &AddEntryCommand{
+		Kind:   kind,
+		Value:  value,
+		Reason: reason,
+	}
+}
@@ -0,0 +1,14 @@
+package blocking
+
+import (
+	"errors"
+
+	"github.com/aviseu/jobs-backoffice/internal/errs"
+)
+
+var (
+	ErrInvalidKind    = errs.NewValidationError(errors.New("invalid blocklist kind"))
+	ErrValueRequired  = errs.NewValidationError(errors.New("value is required"))
+	ErrDuplicateEntry = errs.NewValidationError(errors.New("value is already blocked"))
+	ErrEntryNotFound  = errs.NewValidationError(errors.New("blocklist entry not found"))
+)
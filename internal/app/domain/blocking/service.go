@@ -0,0 +1,154 @@
+package blocking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/blocklist"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	All(ctx context.Context) ([]*aggregator.BlocklistEntry, error)
+	Save(ctx context.Context, e *aggregator.BlocklistEntry) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type JobRepository interface {
+	GetActive(ctx context.Context) ([]*aggregator.Job, error)
+	Save(ctx context.Context, j *aggregator.Job) error
+	SaveVersion(ctx context.Context, v *aggregator.JobVersion) error
+}
+
+type PubSubService interface {
+	PublishJobBlocked(ctx context.Context, job *aggregator.Job) error
+}
+
+type Service struct {
+	r   Repository
+	jr  JobRepository
+	ps  PubSubService
+	log *slog.Logger
+}
+
+func NewService(r Repository, jr JobRepository, ps PubSubService, log *slog.Logger) *Service {
+	return &Service{
+		r:   r,
+		jr:  jr,
+		ps:  ps,
+		log: log,
+	}
+}
+
+func (s *Service) Add(ctx context.Context, cmd *AddEntryCommand) (*aggregator.BlocklistEntry, int, error) {
+	var errs error
+
+	kind, ok := aggregator.ParseBlocklistKind(cmd.Kind)
+	if !ok {
+		errs = errors.Join(errs, fmt.Errorf("failed to find kind %s: %w", cmd.Kind, ErrInvalidKind))
+	}
+
+	value := strings.TrimSpace(cmd.Value)
+	if value == "" {
+		errs = errors.Join(errs, ErrValueRequired)
+	}
+
+	if errs != nil {
+		return nil, 0, errs
+	}
+
+	entries, err := s.r.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get blocklist: %w", err)
+	}
+	for _, e := range entries {
+		if e.Kind == kind && blocklist.Normalize(e.Value) == blocklist.Normalize(value) {
+			return nil, 0, fmt.Errorf("%s %s: %w", kind, value, ErrDuplicateEntry)
+		}
+	}
+
+	e := &aggregator.BlocklistEntry{
+		ID:        uuid.New(),
+		Kind:      kind,
+		Value:     value,
+		Reason:    strings.TrimSpace(cmd.Reason),
+		CreatedAt: time.Now(),
+	}
+	if err := s.r.Save(ctx, e); err != nil {
+		return nil, 0, fmt.Errorf("failed to add blocklist entry: %w", err)
+	}
+
+	n, err := s.unpublish(ctx, e)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to unpublish jobs blocked by entry %s: %w", e.ID, err)
+	}
+
+	return e, n, nil
+}
+
+func (s *Service) Remove(ctx context.Context, id uuid.UUID) error {
+	if err := s.r.Delete(ctx, id); err != nil {
+		if errors.Is(err, infrastructure.ErrBlocklistEntryNotFound) {
+			return ErrEntryNotFound
+		}
+		return fmt.Errorf("failed to remove blocklist entry %s: %w", id, err)
+	}
+
+	return nil
+}
+
+func (s *Service) unpublish(ctx context.Context, e *aggregator.BlocklistEntry) (int, error) {
+	jobs, err := s.jr.GetActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active jobs: %w", err)
+	}
+
+	// Jobs that fail here stay active, the next import of their channel takes them down
+	l := blocklist.New([]*aggregator.BlocklistEntry{e})
+	n := 0
+	for _, j := range jobs {
+		if l.Match(j) == nil {
+			continue
+		}
+
+		// Jobs that never went out, like held ones, only stop being published, subscribers do not know them
+		j.Status = aggregator.JobStatusInactive
+		if j.PublishStatus == aggregator.JobPublishStatusPublished {
+			j.PublishStatus = aggregator.JobPublishStatusUnpublished
+			if err := s.ps.PublishJobBlocked(ctx, j); err != nil {
+				s.log.Error(fmt.Errorf("failed to publish blocked job %s: %w", j.ID, err).Error())
+				j.Status = aggregator.JobStatusActive
+				j.PublishStatus = aggregator.JobPublishStatusPublished
+				continue
+			}
+			j.PublishStatus = aggregator.JobPublishStatusPublished
+		}
+
+		j.UpdatedAt = time.Now()
+		if err := s.jr.Save(ctx, j); err != nil {
+			s.log.Error(fmt.Errorf("failed to save blocked job %s: %w", j.ID, err).Error())
+			continue
+		}
+		n++
+
+		// Blocking happens outside of an import, so the version has no import
+		v := &aggregator.JobVersion{
+			ID:        uuid.New(),
+			JobID:     j.ID,
+			Reason:    aggregator.ImportMetricTypeBlocked,
+			Changes:   aggregator.JobChanges{{Field: "status", Old: aggregator.JobStatusActive.String(), New: aggregator.JobStatusInactive.String()}},
+			CreatedAt: j.UpdatedAt,
+		}
+		if err := s.jr.SaveVersion(ctx, v); err != nil {
+			s.log.Error(fmt.Errorf("failed to save version of blocked job %s: %w", j.ID, err).Error())
+		}
+	}
+
+	return n, nil
+}
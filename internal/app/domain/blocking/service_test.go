@@ -0,0 +1,262 @@
+package blocking_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/blocking"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/errs"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestService(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+type ServiceSuite struct {
+	suite.Suite
+}
+
+func (suite *ServiceSuite) Test_Add_Success() {
+	// Prepare
+	j1ID := uuid.New()
+	j2ID := uuid.New()
+	j3ID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(j1ID),
+			testutils.WithJobCompany("Shady MLM Ltd."),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j2ID),
+			testutils.WithJobCompany("shady  mlm ltd."),
+			testutils.WithJobStatus(aggregator.JobStatusInactive),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusPublished),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j3ID),
+		),
+	)
+
+	// Execute
+	e, n, err := dsl.BlockingService.Add(context.Background(), blocking.NewAddEntryCommand("company", " Shady MLM Ltd. ", "pyramid scheme"))
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(1, n)
+	suite.Equal(aggregator.BlocklistKindCompany, e.Kind)
+	suite.Equal("Shady MLM Ltd.", e.Value)
+	suite.Equal("pyramid scheme", e.Reason)
+	suite.True(e.CreatedAt.After(time.Now().Add(-2 * time.Second)))
+
+	// Assert state change
+	suite.Len(dsl.BlocklistEntries(), 1)
+	suite.Equal(e, dsl.BlocklistEntry(e.ID))
+
+	// Assert active published jobs of the company are taken down
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(j1ID).Status)
+	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(j1ID).PublishStatus)
+	suite.Len(dsl.PublishedJobBlockeds(), 1)
+	suite.NotNil(dsl.PublishedJobBlocked(j1ID))
+	suite.Empty(dsl.PublishedJobMissings())
+
+	// Assert the take down is recorded on the job
+	vv := dsl.JobVersions(j1ID)
+	suite.Len(vv, 1)
+	suite.Equal(aggregator.ImportMetricTypeBlocked, vv[0].Reason)
+	suite.False(vv[0].ImportID.Valid)
+	suite.Equal(aggregator.JobChanges{{Field: "status", Old: "active", New: "inactive"}}, vv[0].Changes)
+
+	// Assert other jobs are left alone
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(j3ID).Status)
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ServiceSuite) Test_Add_Keyword_Success() {
+	// Prepare
+	j1ID := uuid.New()
+	j2ID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(j1ID),
+			testutils.WithJobTitle("Crypto Trader (m/w/d)"),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j2ID),
+			testutils.WithJobTitle("Cryptography Engineer"),
+		),
+	)
+
+	// Execute
+	_, n, err := dsl.BlockingService.Add(context.Background(), blocking.NewAddEntryCommand("keyword", "crypto", ""))
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(1, n)
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(j1ID).Status)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(j2ID).Status)
+}
+
+func (suite *ServiceSuite) Test_Add_Validation_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	// Execute
+	_, _, err := dsl.BlockingService.Add(context.Background(), blocking.NewAddEntryCommand("domain", " ", ""))
+
+	// Assert
+	suite.Error(err)
+	suite.True(errs.IsValidationError(err))
+	suite.ErrorIs(err, blocking.ErrInvalidKind)
+	suite.ErrorIs(err, blocking.ErrValueRequired)
+	suite.Empty(dsl.BlocklistEntries())
+}
+
+func (suite *ServiceSuite) Test_Add_Duplicate_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithBlocklistEntry(aggregator.BlocklistKindKeyword, "Crypto"),
+	)
+
+	// Execute
+	_, _, err := dsl.BlockingService.Add(context.Background(), blocking.NewAddEntryCommand("keyword", "crypto", ""))
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorIs(err, blocking.ErrDuplicateEntry)
+	suite.Len(dsl.BlocklistEntries(), 1)
+}
+
+func (suite *ServiceSuite) Test_Add_RepositoryFail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithBlocklistRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	_, _, err := dsl.BlockingService.Add(context.Background(), blocking.NewAddEntryCommand("keyword", "crypto", ""))
+
+	// Assert
+	suite.Error(err)
+	suite.False(errs.IsValidationError(err))
+	suite.ErrorContains(err, "boom")
+}
+
+func (suite *ServiceSuite) Test_Add_UnpublishedJobs_NotPublished() {
+	// Prepare
+	pendingID := uuid.New()
+	heldID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(pendingID),
+			testutils.WithJobCompany("Shady MLM Ltd."),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusUnpublished),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(heldID),
+			testutils.WithJobCompany("Shady MLM Ltd."),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusHeld),
+		),
+	)
+
+	// Execute
+	_, n, err := dsl.BlockingService.Add(context.Background(), blocking.NewAddEntryCommand("company", "Shady MLM Ltd.", ""))
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	// Assert jobs that never went out are only marked inactive, so they are not published later
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(pendingID).Status)
+	suite.Equal(aggregator.JobPublishStatusUnpublished, dsl.Job(pendingID).PublishStatus)
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(heldID).Status)
+	suite.Equal(aggregator.JobPublishStatusHeld, dsl.Job(heldID).PublishStatus)
+	suite.Empty(dsl.PublishedJobBlockeds())
+
+	// Assert the take down is still recorded on the jobs
+	suite.Len(dsl.JobVersions(pendingID), 1)
+	suite.Len(dsl.JobVersions(heldID), 1)
+}
+
+func (suite *ServiceSuite) Test_Add_UnpublishedJob_PubSubFail_Success() {
+	// Prepare
+	jID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobCompany("Shady MLM Ltd."),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusUnpublished),
+		),
+	)
+	dsl.PubSubJobService.FailWith(errors.New("boom"))
+
+	// Execute
+	_, n, err := dsl.BlockingService.Add(context.Background(), blocking.NewAddEntryCommand("company", "Shady MLM Ltd.", ""))
+
+	// Assert the job does not depend on the broker to be taken down
+	suite.NoError(err)
+	suite.Equal(1, n)
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(jID).Status)
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ServiceSuite) Test_Add_PubSubFail_KeepsJobActive() {
+	// Prepare
+	jID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobCompany("Shady MLM Ltd."),
+		),
+	)
+	dsl.PubSubJobService.FailWith(errors.New("boom"))
+
+	// Execute
+	e, n, err := dsl.BlockingService.Add(context.Background(), blocking.NewAddEntryCommand("company", "Shady MLM Ltd.", ""))
+
+	// Assert the entry is added and the job is left for the next import
+	suite.NoError(err)
+	suite.NotNil(e)
+	suite.Equal(0, n)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(jID).Status)
+	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(jID).PublishStatus)
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to publish blocked job "+jID.String()+": boom")
+}
+
+func (suite *ServiceSuite) Test_Remove_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithBlocklistEntry(aggregator.BlocklistKindKeyword, "crypto", testutils.WithBlocklistEntryID(id)),
+	)
+
+	// Execute
+	err := dsl.BlockingService.Remove(context.Background(), id)
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(dsl.BlocklistEntries())
+}
+
+func (suite *ServiceSuite) Test_Remove_NotFound_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	// Execute
+	err := dsl.BlockingService.Remove(context.Background(), uuid.New())
+
+	// Assert
+	suite.ErrorIs(err, blocking.ErrEntryNotFound)
+}
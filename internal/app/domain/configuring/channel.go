@@ -1,9 +1,14 @@
 package configuring
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/language"
+	"github.com/aviseu/jobs-backoffice/internal/rules"
+	"github.com/aviseu/jobs-backoffice/internal/schedule"
 	"github.com/google/uuid"
 )
 
@@ -12,12 +17,19 @@ type channel struct {
 	updatedAt   time.Time
 	name        string
 	integration aggregator.Integration
+	settings    aggregator.ChannelSettings
 	status      aggregator.ChannelStatus
 	id          uuid.UUID
 }
 
 type optional func(*channel)
 
+func withSettings(settings aggregator.ChannelSettings) optional {
+	return func(ch *channel) {
+		ch.settings = settings
+	}
+}
+
 func withTimestamps(c, u time.Time) optional {
 	return func(ch *channel) {
 		ch.createdAt = c
@@ -53,6 +65,81 @@ func (ch *channel) update(name string) error {
 	return nil
 }
 
+func (ch *channel) updateSettings(settings aggregator.ChannelSettings) error {
+	var err error
+	if settings.MissingAfterImports < 0 || settings.MissingAfter < 0 {
+		err = errors.Join(err, ErrInvalidMissingGracePeriod)
+	}
+	if settings.MaxAge < 0 {
+		err = errors.Join(err, ErrInvalidMaxAge)
+	}
+	if settings.MaxMissingPercentage < 0 || settings.MaxMissingPercentage > 100 {
+		err = errors.Join(err, ErrInvalidMaxMissingPercentage)
+	}
+	if settings.MinQualityScore < 0 || settings.MinQualityScore > 100 {
+		err = errors.Join(err, ErrInvalidMinQualityScore)
+	}
+	if settings.Priority < 0 || settings.Priority > aggregator.MaxChannelPriority {
+		err = errors.Join(err, ErrInvalidPriority)
+	}
+
+	seen := make(map[string]struct{}, len(settings.Enrichers))
+	for _, name := range settings.Enrichers {
+		if strings.TrimSpace(name) == "" {
+			err = errors.Join(err, ErrInvalidEnricher)
+			break
+		}
+		if _, ok := seen[name]; ok {
+			err = errors.Join(err, ErrDuplicateEnricher)
+			break
+		}
+		seen[name] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(settings.Languages))
+	for _, lang := range settings.Languages {
+		if !language.IsSupported(lang) {
+			err = errors.Join(err, ErrUnsupportedLanguage)
+			break
+		}
+		if _, ok := seen[lang]; ok {
+			err = errors.Join(err, ErrDuplicateLanguage)
+			break
+		}
+		seen[lang] = struct{}{}
+	}
+
+	// An empty override leaves the classification to the classifier
+	if settings.Seniority != "" {
+		if s, ok := aggregator.ParseSeniority(settings.Seniority); !ok || s == aggregator.SeniorityUnknown {
+			err = errors.Join(err, ErrInvalidSeniority)
+		}
+	}
+	if settings.EmploymentType != "" {
+		if t, ok := aggregator.ParseEmploymentType(settings.EmploymentType); !ok || t == aggregator.EmploymentTypeUnknown {
+			err = errors.Join(err, ErrInvalidEmploymentType)
+		}
+	}
+
+	if _, rerr := rules.Compile(settings.Rules); rerr != nil {
+		err = errors.Join(err, ErrInvalidRules, rerr)
+	}
+	// Without a schedule the channel follows the default schedule of the scheduler
+	if settings.Schedule != nil {
+		if _, serr := schedule.Parse(*settings.Schedule, schedule.CatchUpOnce); serr != nil {
+			err = errors.Join(err, ErrInvalidSchedule, serr)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	ch.settings = settings
+	ch.updatedAt = time.Now()
+
+	return nil
+}
+
 func (ch *channel) activate() {
 	ch.status = aggregator.ChannelStatusActive
 	ch.updatedAt = time.Now()
@@ -69,6 +156,7 @@ func (ch *channel) toAggregator() *aggregator.Channel {
 		Name:        ch.name,
 		Integration: ch.integration,
 		Status:      ch.status,
+		Settings:    ch.settings,
 		CreatedAt:   ch.createdAt,
 		UpdatedAt:   ch.updatedAt,
 	}
@@ -81,5 +169,6 @@ func newChannelFromAggregator(ch *aggregator.Channel) *channel {
 		ch.Integration,
 		ch.Status,
 		withTimestamps(ch.CreatedAt, ch.UpdatedAt),
+		withSettings(ch.Settings),
 	)
 }
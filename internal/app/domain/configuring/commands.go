@@ -1,6 +1,11 @@
 package configuring
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
 
 type CreateChannelCommand struct {
 	Name        string
@@ -25,3 +30,103 @@ func NewUpdateChannelCommand(id uuid.UUID, name string) *UpdateChannelCommand {
 		Name: name,
 	}
 }
+
+type UpdateChannelSettingsCommand struct {
+	MissingAfterImports  int
+	MissingAfter         time.Duration
+	MaxAge               time.Duration
+	MaxMissingPercentage float64
+	MinQualityScore      int
+	ID                   uuid.UUID
+}
+
+func NewUpdateChannelSettingsCommand(id uuid.UUID, missingAfterImports int, missingAfter, maxAge time.Duration, maxMissingPercentage float64, minQualityScore int) *UpdateChannelSettingsCommand {
+	return &UpdateChannelSettingsCommand{
+		ID:                   id,
+		MissingAfterImports:  missingAfterImports,
+		MissingAfter:         missingAfter,
+		MaxAge:               maxAge,
+		MaxMissingPercentage: maxMissingPercentage,
+		MinQualityScore:      minQualityScore,
+	}
+}
+
+type UpdateChannelEnrichersCommand struct {
+	Enrichers []string
+	ID        uuid.UUID
+}
+
+func NewUpdateChannelEnrichersCommand(id uuid.UUID, enrichers []string) *UpdateChannelEnrichersCommand {
+	return &UpdateChannelEnrichersCommand{
+		ID:        id,
+		Enrichers: enrichers,
+	}
+}
+
+type UpdateChannelLanguagesCommand struct {
+	Languages []string
+	ID        uuid.UUID
+}
+
+func NewUpdateChannelLanguagesCommand(id uuid.UUID, languages []string) *UpdateChannelLanguagesCommand {
+	return &UpdateChannelLanguagesCommand{
+		ID:        id,
+		Languages: languages,
+	}
+}
+
+type UpdateChannelRulesCommand struct {
+	Rules []*aggregator.Rule
+	ID    uuid.UUID
+}
+
+func NewUpdateChannelRulesCommand(id uuid.UUID, rules []*aggregator.Rule) *UpdateChannelRulesCommand {
+	return &UpdateChannelRulesCommand{
+		ID:    id,
+		Rules: rules,
+	}
+}
+
+type UpdateChannelClassificationCommand struct {
+	Seniority      string
+	EmploymentType string
+	ID             uuid.UUID
+}
+
+func NewUpdateChannelClassificationCommand(id uuid.UUID, seniority, employmentType string) *UpdateChannelClassificationCommand {
+	return &UpdateChannelClassificationCommand{
+		ID:             id,
+		Seniority:      seniority,
+		EmploymentType: employmentType,
+	}
+}
+
+type UpdateChannelScheduleCommand struct {
+	Cron     string
+	CatchUp  string
+	Interval time.Duration
+	Jitter   time.Duration
+	ID       uuid.UUID
+}
+
+func NewUpdateChannelScheduleCommand(id uuid.UUID, cron string, interval, jitter time.Duration, catchUp string) *UpdateChannelScheduleCommand {
+	return &UpdateChannelScheduleCommand{
+		ID:       id,
+		Cron:     cron,
+		Interval: interval,
+		Jitter:   jitter,
+		CatchUp:  catchUp,
+	}
+}
+
+type UpdateChannelPriorityCommand struct {
+	Priority int
+	ID       uuid.UUID
+}
+
+func NewUpdateChannelPriorityCommand(id uuid.UUID, priority int) *UpdateChannelPriorityCommand {
+	return &UpdateChannelPriorityCommand{
+		ID:       id,
+		Priority: priority,
+	}
+}
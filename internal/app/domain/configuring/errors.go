@@ -10,4 +10,19 @@ var (
 	ErrInvalidIntegration = errs.NewValidationError(errors.New("invalid integration"))
 	ErrNameIsRequired     = errs.NewValidationError(errors.New("name is required"))
 	ErrChannelNotFound    = errs.NewValidationError(errors.New("channel not found"))
+
+	ErrInvalidMissingGracePeriod   = errs.NewValidationError(errors.New("missing grace period cannot be negative"))
+	ErrInvalidMaxAge               = errs.NewValidationError(errors.New("max age cannot be negative"))
+	ErrInvalidMaxMissingPercentage = errs.NewValidationError(errors.New("max missing percentage must be between 0 and 100"))
+	ErrInvalidMinQualityScore      = errs.NewValidationError(errors.New("min quality score must be between 0 and 100"))
+	ErrInvalidEnricher             = errs.NewValidationError(errors.New("enricher name is required"))
+	ErrDuplicateEnricher           = errs.NewValidationError(errors.New("enrichers cannot be configured more than once"))
+	ErrUnknownEnricher             = errs.NewValidationError(errors.New("unknown enricher"))
+	ErrUnsupportedLanguage         = errs.NewValidationError(errors.New("language is not supported"))
+	ErrDuplicateLanguage           = errs.NewValidationError(errors.New("languages cannot be configured more than once"))
+	ErrInvalidRules                = errs.NewValidationError(errors.New("invalid rules"))
+	ErrInvalidSeniority            = errs.NewValidationError(errors.New("invalid seniority"))
+	ErrInvalidEmploymentType       = errs.NewValidationError(errors.New("invalid employment type"))
+	ErrInvalidSchedule             = errs.NewValidationError(errors.New("invalid schedule"))
+	ErrInvalidPriority             = errs.NewValidationError(errors.New("priority must be between 1 and 10"))
 )
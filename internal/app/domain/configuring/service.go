@@ -15,12 +15,17 @@ type Repository interface {
 	Save(context.Context, *aggregator.Channel) error
 }
 
+type EnricherRegistry interface {
+	HasEnricher(name string) bool
+}
+
 type Service struct {
-	r Repository
+	r  Repository
+	er EnricherRegistry
 }
 
-func NewService(r Repository) *Service {
-	return &Service{r: r}
+func NewService(r Repository, er EnricherRegistry) *Service {
+	return &Service{r: r, er: er}
 }
 
 func (s *Service) Create(ctx context.Context, cmd *CreateChannelCommand) (*aggregator.Channel, error) {
@@ -75,6 +80,199 @@ func (s *Service) Update(ctx context.Context, cmd *UpdateChannelCommand) (*aggre
 	return ch.toAggregator(), nil
 }
 
+func (s *Service) UpdateSettings(ctx context.Context, cmd *UpdateChannelSettingsCommand) (*aggregator.Channel, error) {
+	aggr, err := s.r.Find(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to find channel: %w", err)
+	}
+
+	ch := newChannelFromAggregator(aggr)
+
+	settings := aggr.Settings
+	settings.MissingAfterImports = cmd.MissingAfterImports
+	settings.MissingAfter = cmd.MissingAfter
+	settings.MaxAge = cmd.MaxAge
+	settings.MaxMissingPercentage = cmd.MaxMissingPercentage
+	settings.MinQualityScore = cmd.MinQualityScore
+	if err := ch.updateSettings(settings); err != nil {
+		return nil, fmt.Errorf("failed to update settings of channel: %w", err)
+	}
+
+	if err := s.r.Save(ctx, ch.toAggregator()); err != nil {
+		return nil, fmt.Errorf("failed to update settings of channel: %w", err)
+	}
+
+	return ch.toAggregator(), nil
+}
+
+func (s *Service) UpdateEnrichers(ctx context.Context, cmd *UpdateChannelEnrichersCommand) (*aggregator.Channel, error) {
+	aggr, err := s.r.Find(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to find channel: %w", err)
+	}
+
+	ch := newChannelFromAggregator(aggr)
+
+	settings := aggr.Settings
+	settings.Enrichers = cmd.Enrichers
+	if err := ch.updateSettings(settings); err != nil {
+		return nil, fmt.Errorf("failed to update enrichers of channel: %w", err)
+	}
+
+	// An enricher the importer does not know would silently do nothing
+	var errs error
+	for _, name := range cmd.Enrichers {
+		if !s.er.HasEnricher(name) {
+			errs = errors.Join(errs, fmt.Errorf("failed to find enricher %s: %w", name, ErrUnknownEnricher))
+		}
+	}
+	if errs != nil {
+		return nil, fmt.Errorf("failed to update enrichers of channel: %w", errs)
+	}
+
+	if err := s.r.Save(ctx, ch.toAggregator()); err != nil {
+		return nil, fmt.Errorf("failed to update enrichers of channel: %w", err)
+	}
+
+	return ch.toAggregator(), nil
+}
+
+func (s *Service) UpdateLanguages(ctx context.Context, cmd *UpdateChannelLanguagesCommand) (*aggregator.Channel, error) {
+	aggr, err := s.r.Find(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to find channel: %w", err)
+	}
+
+	ch := newChannelFromAggregator(aggr)
+
+	settings := aggr.Settings
+	settings.Languages = cmd.Languages
+	if err := ch.updateSettings(settings); err != nil {
+		return nil, fmt.Errorf("failed to update languages of channel: %w", err)
+	}
+
+	if err := s.r.Save(ctx, ch.toAggregator()); err != nil {
+		return nil, fmt.Errorf("failed to update languages of channel: %w", err)
+	}
+
+	return ch.toAggregator(), nil
+}
+
+func (s *Service) UpdateRules(ctx context.Context, cmd *UpdateChannelRulesCommand) (*aggregator.Channel, error) {
+	aggr, err := s.r.Find(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to find channel: %w", err)
+	}
+
+	ch := newChannelFromAggregator(aggr)
+
+	settings := aggr.Settings
+	settings.Rules = cmd.Rules
+	if err := ch.updateSettings(settings); err != nil {
+		return nil, fmt.Errorf("failed to update rules of channel: %w", err)
+	}
+
+	if err := s.r.Save(ctx, ch.toAggregator()); err != nil {
+		return nil, fmt.Errorf("failed to update rules of channel: %w", err)
+	}
+
+	return ch.toAggregator(), nil
+}
+
+func (s *Service) UpdateClassification(ctx context.Context, cmd *UpdateChannelClassificationCommand) (*aggregator.Channel, error) {
+	aggr, err := s.r.Find(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to find channel: %w", err)
+	}
+
+	ch := newChannelFromAggregator(aggr)
+
+	settings := aggr.Settings
+	settings.Seniority = cmd.Seniority
+	settings.EmploymentType = cmd.EmploymentType
+	if err := ch.updateSettings(settings); err != nil {
+		return nil, fmt.Errorf("failed to update classification of channel: %w", err)
+	}
+
+	if err := s.r.Save(ctx, ch.toAggregator()); err != nil {
+		return nil, fmt.Errorf("failed to update classification of channel: %w", err)
+	}
+
+	return ch.toAggregator(), nil
+}
+
+func (s *Service) UpdateSchedule(ctx context.Context, cmd *UpdateChannelScheduleCommand) (*aggregator.Channel, error) {
+	aggr, err := s.r.Find(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to find channel: %w", err)
+	}
+
+	ch := newChannelFromAggregator(aggr)
+
+	// An empty schedule falls back to the default schedule
+	settings := aggr.Settings
+	settings.Schedule = nil
+	if cmd.Cron != "" || cmd.Interval != 0 || cmd.Jitter != 0 || cmd.CatchUp != "" {
+		settings.Schedule = &aggregator.ChannelSchedule{
+			Cron:     cmd.Cron,
+			Interval: cmd.Interval,
+			Jitter:   cmd.Jitter,
+			CatchUp:  cmd.CatchUp,
+		}
+	}
+	if err := ch.updateSettings(settings); err != nil {
+		return nil, fmt.Errorf("failed to update schedule of channel: %w", err)
+	}
+
+	if err := s.r.Save(ctx, ch.toAggregator()); err != nil {
+		return nil, fmt.Errorf("failed to update schedule of channel: %w", err)
+	}
+
+	return ch.toAggregator(), nil
+}
+
+func (s *Service) UpdatePriority(ctx context.Context, cmd *UpdateChannelPriorityCommand) (*aggregator.Channel, error) {
+	aggr, err := s.r.Find(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			return nil, ErrChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to find channel: %w", err)
+	}
+
+	ch := newChannelFromAggregator(aggr)
+
+	settings := aggr.Settings
+	settings.Priority = cmd.Priority
+	if err := ch.updateSettings(settings); err != nil {
+		return nil, fmt.Errorf("failed to update priority of channel: %w", err)
+	}
+
+	if err := s.r.Save(ctx, ch.toAggregator()); err != nil {
+		return nil, fmt.Errorf("failed to update priority of channel: %w", err)
+	}
+
+	return ch.toAggregator(), nil
+}
+
 func (s *Service) Activate(ctx context.Context, id uuid.UUID) error {
 	aggr, err := s.r.Find(ctx, id)
 	if err != nil {
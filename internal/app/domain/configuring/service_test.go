@@ -6,6 +6,7 @@ import (
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/configuring"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/aviseu/jobs-backoffice/internal/errs"
+	"github.com/aviseu/jobs-backoffice/internal/schedule"
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
@@ -278,3 +279,619 @@ func (suite *ServiceSuite) Test_Deactivate_ChannelRepositoryFail() {
 	suite.ErrorContains(err, "boom")
 	suite.False(errs.IsValidationError(err))
 }
+
+func (suite *ServiceSuite) Test_UpdateSettings_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelActivated(),
+		),
+	)
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, 3, 48*time.Hour, 0, 0, 0)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(id, res.ID)
+	suite.Equal(3, res.Settings.MissingAfterImports)
+	suite.Equal(48*time.Hour, res.Settings.MissingAfter)
+
+	// Assert state change
+	ch := dsl.FirstChannel()
+	suite.Equal("channel 1", ch.Name)
+	suite.Equal(aggregator.ChannelStatusActive, ch.Status)
+	suite.Equal(3, ch.Settings.MissingAfterImports)
+	suite.Equal(48*time.Hour, ch.Settings.MissingAfter)
+}
+
+func (suite *ServiceSuite) Test_UpdateSettings_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	cmd := configuring.NewUpdateChannelSettingsCommand(uuid.New(), 3, 0, 0, 0, 0)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrChannelNotFound)
+}
+
+func (suite *ServiceSuite) Test_UpdateSettings_Validation_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, -1, 0, 0, 0, 0)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidMissingGracePeriod)
+	suite.True(errs.IsValidationError(err))
+	suite.Equal(0, dsl.FirstChannel().Settings.MissingAfterImports)
+}
+
+func (suite *ServiceSuite) Test_UpdateSettings_MaxAge_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, 0, 0, 30*24*time.Hour, 0, 0)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(30*24*time.Hour, res.Settings.MaxAge)
+	suite.Equal(30*24*time.Hour, dsl.FirstChannel().Settings.MaxAge)
+}
+
+func (suite *ServiceSuite) Test_UpdateSettings_MaxAge_Validation_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, 0, 0, -time.Hour, 0, 0)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidMaxAge)
+	suite.True(errs.IsValidationError(err))
+}
+
+func (suite *ServiceSuite) Test_UpdateSettings_MinQualityScore_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, 0, 0, 0, 0, 70)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(70, res.Settings.MinQualityScore)
+	suite.Equal(70, dsl.FirstChannel().Settings.MinQualityScore)
+}
+
+func (suite *ServiceSuite) Test_UpdateSettings_MinQualityScore_Validation_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, 0, 0, 0, 0, 101)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidMinQualityScore)
+	suite.True(errs.IsValidationError(err))
+}
+
+func (suite *ServiceSuite) Test_UpdateSettings_MaxMissingPercentage_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, 0, 0, 0, 80, 0)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert
+	suite.NoError(err)
+	suite.InDelta(80, res.Settings.MaxMissingPercentage, 0.001)
+	suite.InDelta(80, dsl.FirstChannel().Settings.MaxMissingPercentage, 0.001)
+}
+
+func (suite *ServiceSuite) Test_UpdateSettings_MaxMissingPercentage_Validation_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelSettingsCommand(id, 0, 0, 0, 101, 0)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSettings(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidMaxMissingPercentage)
+	suite.True(errs.IsValidationError(err))
+}
+
+func (suite *ServiceSuite) Test_UpdateEnrichers_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithNoopEnrichers("language", "salary"),
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MaxAge: time.Hour}),
+		),
+	)
+	cmd := configuring.NewUpdateChannelEnrichersCommand(id, []string{"language", "salary"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateEnrichers(context.Background(), cmd)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal([]string{"language", "salary"}, res.Settings.Enrichers)
+
+	// Assert state change keeps the other settings
+	ch := dsl.FirstChannel()
+	suite.Equal([]string{"language", "salary"}, ch.Settings.Enrichers)
+	suite.Equal(time.Hour, ch.Settings.MaxAge)
+}
+
+func (suite *ServiceSuite) Test_UpdateEnrichers_Builtin_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelEnrichersCommand(id, []string{"salary"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateEnrichers(context.Background(), cmd)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal([]string{"salary"}, res.Settings.Enrichers)
+}
+
+func (suite *ServiceSuite) Test_UpdateEnrichers_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	cmd := configuring.NewUpdateChannelEnrichersCommand(uuid.New(), []string{"language"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateEnrichers(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrChannelNotFound)
+}
+
+func (suite *ServiceSuite) Test_UpdateEnrichers_EmptyName_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithNoopEnrichers("language"),
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelEnrichersCommand(id, []string{"language", " "})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateEnrichers(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidEnricher)
+	suite.True(errs.IsValidationError(err))
+	suite.Empty(dsl.FirstChannel().Settings.Enrichers)
+}
+
+func (suite *ServiceSuite) Test_UpdateEnrichers_Duplicate_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithNoopEnrichers("language"),
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelEnrichersCommand(id, []string{"language", "language"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateEnrichers(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrDuplicateEnricher)
+	suite.True(errs.IsValidationError(err))
+}
+
+func (suite *ServiceSuite) Test_UpdateEnrichers_Unknown_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithNoopEnrichers("language"),
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelEnrichersCommand(id, []string{"language", "horoscope"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateEnrichers(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrUnknownEnricher)
+	suite.ErrorContains(err, "failed to find enricher horoscope")
+	suite.True(errs.IsValidationError(err))
+	suite.Empty(dsl.FirstChannel().Settings.Enrichers)
+}
+
+func (suite *ServiceSuite) Test_UpdateLanguages_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Enrichers: []string{"salary"}}),
+		),
+	)
+	cmd := configuring.NewUpdateChannelLanguagesCommand(id, []string{"de", "en"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateLanguages(context.Background(), cmd)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal([]string{"de", "en"}, res.Settings.Languages)
+
+	// Assert state change keeps the other settings
+	ch := dsl.FirstChannel()
+	suite.Equal([]string{"de", "en"}, ch.Settings.Languages)
+	suite.Equal([]string{"salary"}, ch.Settings.Enrichers)
+}
+
+func (suite *ServiceSuite) Test_UpdateLanguages_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	cmd := configuring.NewUpdateChannelLanguagesCommand(uuid.New(), []string{"de"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateLanguages(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrChannelNotFound)
+}
+
+func (suite *ServiceSuite) Test_UpdateLanguages_Unsupported_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelLanguagesCommand(id, []string{"de", "xx"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateLanguages(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrUnsupportedLanguage)
+	suite.True(errs.IsValidationError(err))
+	suite.Empty(dsl.FirstChannel().Settings.Languages)
+}
+
+func (suite *ServiceSuite) Test_UpdateLanguages_Duplicate_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelLanguagesCommand(id, []string{"de", "de"})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateLanguages(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrDuplicateLanguage)
+	suite.True(errs.IsValidationError(err))
+}
+
+func (suite *ServiceSuite) Test_UpdateRules_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Languages: []string{"de"}}),
+		),
+	)
+	rules := []*aggregator.Rule{
+		{Name: "no students", Action: aggregator.RuleActionExclude, Condition: &aggregator.RuleCondition{Field: "title", Op: "matches", Value: "praktikum|werkstudent"}},
+	}
+	cmd := configuring.NewUpdateChannelRulesCommand(id, rules)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateRules(context.Background(), cmd)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(rules, res.Settings.Rules)
+
+	// Assert state change keeps the other settings
+	ch := dsl.FirstChannel()
+	suite.Equal(rules, ch.Settings.Rules)
+	suite.Equal([]string{"de"}, ch.Settings.Languages)
+}
+
+func (suite *ServiceSuite) Test_UpdateRules_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	cmd := configuring.NewUpdateChannelRulesCommand(uuid.New(), nil)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateRules(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrChannelNotFound)
+}
+
+func (suite *ServiceSuite) Test_UpdateRules_Invalid_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelRulesCommand(id, []*aggregator.Rule{
+		{Name: "broken", Action: aggregator.RuleActionExclude, Condition: &aggregator.RuleCondition{Field: "title", Op: "matches", Value: "(unclosed"}},
+	})
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateRules(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidRules)
+	suite.ErrorContains(err, "rule broken: invalid pattern for field title")
+	suite.True(errs.IsValidationError(err))
+	suite.Empty(dsl.FirstChannel().Settings.Rules)
+}
+
+func (suite *ServiceSuite) Test_UpdateClassification_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Languages: []string{"de"}}),
+		),
+	)
+	cmd := configuring.NewUpdateChannelClassificationCommand(id, "intern", "internship")
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateClassification(context.Background(), cmd)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal("intern", res.Settings.Seniority)
+	suite.Equal("internship", res.Settings.EmploymentType)
+
+	// Assert state change keeps the other settings
+	ch := dsl.FirstChannel()
+	suite.Equal("intern", ch.Settings.Seniority)
+	suite.Equal("internship", ch.Settings.EmploymentType)
+	suite.Equal([]string{"de"}, ch.Settings.Languages)
+}
+
+func (suite *ServiceSuite) Test_UpdateClassification_Clear_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Seniority: "senior", EmploymentType: "contract"}),
+		),
+	)
+	cmd := configuring.NewUpdateChannelClassificationCommand(id, "", "")
+
+	// Execute
+	_, err := dsl.ConfiguringService.UpdateClassification(context.Background(), cmd)
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(dsl.FirstChannel().Settings.Seniority)
+	suite.Empty(dsl.FirstChannel().Settings.EmploymentType)
+}
+
+func (suite *ServiceSuite) Test_UpdateClassification_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	cmd := configuring.NewUpdateChannelClassificationCommand(uuid.New(), "senior", "")
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateClassification(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrChannelNotFound)
+}
+
+func (suite *ServiceSuite) Test_UpdateClassification_Validation_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelClassificationCommand(id, "unknown", "gig")
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateClassification(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidSeniority)
+	suite.ErrorIs(err, configuring.ErrInvalidEmploymentType)
+	suite.True(errs.IsValidationError(err))
+	suite.Empty(dsl.FirstChannel().Settings.Seniority)
+}
+
+func (suite *ServiceSuite) Test_UpdateSchedule_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Languages: []string{"de"}}),
+		),
+	)
+	cmd := configuring.NewUpdateChannelScheduleCommand(id, "0 */6 * * *", 0, 15*time.Minute, "all")
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSchedule(context.Background(), cmd)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(&aggregator.ChannelSchedule{Cron: "0 */6 * * *", Jitter: 15 * time.Minute, CatchUp: "all"}, res.Settings.Schedule)
+
+	// Assert state change keeps the other settings
+	ch := dsl.FirstChannel()
+	suite.Equal(&aggregator.ChannelSchedule{Cron: "0 */6 * * *", Jitter: 15 * time.Minute, CatchUp: "all"}, ch.Settings.Schedule)
+	suite.Equal([]string{"de"}, ch.Settings.Languages)
+}
+
+func (suite *ServiceSuite) Test_UpdateSchedule_Clear_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Interval: time.Hour}}),
+		),
+	)
+	cmd := configuring.NewUpdateChannelScheduleCommand(id, "", 0, 0, "")
+
+	// Execute
+	_, err := dsl.ConfiguringService.UpdateSchedule(context.Background(), cmd)
+
+	// Assert
+	suite.NoError(err)
+	suite.Nil(dsl.FirstChannel().Settings.Schedule)
+}
+
+func (suite *ServiceSuite) Test_UpdateSchedule_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	cmd := configuring.NewUpdateChannelScheduleCommand(uuid.New(), "@daily", 0, 0, "")
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSchedule(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrChannelNotFound)
+}
+
+func (suite *ServiceSuite) Test_UpdateSchedule_Validation_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelScheduleCommand(id, "every monday", 0, -time.Minute, "sometimes")
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdateSchedule(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidSchedule)
+	suite.ErrorIs(err, schedule.ErrInvalidCron)
+	suite.ErrorIs(err, schedule.ErrInvalidJitter)
+	suite.ErrorIs(err, schedule.ErrInvalidCatchUp)
+	suite.True(errs.IsValidationError(err))
+	suite.Nil(dsl.FirstChannel().Settings.Schedule)
+}
+
+func (suite *ServiceSuite) Test_UpdatePriority_Success() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Languages: []string{"de"}}),
+		),
+	)
+	cmd := configuring.NewUpdateChannelPriorityCommand(id, 8)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdatePriority(context.Background(), cmd)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(8, res.Settings.Priority)
+
+	// Assert state change keeps the other settings
+	ch := dsl.FirstChannel()
+	suite.Equal(8, ch.Settings.Priority)
+	suite.Equal([]string{"de"}, ch.Settings.Languages)
+}
+
+func (suite *ServiceSuite) Test_UpdatePriority_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	cmd := configuring.NewUpdateChannelPriorityCommand(uuid.New(), 5)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdatePriority(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrChannelNotFound)
+}
+
+func (suite *ServiceSuite) Test_UpdatePriority_Validation_Fail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(id)),
+	)
+	cmd := configuring.NewUpdateChannelPriorityCommand(id, 11)
+
+	// Execute
+	res, err := dsl.ConfiguringService.UpdatePriority(context.Background(), cmd)
+
+	// Assert
+	suite.Nil(res)
+	suite.ErrorIs(err, configuring.ErrInvalidPriority)
+	suite.True(errs.IsValidationError(err))
+	suite.Equal(0, dsl.FirstChannel().Settings.Priority)
+}
@@ -0,0 +1,215 @@
+package dispatching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+type Config struct {
+	Mode            string         `env:"MODE" envDefault:"broker"`
+	Workers         int            `env:"WORKERS" envDefault:"4"`
+	PollInterval    time.Duration  `env:"POLL_INTERVAL" envDefault:"1s"`
+	DefaultCap      int            `env:"DEFAULT_CAP" envDefault:"0"`
+	IntegrationCaps map[string]int `env:"INTEGRATION_CAPS" envKeyValSeparator:":"`
+	StaleAfter      time.Duration  `env:"STALE_AFTER" envDefault:"1h"`
+	Heartbeat       time.Duration  `env:"HEARTBEAT" envDefault:"1m"`
+}
+
+// missedHeartbeats is the number of heartbeats a running import can miss before it is requeued
+const missedHeartbeats = 3
+
+type Importer interface {
+	Import(ctx context.Context, importID uuid.UUID) error
+}
+
+// Dispatcher runs queued imports on a fixed number of workers, claiming them in weighted fair order
+type Dispatcher struct {
+	qr   QueueRepository
+	im   Importer
+	cfg  Config
+	caps map[aggregator.Integration]int
+	log  *slog.Logger
+}
+
+func NewDispatcher(qr QueueRepository, im Importer, cfg Config, log *slog.Logger) *Dispatcher {
+	return &Dispatcher{
+		qr:  qr,
+		im:  im,
+		cfg: cfg,
+		log: log,
+	}
+}
+
+func (d *Dispatcher) Run(ctx context.Context) error {
+	if d.cfg.Workers < 1 {
+		return fmt.Errorf("failed to start dispatcher with %d workers: at least one is required", d.cfg.Workers)
+	}
+	if d.cfg.PollInterval <= 0 {
+		return fmt.Errorf("failed to start dispatcher with poll interval %s: must be positive", d.cfg.PollInterval)
+	}
+	if d.cfg.Heartbeat <= 0 {
+		return fmt.Errorf("failed to start dispatcher with heartbeat %s: must be positive", d.cfg.Heartbeat)
+	}
+	// A running import misses several heartbeats before it is considered stale, a single late beat does not requeue it
+	if d.cfg.StaleAfter < missedHeartbeats*d.cfg.Heartbeat {
+		return fmt.Errorf("failed to start dispatcher with stale after %s: must be at least %d times the heartbeat of %s", d.cfg.StaleAfter, missedHeartbeats, d.cfg.Heartbeat)
+	}
+	caps := make(map[aggregator.Integration]int, len(d.cfg.IntegrationCaps))
+	for name, c := range d.cfg.IntegrationCaps {
+		i, ok := aggregator.ParseIntegration(name)
+		if !ok {
+			return fmt.Errorf("failed to start dispatcher with cap for integration %s: unknown integration", name)
+		}
+		caps[i] = c
+	}
+	d.caps = caps
+
+	var wg sync.WaitGroup
+	for w := 0; w < d.cfg.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.work(ctx)
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		d.recoverStale(ctx)
+	}()
+
+	wg.Wait()
+	return nil
+}
+
+func (d *Dispatcher) work(ctx context.Context) {
+	for {
+		dispatched, err := d.Dispatch(ctx)
+		if err != nil {
+			d.log.Error(err.Error())
+		}
+		if dispatched && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.cfg.PollInterval):
+		}
+	}
+}
+
+// recoverStale puts imports back in the queue whose worker stopped beating without completing them
+func (d *Dispatcher) recoverStale(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.StaleAfter / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.RequeueStale(ctx); err != nil {
+				d.log.Error(err.Error())
+			}
+		}
+	}
+}
+
+// Dispatch claims the next import and runs it, it reports false when there was nothing to claim.
+// A failed import is completed like a successful one, the import itself records the failure.
+// An import interrupted by a shutdown goes back in the queue.
+func (d *Dispatcher) Dispatch(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+
+	qi, err := d.qr.Claim(ctx, d.caps, d.cfg.DefaultCap)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrQueueEmpty) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to claim import: %w", err)
+	}
+
+	d.log.Info(fmt.Sprintf("dispatching import %s of channel %s with priority %d", qi.ImportID, qi.ChannelID, qi.Priority))
+	// The import is stopped when its claim is lost, another worker may already run it
+	importCtx, stopImport := context.WithCancelCause(ctx)
+	defer stopImport(nil)
+	beatCtx, stopBeat := context.WithCancel(importCtx)
+	var beat sync.WaitGroup
+	beat.Add(1)
+	go func() {
+		defer beat.Done()
+		d.heartbeat(beatCtx, qi.ImportID, qi.ClaimToken.UUID, stopImport)
+	}()
+	importErr := d.im.Import(importCtx, qi.ImportID)
+	stopBeat()
+	beat.Wait()
+	if err := context.Cause(importCtx); errors.Is(err, infrastructure.ErrStaleFence) {
+		return true, fmt.Errorf("failed to finish import %s after losing its claim: %w", qi.ImportID, err)
+	}
+	if importErr != nil && ctx.Err() != nil {
+		if err := d.qr.Requeue(context.WithoutCancel(ctx), qi.ImportID, qi.ClaimToken.UUID); err != nil {
+			return true, fmt.Errorf("failed to requeue interrupted import %s: %w", qi.ImportID, err)
+		}
+		d.log.Info(fmt.Sprintf("requeued interrupted import %s", qi.ImportID))
+
+		return true, nil
+	}
+	if importErr != nil {
+		d.log.Error(fmt.Errorf("failed to execute import %s: %w", qi.ImportID, importErr).Error())
+	}
+
+	if err := d.qr.Complete(context.WithoutCancel(ctx), qi.ImportID, qi.ClaimToken.UUID); err != nil {
+		return true, fmt.Errorf("failed to complete import %s: %w", qi.ImportID, err)
+	}
+
+	return true, nil
+}
+
+// heartbeat keeps the claim of a running import fresh until the context is done.
+// When the claim turns out to be lost, it stops the import with the stale fence as cause.
+func (d *Dispatcher) heartbeat(ctx context.Context, importID, claimToken uuid.UUID, stopImport context.CancelCauseFunc) {
+	ticker := time.NewTicker(d.cfg.Heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := d.qr.Heartbeat(ctx, importID, claimToken)
+			if errors.Is(err, infrastructure.ErrStaleFence) {
+				stopImport(err)
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				d.log.Error(fmt.Errorf("failed to beat heartbeat of import %s: %w", importID, err).Error())
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) RequeueStale(ctx context.Context) (int, error) {
+	n, err := d.qr.RequeueStale(ctx, time.Now().Add(-d.cfg.StaleAfter))
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue stale imports: %w", err)
+	}
+	if n > 0 {
+		d.log.Info(fmt.Sprintf("requeued %d stale imports", n))
+	}
+
+	return n, nil
+}
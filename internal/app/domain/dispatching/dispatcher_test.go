@@ -0,0 +1,327 @@
+package dispatching_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/dispatching"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDispatcher(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DispatcherSuite))
+}
+
+type DispatcherSuite struct {
+	suite.Suite
+}
+
+type importerFunc func(ctx context.Context, importID uuid.UUID) error
+
+func (f importerFunc) Import(ctx context.Context, importID uuid.UUID) error {
+	return f(ctx, importID)
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+		),
+	)
+	suite.NoError(dsl.ImportQueue.PublishImportCommand(context.Background(), iID))
+
+	// Execute
+	dispatched, err := dsl.Dispatcher.Dispatch(context.Background())
+
+	// Assert result
+	suite.NoError(err)
+	suite.True(dispatched)
+
+	// Assert state change
+	suite.Empty(dsl.QueuedImports())
+	suite.Equal(aggregator.ImportStatusCompleted, dsl.FirstImport().Status)
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Contains(lines[0], "dispatching import "+iID.String()+" of channel "+chID.String()+" with priority 1")
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_Empty_Success() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	// Execute
+	dispatched, err := dsl.Dispatcher.Dispatch(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.False(dispatched)
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_ImportFail_Completes() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: iID, ChannelID: uuid.New(), Priority: 1, VirtualTime: 1}),
+	)
+
+	// Execute
+	dispatched, err := dsl.Dispatcher.Dispatch(context.Background())
+
+	// Assert result
+	suite.NoError(err)
+	suite.True(dispatched)
+
+	// Assert the failed import leaves the queue
+	suite.Empty(dsl.QueuedImports())
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 2)
+	suite.Contains(lines[1], "failed to execute import "+iID.String())
+	suite.Contains(lines[1], `"level":"ERROR"`)
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_Canceled_Skips() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: iID, ChannelID: uuid.New(), Priority: 1, VirtualTime: 1}),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Execute
+	dispatched, err := dsl.Dispatcher.Dispatch(ctx)
+
+	// Assert
+	suite.NoError(err)
+	suite.False(dispatched)
+	suite.Equal(aggregator.QueueStatusQueued, dsl.QueuedImports()[0].Status)
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_IntegrationCap_Success() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithDispatchConfig(dispatching.Config{Workers: 1, PollInterval: time.Millisecond, StaleAfter: time.Hour, Heartbeat: time.Minute, DefaultCap: 1}),
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: uuid.New(), ChannelID: uuid.New(), Priority: 1, VirtualTime: 1, Status: aggregator.QueueStatusRunning, StartedAt: null.TimeFrom(time.Now())}),
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: uuid.New(), ChannelID: uuid.New(), Priority: 1, VirtualTime: 2}),
+	)
+
+	// Execute
+	dispatched, err := dsl.Dispatcher.Dispatch(context.Background())
+
+	// Assert the integration is at its cap, so nothing is dispatched
+	suite.NoError(err)
+	suite.False(dispatched)
+	suite.Len(dsl.QueuedImports(), 2)
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_IntegrationCap_ClaimsNextIntegration_Success() {
+	// Prepare
+	next := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithDispatchConfig(dispatching.Config{Workers: 1, PollInterval: time.Millisecond, StaleAfter: time.Hour, Heartbeat: time.Minute, DefaultCap: 1}),
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: uuid.New(), ChannelID: uuid.New(), Priority: 1, VirtualTime: 1, Status: aggregator.QueueStatusRunning, StartedAt: null.TimeFrom(time.Now())}),
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: uuid.New(), ChannelID: uuid.New(), Priority: 1, VirtualTime: 2}),
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: next, ChannelID: uuid.New(), Integration: aggregator.Integration(1), Priority: 1, VirtualTime: 3}),
+	)
+
+	// Execute
+	dispatched, err := dsl.Dispatcher.Dispatch(context.Background())
+
+	// Assert the integration at its cap does not hold up the one behind it
+	suite.NoError(err)
+	suite.True(dispatched)
+	suite.Len(dsl.QueuedImports(), 2)
+	suite.NotContains(dsl.ImportQueueRepository.Queue, next)
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_LostClaim_StopsImport() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: iID, ChannelID: uuid.New(), Priority: 1, VirtualTime: 1}),
+	)
+	cfg := dispatching.Config{Workers: 1, PollInterval: time.Millisecond, StaleAfter: 40 * time.Millisecond, Heartbeat: 5 * time.Millisecond}
+	d := dispatching.NewDispatcher(dsl.ImportQueueRepository, importerFunc(func(ctx context.Context, _ uuid.UUID) error {
+		// Another dispatcher considered the import stale and requeued it
+		n, err := dsl.ImportQueueRepository.RequeueStale(ctx, time.Now().Add(time.Hour))
+		suite.NoError(err)
+		suite.Equal(1, n)
+		<-ctx.Done()
+		return ctx.Err()
+	}), cfg, dsl.Logger)
+
+	// Execute
+	dispatched, err := d.Dispatch(context.Background())
+
+	// Assert result
+	suite.True(dispatched)
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+	suite.ErrorContains(err, "failed to finish import "+iID.String()+" after losing its claim")
+
+	// Assert the import is left in the queue for the worker that claims it next
+	suite.Len(dsl.QueuedImports(), 1)
+	suite.Equal(aggregator.QueueStatusQueued, dsl.QueuedImports()[0].Status)
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_QueueRepositoryFail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithImportQueueRepositoryError(errors.New("boom!")),
+	)
+
+	// Execute
+	dispatched, err := dsl.Dispatcher.Dispatch(context.Background())
+
+	// Assert
+	suite.False(dispatched)
+	suite.EqualError(err, "failed to claim import: boom!")
+}
+
+func (suite *DispatcherSuite) Test_RequeueStale_Success() {
+	// Prepare
+	stale := uuid.New()
+	fresh := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: stale, Status: aggregator.QueueStatusRunning, StartedAt: null.TimeFrom(time.Now().Add(-2 * time.Hour)), HeartbeatAt: null.TimeFrom(time.Now().Add(-2 * time.Hour))}),
+		// Imports running longer than stale after are kept as long as they beat
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: fresh, Status: aggregator.QueueStatusRunning, StartedAt: null.TimeFrom(time.Now().Add(-2 * time.Hour)), HeartbeatAt: null.TimeFrom(time.Now())}),
+	)
+
+	// Execute
+	n, err := dsl.Dispatcher.RequeueStale(context.Background())
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(1, n)
+
+	// Assert state change
+	suite.Equal(aggregator.QueueStatusQueued, dsl.ImportQueueRepository.Queue[stale].Status)
+	suite.Equal(aggregator.QueueStatusRunning, dsl.ImportQueueRepository.Queue[fresh].Status)
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "requeued 1 stale imports")
+}
+
+func (suite *DispatcherSuite) Test_Dispatch_Heartbeat_KeepsLongImportClaimed() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithQueuedImport(&aggregator.QueuedImport{ImportID: iID, ChannelID: uuid.New(), Priority: 1, VirtualTime: 1}),
+	)
+	cfg := dispatching.Config{Workers: 1, PollInterval: time.Millisecond, StaleAfter: 40 * time.Millisecond, Heartbeat: 5 * time.Millisecond}
+	var d *dispatching.Dispatcher
+	requeued := -1
+	d = dispatching.NewDispatcher(dsl.ImportQueueRepository, importerFunc(func(ctx context.Context, _ uuid.UUID) error {
+		// The import runs longer than stale after
+		time.Sleep(100 * time.Millisecond)
+		n, err := d.RequeueStale(ctx)
+		suite.NoError(err)
+		requeued = n
+		return nil
+	}), cfg, dsl.Logger)
+
+	// Execute
+	dispatched, err := d.Dispatch(context.Background())
+
+	// Assert result
+	suite.NoError(err)
+	suite.True(dispatched)
+
+	// Assert the running import was not requeued while it was still beating
+	suite.Equal(0, requeued)
+	suite.Empty(dsl.QueuedImports())
+}
+
+func (suite *DispatcherSuite) Test_Run_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+		),
+	)
+	suite.NoError(dsl.ImportQueue.PublishImportCommand(context.Background(), iID))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Execute
+	done := make(chan error, 1)
+	go func() {
+		done <- dsl.Dispatcher.Run(ctx)
+	}()
+	suite.Eventually(func() bool {
+		return len(dsl.QueuedImports()) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	cancel()
+
+	// Assert
+	suite.NoError(<-done)
+}
+
+func (suite *DispatcherSuite) Test_Run_InvalidConfig_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithDispatchConfig(dispatching.Config{Workers: 1, PollInterval: time.Second, StaleAfter: time.Hour, Heartbeat: time.Minute, IntegrationCaps: map[string]int{"unknown": 1}}),
+	)
+
+	// Execute
+	err := dsl.Dispatcher.Run(context.Background())
+
+	// Assert
+	suite.EqualError(err, "failed to start dispatcher with cap for integration unknown: unknown integration")
+}
+
+func (suite *DispatcherSuite) Test_Run_StaleAfterBelowHeartbeat_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithDispatchConfig(dispatching.Config{Workers: 1, PollInterval: time.Second, StaleAfter: 2 * time.Minute, Heartbeat: time.Minute}),
+	)
+
+	// Execute
+	err := dsl.Dispatcher.Run(context.Background())
+
+	// Assert a couple of late heartbeats are not enough to requeue an import
+	suite.EqualError(err, "failed to start dispatcher with stale after 2m0s: must be at least 3 times the heartbeat of 1m0s")
+}
+
+func (suite *DispatcherSuite) Test_Run_NoWorkers_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithDispatchConfig(dispatching.Config{PollInterval: time.Second, StaleAfter: time.Hour, Heartbeat: time.Minute}),
+	)
+
+	// Execute
+	err := dsl.Dispatcher.Run(context.Background())
+
+	// Assert
+	suite.EqualError(err, "failed to start dispatcher with 0 workers: at least one is required")
+}
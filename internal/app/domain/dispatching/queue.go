@@ -0,0 +1,74 @@
+package dispatching
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+const (
+	ModeBroker = "broker"
+	ModeQueue  = "queue"
+)
+
+type ImportRepository interface {
+	FindImport(ctx context.Context, id uuid.UUID) (*aggregator.Import, error)
+}
+
+type ChannelRepository interface {
+	Find(ctx context.Context, id uuid.UUID) (*aggregator.Channel, error)
+}
+
+type QueueRepository interface {
+	Enqueue(ctx context.Context, qi *aggregator.QueuedImport) error
+	Claim(ctx context.Context, caps map[aggregator.Integration]int, defaultCap int) (*aggregator.QueuedImport, error)
+	Complete(ctx context.Context, importID, claimToken uuid.UUID) error
+	Requeue(ctx context.Context, importID, claimToken uuid.UUID) error
+	Heartbeat(ctx context.Context, importID, claimToken uuid.UUID) error
+	RequeueStale(ctx context.Context, heartbeatBefore time.Time) (int, error)
+}
+
+// Queue puts import commands in the import queue instead of publishing them,
+// so it can stand in for the broker import service when scheduling imports
+type Queue struct {
+	ir  ImportRepository
+	chr ChannelRepository
+	qr  QueueRepository
+}
+
+func NewQueue(ir ImportRepository, chr ChannelRepository, qr QueueRepository) *Queue {
+	return &Queue{
+		ir:  ir,
+		chr: chr,
+		qr:  qr,
+	}
+}
+
+func (q *Queue) PublishImportCommand(ctx context.Context, importID uuid.UUID) error {
+	i, err := q.ir.FindImport(ctx, importID)
+	if err != nil {
+		return fmt.Errorf("failed to find import %s: %w", importID, err)
+	}
+
+	ch, err := q.chr.Find(ctx, i.ChannelID)
+	if err != nil {
+		return fmt.Errorf("failed to find channel %s of import %s: %w", i.ChannelID, importID, err)
+	}
+
+	qi := &aggregator.QueuedImport{
+		ImportID:    i.ID,
+		ChannelID:   ch.ID,
+		Integration: ch.Integration,
+		Priority:    ch.Settings.PriorityOrDefault(),
+		Status:      aggregator.QueueStatusQueued,
+		EnqueuedAt:  time.Now(),
+	}
+	if err := q.qr.Enqueue(ctx, qi); err != nil {
+		return fmt.Errorf("failed to enqueue import %s: %w", importID, err)
+	}
+
+	return nil
+}
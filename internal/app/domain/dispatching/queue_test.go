@@ -0,0 +1,120 @@
+package dispatching_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestQueue(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(QueueSuite))
+}
+
+type QueueSuite struct {
+	suite.Suite
+}
+
+func (suite *QueueSuite) Test_PublishImportCommand_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Priority: 5}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportQueue.PublishImportCommand(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	queued := dsl.QueuedImports()
+	suite.Len(queued, 1)
+	suite.Equal(iID, queued[0].ImportID)
+	suite.Equal(chID, queued[0].ChannelID)
+	suite.Equal(aggregator.IntegrationArbeitnow, queued[0].Integration)
+	suite.Equal(5, queued[0].Priority)
+	suite.Equal(aggregator.QueueStatusQueued, queued[0].Status)
+	suite.InDelta(0.2, queued[0].VirtualTime, 0.0001)
+}
+
+func (suite *QueueSuite) Test_PublishImportCommand_DefaultPriority_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(chID)),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportQueue.PublishImportCommand(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(aggregator.DefaultChannelPriority, dsl.QueuedImports()[0].Priority)
+}
+
+func (suite *QueueSuite) Test_PublishImportCommand_ImportNotFound_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	iID := uuid.New()
+
+	// Execute
+	err := dsl.ImportQueue.PublishImportCommand(context.Background(), iID)
+
+	// Assert
+	suite.ErrorIs(err, infrastructure.ErrImportNotFound)
+	suite.Empty(dsl.QueuedImports())
+}
+
+func (suite *QueueSuite) Test_PublishImportCommand_ChannelNotFound_Fail() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(testutils.WithImportID(iID)),
+	)
+
+	// Execute
+	err := dsl.ImportQueue.PublishImportCommand(context.Background(), iID)
+
+	// Assert
+	suite.ErrorIs(err, infrastructure.ErrChannelNotFound)
+	suite.Empty(dsl.QueuedImports())
+}
+
+func (suite *QueueSuite) Test_PublishImportCommand_QueueRepositoryFail() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelID(chID)),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+		),
+		testutils.WithImportQueueRepositoryError(errors.New("boom!")),
+	)
+
+	// Execute
+	err := dsl.ImportQueue.PublishImportCommand(context.Background(), iID)
+
+	// Assert
+	suite.EqualError(err, "failed to enqueue import "+iID.String()+": boom!")
+}
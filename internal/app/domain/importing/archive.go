@@ -0,0 +1,118 @@
+package importing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+const archiveExtension = ".json.gz"
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	List(ctx context.Context, prefix string) ([]*aggregator.Blob, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type archivedPage struct {
+	raw    []byte
+	number int
+}
+
+type archive struct {
+	bs BlobStore
+}
+
+func newArchive(bs BlobStore) *archive {
+	return &archive{bs: bs}
+}
+
+func archiveChannelPrefix(chID uuid.UUID) string {
+	return "imports/" + chID.String() + "/"
+}
+
+func archiveImportPrefix(chID, importID uuid.UUID) string {
+	return archiveChannelPrefix(chID) + importID.String() + "/"
+}
+
+func (a *archive) store(ctx context.Context, chID, importID uuid.UUID, p *aggregator.JobPage) error {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(p.Raw); err != nil {
+		return fmt.Errorf("failed to compress page %d: %w", p.Number, err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to compress page %d: %w", p.Number, err)
+	}
+
+	key := fmt.Sprintf("%s%05d%s", archiveImportPrefix(chID, importID), p.Number, archiveExtension)
+	if err := a.bs.Put(ctx, key, buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to store page %d: %w", p.Number, err)
+	}
+
+	return nil
+}
+
+func (a *archive) pages(ctx context.Context, chID, importID uuid.UUID) ([]*archivedPage, error) {
+	blobs, err := a.bs.List(ctx, archiveImportPrefix(chID, importID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived pages: %w", err)
+	}
+
+	pages := make([]*archivedPage, 0, len(blobs))
+	for _, b := range blobs {
+		number, err := strconv.Atoi(strings.TrimSuffix(path.Base(b.Key), archiveExtension))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse page number of %s: %w", b.Key, err)
+		}
+
+		data, err := a.bs.Get(ctx, b.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get archived page %d: %w", number, err)
+		}
+
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress archived page %d: %w", number, err)
+		}
+		raw, err := io.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress archived page %d: %w", number, err)
+		}
+
+		pages = append(pages, &archivedPage{number: number, raw: raw})
+	}
+
+	return pages, nil
+}
+
+func (a *archive) purge(ctx context.Context, chID uuid.UUID, before time.Time) (int, error) {
+	blobs, err := a.bs.List(ctx, archiveChannelPrefix(chID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list archived pages: %w", err)
+	}
+
+	purged := 0
+	for _, b := range blobs {
+		if !b.ModifiedAt.Before(before) {
+			continue
+		}
+
+		if err := a.bs.Delete(ctx, b.Key); err != nil {
+			return purged, fmt.Errorf("failed to delete archived page %s: %w", b.Key, err)
+		}
+		purged++
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,60 @@
+package importing
+
+import (
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
+)
+
+type checkpoint struct {
+	updatedAt time.Time
+	nextLink  null.String
+	pages     int
+	jobs      int
+	completed bool
+	importID  uuid.UUID
+}
+
+func newCheckpoint(importID uuid.UUID) *checkpoint {
+	return &checkpoint{
+		importID:  importID,
+		nextLink:  null.NewString("", false),
+		updatedAt: time.Now(),
+	}
+}
+
+func (c *checkpoint) nextPage() int {
+	return c.pages + 1
+}
+
+func (c *checkpoint) advance(p *aggregator.JobPage) {
+	c.pages = p.Number
+	c.jobs += len(p.Jobs)
+	c.nextLink = p.Next
+	c.completed = !p.Next.Valid
+	c.updatedAt = time.Now()
+}
+
+func (c *checkpoint) toAggregator() *aggregator.ImportCheckpoint {
+	return &aggregator.ImportCheckpoint{
+		ImportID:  c.importID,
+		NextLink:  c.nextLink,
+		Pages:     c.pages,
+		Jobs:      c.jobs,
+		Completed: c.completed,
+		UpdatedAt: c.updatedAt,
+	}
+}
+
+func newCheckpointFromAggregator(cp *aggregator.ImportCheckpoint) *checkpoint {
+	return &checkpoint{
+		importID:  cp.ImportID,
+		nextLink:  cp.NextLink,
+		pages:     cp.Pages,
+		jobs:      cp.Jobs,
+		completed: cp.Completed,
+		updatedAt: cp.UpdatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package importing
+
+import (
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+const confidenceOverride = 1
+
+func overrideClassification(jobs []*job, settings aggregator.ChannelSettings) {
+	// Overrides are validated when the settings are saved
+	seniority, hasSeniority := aggregator.ParseSeniority(settings.Seniority)
+	employmentType, hasEmploymentType := aggregator.ParseEmploymentType(settings.EmploymentType)
+
+	for _, j := range jobs {
+		if hasSeniority {
+			j.classification.Seniority = seniority
+			j.classification.SeniorityConfidence = confidenceOverride
+		}
+		if hasEmploymentType {
+			j.classification.EmploymentType = employmentType
+			j.classification.EmploymentTypeConfidence = confidenceOverride
+		}
+	}
+}
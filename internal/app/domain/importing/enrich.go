@@ -0,0 +1,79 @@
+package importing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type Enricher interface {
+	Enrich(ctx context.Context, j *aggregator.Job) error
+}
+
+type EnricherFunc func(ctx context.Context, j *aggregator.Job) error
+
+func (f EnricherFunc) Enrich(ctx context.Context, j *aggregator.Job) error {
+	return f(ctx, j)
+}
+
+// enrichers is the registry shared by imports and previews
+type enrichers struct {
+	enr map[string]Enricher
+	log *slog.Logger
+}
+
+func newEnrichers(log *slog.Logger) *enrichers {
+	return &enrichers{
+		enr: make(map[string]Enricher),
+		log: log,
+	}
+}
+
+func (s *enrichers) RegisterEnricher(name string, e Enricher) {
+	s.enr[name] = e
+}
+
+func (s *enrichers) HasEnricher(name string) bool {
+	_, ok := s.enr[name]
+	return ok
+}
+
+func (s *enrichers) enrich(ctx context.Context, ch *aggregator.Channel, jobs []*job) []*job {
+	// Channels without enrichers of their own get the built-in ones
+	names := ch.Settings.Enrichers
+	if len(names) == 0 {
+		names = DefaultEnrichers
+	}
+
+	// Resolve the configured enrichers once, in the order of the channel settings
+	ee := make(map[string]Enricher, len(names))
+	for _, name := range names {
+		e, ok := s.enr[name]
+		if !ok {
+			s.log.Warn(fmt.Sprintf("unknown enricher %s configured for channel %s", name, ch.ID))
+			continue
+		}
+		ee[name] = e
+	}
+
+	result := make([]*job, len(jobs))
+	for i, j := range jobs {
+		a := j.toAggregator()
+		for _, name := range names {
+			e, ok := ee[name]
+			if !ok {
+				continue
+			}
+
+			// A failing enricher never blocks the import, the job just misses its attributes
+			if err := e.Enrich(ctx, a); err != nil {
+				s.log.Warn(fmt.Sprintf("failed to enrich job %s with %s: %s", j.id, name, err))
+			}
+		}
+		result[i] = newJobFromAggregator(a)
+	}
+
+	return result
+}
@@ -0,0 +1,71 @@
+package importing
+
+import (
+	"context"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/classify"
+	"github.com/aviseu/jobs-backoffice/internal/gazetteer"
+	"github.com/aviseu/jobs-backoffice/internal/language"
+	"github.com/aviseu/jobs-backoffice/internal/salary"
+	"github.com/aviseu/jobs-backoffice/internal/skills"
+)
+
+const (
+	EnricherSalary         = "salary"
+	EnricherLocation       = "location"
+	EnricherLanguage       = "language"
+	EnricherSkills         = "skills"
+	EnricherClassification = "classification"
+)
+
+// DefaultEnrichers run, in this order, for channels that do not configure enrichers of their own
+var DefaultEnrichers = []string{EnricherSalary, EnricherLocation, EnricherLanguage, EnricherSkills, EnricherClassification}
+
+// BuiltinEnrichers returns the enrichers that ship with the importer, every binary that imports registers them
+func BuiltinEnrichers() map[string]Enricher {
+	return map[string]Enricher{
+		EnricherSalary:         EnricherFunc(enrichSalary),
+		EnricherLocation:       EnricherFunc(enrichLocation),
+		EnricherLanguage:       EnricherFunc(enrichLanguage),
+		EnricherSkills:         EnricherFunc(enrichSkills),
+		EnricherClassification: EnricherFunc(enrichClassification),
+	}
+}
+
+func enrichSalary(_ context.Context, j *aggregator.Job) error {
+	// Structured salaries of the provider take precedence over what is mentioned in the text
+	if j.Salary == nil {
+		j.Salary = salary.Parse(j.Title, j.DescriptionText)
+		if j.Salary != nil {
+			j.Salary.Annualize()
+		}
+	}
+
+	return nil
+}
+
+func enrichLocation(_ context.Context, j *aggregator.Job) error {
+	j.Geo = gazetteer.Resolve(j.Location, j.Remote)
+
+	return nil
+}
+
+func enrichLanguage(_ context.Context, j *aggregator.Job) error {
+	j.Language = language.Detect(j.Title, j.DescriptionText)
+
+	return nil
+}
+
+func enrichSkills(_ context.Context, j *aggregator.Job) error {
+	// Provider tags are only used to find skills, they are not stored on their own
+	j.Skills = skills.Extract(append([]string{j.Title, j.DescriptionText}, j.Tags...)...)
+
+	return nil
+}
+
+func enrichClassification(_ context.Context, j *aggregator.Job) error {
+	j.Classification = classify.Classify(j.Title, j.DescriptionText, j.JobTypes)
+
+	return nil
+}
@@ -6,4 +6,10 @@ import (
 	"github.com/aviseu/jobs-backoffice/internal/errs"
 )
 
-var ErrImportNotFound = errs.NewValidationError(errors.New("import not found"))
+var (
+	ErrImportNotFound    = errs.NewValidationError(errors.New("import not found"))
+	ErrChannelNotFound   = errs.NewValidationError(errors.New("channel not found"))
+	ErrArchiveNotFound   = errors.New("no archived pages found")
+	ErrArchiveIncomplete = errors.New("archive does not hold every page of the import")
+	ErrInterrupted       = errors.New("import was interrupted before it finished")
+)
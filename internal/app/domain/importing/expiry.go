@@ -0,0 +1,74 @@
+package importing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+type expiry struct {
+	maxAge time.Duration
+}
+
+func newExpiry(settings aggregator.ChannelSettings) *expiry {
+	return &expiry{maxAge: settings.MaxAge}
+}
+
+func (x *expiry) expired(j *job, now time.Time) bool {
+	// An explicit close date from the provider always applies
+	if j.validThrough.Valid && !now.Before(j.validThrough.Time) {
+		return true
+	}
+
+	return x.maxAge > 0 && now.Sub(j.postedAt) > x.maxAge
+}
+
+// ExpireJobs takes down active jobs past their close date or maximum age. Imports only expire jobs of the
+// channel they import, this also covers channels that are paused or imported rarely.
+func (s *Service) ExpireJobs(ctx context.Context) (int, error) {
+	jobs, err := s.jr.GetActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active jobs: %w", err)
+	}
+
+	now := time.Now()
+	expiries := make(map[uuid.UUID]*expiry)
+	expired := 0
+	for _, aj := range jobs {
+		x, ok := expiries[aj.ChannelID]
+		if !ok {
+			ch, err := s.chr.Find(ctx, aj.ChannelID)
+			if err != nil {
+				return expired, fmt.Errorf("failed to find channel %s of job %s: %w", aj.ChannelID, aj.ID, err)
+			}
+			x = newExpiry(ch.Settings)
+			expiries[aj.ChannelID] = x
+		}
+
+		j := newJobFromAggregator(aj)
+		if !x.expired(j, now) {
+			continue
+		}
+
+		// Jobs that fail here stay active and are expired again next run
+		j.markAsExpired()
+		if err := s.pjs.PublishJobMissing(ctx, j.toAggregator()); err != nil {
+			s.log.Error(fmt.Errorf("failed to publish expired job %s: %w", j.id, err).Error())
+			continue
+		}
+		j.markAsPublished()
+		if err := s.jr.Save(ctx, j.toAggregator()); err != nil {
+			s.log.Error(fmt.Errorf("failed to save expired job %s: %w", j.id, err).Error())
+			continue
+		}
+		if err := s.jr.SaveVersion(ctx, j.newVersion(uuid.NullUUID{})); err != nil {
+			s.log.Error(fmt.Errorf("failed to save version of expired job %s: %w", j.id, err).Error())
+		}
+		expired++
+	}
+
+	return expired, nil
+}
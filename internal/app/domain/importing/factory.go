@@ -5,10 +5,12 @@ import (
 
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/api/arbeitnow"
+	"gopkg.in/guregu/null.v3"
 )
 
 type provider interface {
-	GetJobs() ([]*aggregator.Job, error)
+	GetJobsFrom(next null.String, page int, fn func(p *aggregator.JobPage) error) error
+	ParsePage(raw []byte, page int) (*aggregator.JobPage, error)
 }
 
 type factory struct {
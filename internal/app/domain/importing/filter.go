@@ -0,0 +1,47 @@
+package importing
+
+import (
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/blocklist"
+	"github.com/aviseu/jobs-backoffice/internal/rules"
+	"github.com/google/uuid"
+)
+
+type filter struct {
+	set  *rules.Set
+	lang *languageFilter
+	bl   *blocklist.List
+	// Dead links by job, only for the url that was found dead
+	dead map[uuid.UUID]string
+}
+
+func newFilter(settings aggregator.ChannelSettings, entries []*aggregator.BlocklistEntry, dead []*aggregator.Link) (*filter, error) {
+	set, err := rules.Compile(settings.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile rules: %w", err)
+	}
+
+	d := make(map[uuid.UUID]string, len(dead))
+	for _, l := range dead {
+		d[l.JobID] = l.URL
+	}
+
+	return &filter{set: set, lang: newLanguageFilter(settings), bl: blocklist.New(entries), dead: d}, nil
+}
+
+func (f *filter) deadLink(j *job) bool {
+	// Jobs taken down for a dead link stay down until the provider changes the link
+	url, ok := f.dead[j.id]
+	return ok && url == j.url
+}
+
+func (f *filter) blocks(j *job) bool {
+	return f.bl.Match(j.toAggregator()) != nil
+}
+
+func (f *filter) excludes(j *job) bool {
+	// Jobs in languages the channel is not interested in are filtered like jobs the rules do not allow
+	return !f.lang.allows(j) || !f.set.Allows(j.toAggregator())
+}
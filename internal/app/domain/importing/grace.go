@@ -0,0 +1,36 @@
+package importing
+
+import (
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type grace struct {
+	imports int
+	after   time.Duration
+}
+
+func newGrace(settings aggregator.ChannelSettings) *grace {
+	return &grace{
+		imports: settings.MissingAfterImports,
+		after:   settings.MissingAfter,
+	}
+}
+
+func (g *grace) expired(j *job, now time.Time) bool {
+	// Without a grace period jobs go missing the first time they are not seen
+	if g.imports <= 0 && g.after <= 0 {
+		return true
+	}
+
+	if g.imports > 0 && j.missedImports+1 >= g.imports {
+		return true
+	}
+
+	if g.after > 0 && j.missingSince.Valid && now.Sub(j.missingSince.Time) >= g.after {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+package importing
+
+import (
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type guard struct {
+	cfg ConfigGuard
+}
+
+func newGuard(cfg ConfigGuard) *guard {
+	return &guard{cfg: cfg}
+}
+
+func (g *guard) check(rec *reconciliation, existing []*job, settings aggregator.ChannelSettings) (string, bool) {
+	missing := len(rec.missing)
+	if missing == 0 {
+		return "", true
+	}
+
+	active := 0
+	for _, j := range existing {
+		if j.status == aggregator.JobStatusActive {
+			active++
+		}
+	}
+
+	if g.cfg.MaxMissingCount > 0 && missing > g.cfg.MaxMissingCount {
+		return fmt.Sprintf("%d of %d active jobs would be marked missing, more than the allowed %d", missing, active, g.cfg.MaxMissingCount), false
+	}
+
+	// The threshold of the channel itself applies however small the channel is
+	maxPercentage, minActive := g.cfg.MaxMissingPercentage, g.cfg.MinActiveJobs
+	if settings.MaxMissingPercentage > 0 {
+		maxPercentage, minActive = settings.MaxMissingPercentage, 0
+	}
+	if maxPercentage <= 0 || active == 0 {
+		return "", true
+	}
+
+	// Small channels legitimately lose a large share of their jobs, but not all of them at once
+	if active < minActive {
+		if missing >= active {
+			return fmt.Sprintf("all %d active jobs would be marked missing", active), false
+		}
+
+		return "", true
+	}
+
+	percentage := float64(missing) / float64(active) * 100
+	if percentage > maxPercentage {
+		return fmt.Sprintf("%d of %d active jobs (%.0f%%) would be marked missing, more than the allowed %.0f%%", missing, active, percentage, maxPercentage), false
+	}
+
+	return "", true
+}
@@ -13,11 +13,13 @@ type importEntry struct {
 	endedAt   null.Time
 	error     null.String
 	status    aggregator.ImportStatus
+	replayOf  uuid.NullUUID
 	id        uuid.UUID
 	channelID uuid.UUID
+	approved  bool
 }
 
-func newImportEntry(id, channelID uuid.UUID, status aggregator.ImportStatus, startedAt time.Time, endedAt null.Time, err null.String) *importEntry {
+func newImportEntry(id, channelID uuid.UUID, status aggregator.ImportStatus, startedAt time.Time, endedAt null.Time, err null.String, replayOf uuid.NullUUID, approved bool) *importEntry {
 	i := &importEntry{
 		id:        id,
 		channelID: channelID,
@@ -25,6 +27,8 @@ func newImportEntry(id, channelID uuid.UUID, status aggregator.ImportStatus, sta
 		startedAt: startedAt,
 		endedAt:   endedAt,
 		error:     err,
+		replayOf:  replayOf,
+		approved:  approved,
 	}
 
 	return i
@@ -36,6 +40,19 @@ func (i *importEntry) markAsFailed(err error) {
 	i.error = null.StringFrom(err.Error())
 }
 
+func (i *importEntry) isUnfinished() bool {
+	switch i.status {
+	case aggregator.ImportStatusPending, aggregator.ImportStatusFetching, aggregator.ImportStatusProcessing, aggregator.ImportStatusPublishing:
+		return true
+	default:
+		return false
+	}
+}
+
+func (i *importEntry) isReplay() bool {
+	return i.replayOf.Valid
+}
+
 func (i *importEntry) markAsFetching() {
 	i.status = aggregator.ImportStatusFetching
 }
@@ -48,6 +65,12 @@ func (i *importEntry) markAsPublishing() {
 	i.status = aggregator.ImportStatusPublishing
 }
 
+func (i *importEntry) markAsNeedsReview(reason string) {
+	i.status = aggregator.ImportStatusNeedsReview
+	i.endedAt = null.TimeFrom(time.Now())
+	i.error = null.StringFrom(reason)
+}
+
 func (i *importEntry) markAsCompleted() {
 	i.status = aggregator.ImportStatusCompleted
 	i.endedAt = null.TimeFrom(time.Now())
@@ -61,6 +84,8 @@ func (i *importEntry) toAggregate() *aggregator.Import {
 		EndedAt:   i.endedAt,
 		Error:     i.error,
 		Status:    i.status,
+		ReplayOf:  i.replayOf,
+		Approved:  i.approved,
 	}
 }
 
@@ -72,5 +97,7 @@ func newImportFromAggregator(i *aggregator.Import) *importEntry {
 		i.StartedAt,
 		i.EndedAt,
 		i.Error,
+		i.ReplayOf,
+		i.Approved,
 	)
 }
@@ -5,46 +5,100 @@ import (
 
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
 )
 
 type job struct {
-	postedAt      time.Time
-	createdAt     time.Time
-	updatedAt     time.Time
-	url           string
-	title         string
-	description   string
-	source        string
-	location      string
-	id            uuid.UUID
-	remote        bool
-	channelID     uuid.UUID
-	status        aggregator.JobStatus
-	publishStatus aggregator.JobPublishStatus
-}
-
-func newJob(id, channelID uuid.UUID, s aggregator.JobStatus, url, title, description, source, location string, remote bool, postedAt time.Time, publishStatus aggregator.JobPublishStatus, createdAt, updatedAt time.Time) *job {
-	return &job{
-		id:            id,
-		channelID:     channelID,
-		status:        s,
-		publishStatus: publishStatus,
-		url:           url,
-		title:         title,
-		description:   description,
-		source:        source,
-		location:      location,
-		remote:        remote,
-		postedAt:      postedAt,
-		createdAt:     createdAt,
-		updatedAt:     updatedAt,
-	}
+	postedAt            time.Time
+	createdAt           time.Time
+	updatedAt           time.Time
+	missingSince        null.Time
+	validThrough        null.Time
+	url                 string
+	title               string
+	description         string
+	descriptionText     string
+	descriptionMarkdown string
+	source              string
+	company             string
+	location            string
+	language            string
+	geo                 aggregator.Geo
+	enrichments         aggregator.Enrichments
+	salary              *aggregator.Salary
+	quality             *aggregator.Quality
+	skills              aggregator.Skills
+	tags                []string
+	jobTypes            []string
+	classification      aggregator.Classification
+	id                  uuid.UUID
+	remote              bool
+	missedImports       int
+	channelID           uuid.UUID
+	status              aggregator.JobStatus
+	publishStatus       aggregator.JobPublishStatus
+	changes             []*aggregator.JobFieldChange
+	changeReason        aggregator.ImportMetricType
+	versioned           bool
+	wasPublished        bool
 }
 
 func (j *job) markAsMissing() {
+	prev := *j
+	j.status = aggregator.JobStatusInactive
+	j.publishStatus = aggregator.JobPublishStatusUnpublished
+	j.updatedAt = time.Now()
+	j.recordChanges(&prev, aggregator.ImportMetricTypeMissing)
+}
+
+func (j *job) markAsExpired() {
+	prev := *j
+	j.status = aggregator.JobStatusInactive
+	j.publishStatus = aggregator.JobPublishStatusUnpublished
+	j.updatedAt = time.Now()
+	j.recordChanges(&prev, aggregator.ImportMetricTypeExpired)
+}
+
+func (j *job) markAsFiltered() {
+	prev := *j
 	j.status = aggregator.JobStatusInactive
 	j.publishStatus = aggregator.JobPublishStatusUnpublished
 	j.updatedAt = time.Now()
+	j.recordChanges(&prev, aggregator.ImportMetricTypeFiltered)
+}
+
+func (j *job) markAsBlocked() {
+	prev := *j
+	j.status = aggregator.JobStatusInactive
+	j.publishStatus = aggregator.JobPublishStatusUnpublished
+	j.updatedAt = time.Now()
+	j.recordChanges(&prev, aggregator.ImportMetricTypeBlocked)
+}
+
+func (j *job) markAsDeadLink() {
+	prev := *j
+	j.status = aggregator.JobStatusInactive
+	j.publishStatus = aggregator.JobPublishStatusUnpublished
+	j.updatedAt = time.Now()
+	j.recordChanges(&prev, aggregator.ImportMetricTypeDeadLink)
+}
+
+func (j *job) markAsPendingMissing(now time.Time) {
+	j.missedImports++
+	if !j.missingSince.Valid {
+		j.missingSince = null.TimeFrom(now)
+	}
+	j.updatedAt = now
+}
+
+func (j *job) isPendingMissing() bool {
+	return j.missedImports > 0 || j.missingSince.Valid
+}
+
+func (j *job) markAsSeen() {
+	j.missedImports = 0
+	j.missingSince = null.NewTime(time.Time{}, false)
+	j.updatedAt = time.Now()
 }
 
 func (j *job) markAsChanged() {
@@ -58,12 +112,37 @@ func (j *job) markAsPublished() {
 	j.updatedAt = time.Now()
 }
 
+func (j *job) markAsHeld() {
+	j.publishStatus = aggregator.JobPublishStatusHeld
+	j.updatedAt = time.Now()
+}
+
+func (j *job) isPublishedBelow(q *aggregator.Quality, minScore int) bool {
+	return j.status == aggregator.JobStatusActive && j.publishStatus == aggregator.JobPublishStatusPublished && q != nil && q.Score < minScore
+}
+
+func (j *job) markForTakeDown(q *aggregator.Quality) {
+	j.quality = q
+	j.wasPublished = true
+	j.publishStatus = aggregator.JobPublishStatusUnpublished
+}
+
+func (j *job) isHeld() bool {
+	return j.publishStatus == aggregator.JobPublishStatusHeld
+}
+
 func (j *job) needsPublishing() bool {
-	return j.publishStatus == aggregator.JobPublishStatusUnpublished
+	return j.publishStatus != aggregator.JobPublishStatusPublished
+}
+
+func (j *job) meetsQuality(minScore int) bool {
+	// Jobs that were never scored are not held back
+	return j.quality == nil || j.quality.Score >= minScore
 }
 
 func (j *job) IsEqual(other *job) bool {
 	// ignore publish status, created at and updated at
+	// ignore quality, it also depends on the rest of the batch, so a different batch alone is no change
 	return j.status == other.status &&
 		j.id == other.id &&
 		j.channelID == other.channelID &&
@@ -71,43 +150,90 @@ func (j *job) IsEqual(other *job) bool {
 		j.title == other.title &&
 		j.description == other.description &&
 		j.source == other.source &&
+		j.company == other.company &&
 		j.location == other.location &&
 		j.remote == other.remote &&
-		j.postedAt.Equal(other.postedAt)
+		j.postedAt.Equal(other.postedAt) &&
+		j.validThrough.Equal(other.validThrough) &&
+		j.enrichments.Equal(other.enrichments) &&
+		j.salary.Equal(other.salary) &&
+		j.geo == other.geo &&
+		j.language == other.language &&
+		j.skills.Equal(other.skills) &&
+		j.classification == other.classification
 }
 
 func (j *job) toAggregator() *aggregator.Job {
 	return &aggregator.Job{
-		ID:            j.id,
-		ChannelID:     j.channelID,
-		URL:           j.url,
-		Title:         j.title,
-		Description:   j.description,
-		Source:        j.source,
-		Location:      j.location,
-		Remote:        j.remote,
-		PostedAt:      j.postedAt,
-		CreatedAt:     j.createdAt,
-		UpdatedAt:     j.updatedAt,
-		Status:        j.status,
-		PublishStatus: j.publishStatus,
+		ID:                  j.id,
+		ChannelID:           j.channelID,
+		URL:                 j.url,
+		Title:               j.title,
+		Description:         j.description,
+		DescriptionText:     j.descriptionText,
+		DescriptionMarkdown: j.descriptionMarkdown,
+		Source:              j.source,
+		Company:             j.company,
+		Location:            j.location,
+		Geo:                 j.geo,
+		Language:            j.language,
+		Enrichments:         j.enrichments,
+		Salary:              j.salary,
+		Quality:             j.quality,
+		Skills:              j.skills,
+		Tags:                j.tags,
+		JobTypes:            j.jobTypes,
+		Classification:      j.classification,
+		Remote:              j.remote,
+		PostedAt:            j.postedAt,
+		CreatedAt:           j.createdAt,
+		UpdatedAt:           j.updatedAt,
+		Status:              j.status,
+		PublishStatus:       j.publishStatus,
+		MissedImports:       j.missedImports,
+		MissingSince:        j.missingSince,
+		ValidThrough:        j.validThrough,
 	}
 }
 
 func newJobFromAggregator(j *aggregator.Job) *job {
-	return newJob(
-		j.ID,
-		j.ChannelID,
-		j.Status,
-		j.URL,
-		j.Title,
-		j.Description,
-		j.Source,
-		j.Location,
-		j.Remote,
-		j.PostedAt,
-		j.PublishStatus,
-		j.CreatedAt,
-		j.UpdatedAt,
-	)
+	return &job{
+		id:                  j.ID,
+		channelID:           j.ChannelID,
+		status:              j.Status,
+		publishStatus:       j.PublishStatus,
+		url:                 j.URL,
+		title:               j.Title,
+		description:         j.Description,
+		descriptionText:     j.DescriptionText,
+		descriptionMarkdown: j.DescriptionMarkdown,
+		source:              j.Source,
+		company:             j.Company,
+		location:            j.Location,
+		geo:                 j.Geo,
+		language:            j.Language,
+		enrichments:         j.Enrichments,
+		salary:              j.Salary,
+		quality:             j.Quality,
+		skills:              j.Skills,
+		tags:                j.Tags,
+		jobTypes:            j.JobTypes,
+		classification:      j.Classification,
+		remote:              j.Remote,
+		postedAt:            j.PostedAt,
+		createdAt:           j.CreatedAt,
+		updatedAt:           j.UpdatedAt,
+		missedImports:       j.MissedImports,
+		missingSince:        j.MissingSince,
+		validThrough:        j.ValidThrough,
+	}
+}
+
+func toAggregatorJobs(jobs []*job) []*aggregator.Job {
+	result := make([]*aggregator.Job, len(jobs))
+	for i, j := range jobs {
+		result[i] = j.toAggregator()
+	}
+
+	return result
 }
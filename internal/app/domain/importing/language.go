@@ -0,0 +1,20 @@
+package importing
+
+import (
+	"slices"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type languageFilter struct {
+	languages []string
+}
+
+func newLanguageFilter(settings aggregator.ChannelSettings) *languageFilter {
+	return &languageFilter{languages: settings.Languages}
+}
+
+func (f *languageFilter) allows(j *job) bool {
+	// Jobs of which the language could not be detected are kept
+	return len(f.languages) == 0 || j.language == "" || slices.Contains(f.languages, j.language)
+}
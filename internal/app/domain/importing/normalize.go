@@ -0,0 +1,33 @@
+package importing
+
+import (
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/deadline"
+	"github.com/aviseu/jobs-backoffice/internal/links"
+	"github.com/aviseu/jobs-backoffice/internal/richtext"
+)
+
+func (j *job) normalize(f aggregator.DescriptionFormat) {
+	// Tracking parameters and redirectors would make the same posting look like a different one
+	j.url = links.Canonicalize(j.url)
+
+	// Descriptions are always stored as safe html, plaintext is converted first
+	if f == aggregator.DescriptionFormatText {
+		j.description = richtext.FromText(j.description)
+	} else {
+		j.description = richtext.Sanitize(j.description)
+	}
+
+	j.descriptionText = richtext.Text(j.description)
+	j.descriptionMarkdown = richtext.Markdown(j.description)
+
+	// Close dates of the provider take precedence over a deadline mentioned in the text
+	if !j.validThrough.Valid {
+		j.validThrough = deadline.Parse(j.descriptionText)
+	}
+
+	// Structured salaries of the provider are compared by their annual amounts
+	if j.salary != nil {
+		j.salary.Annualize()
+	}
+}
@@ -0,0 +1,142 @@
+package importing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
+)
+
+var errPreviewTruncated = errors.New("preview reached its page limit")
+
+type ConfigPreview struct {
+	MaxPages int           `env:"MAX_PAGES" envDefault:"1"`
+	Timeout  time.Duration `env:"TIMEOUT" envDefault:"15s"`
+}
+
+// PreviewService dry-runs an import of a channel, it never stores, archives or publishes anything
+type PreviewService struct {
+	chr ChannelRepository
+	jr  JobRepository
+	br  BlocklistRepository
+	lr  LinkRepository
+	c   HTTPClient
+	cfg Config
+
+	*enrichers
+}
+
+func NewPreviewService(chr ChannelRepository, jr JobRepository, br BlocklistRepository, lr LinkRepository, c HTTPClient, cfg Config, log *slog.Logger) *PreviewService {
+	return &PreviewService{
+		chr: chr,
+		jr:  jr,
+		br:  br,
+		lr:  lr,
+		c:   c,
+		cfg: cfg,
+
+		enrichers: newEnrichers(log),
+	}
+}
+
+func (s *PreviewService) Preview(ctx context.Context, chID uuid.UUID) (*aggregator.ImportPreview, error) {
+	ch, err := s.chr.Find(ctx, chID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrChannelNotFound) {
+			return nil, ErrChannelNotFound
+		}
+
+		return nil, fmt.Errorf("failed to find channel %s: %w", chID, err)
+	}
+
+	// Providers do not take a context, the deadline is put on every request they make
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Preview.Timeout)
+	defer cancel()
+
+	p, err := newFactory(&contextClient{c: s.c, ctx: ctx}, s.cfg).create(ch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create provider for channel %s: %w", ch.ID, err)
+	}
+
+	// Fetch the first pages without archiving or staging
+	pages := 0
+	truncated := false
+	incomingJobs := make([]*job, 0)
+	err = p.GetJobsFrom(null.NewString("", false), 1, func(page *aggregator.JobPage) error {
+		pages = page.Number
+		for _, j := range page.Jobs {
+			nj := newJobFromAggregator(j)
+			nj.normalize(ch.Integration.DescriptionFormat())
+			incomingJobs = append(incomingJobs, nj)
+		}
+
+		if page.Number >= s.cfg.Preview.MaxPages && page.Next.Valid {
+			truncated = true
+			return errPreviewTruncated
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPreviewTruncated) {
+		return nil, fmt.Errorf("failed to preview channel %s: %w", ch.ID, err)
+	}
+	incomingJobs = s.enrich(ctx, ch, incomingJobs)
+	score(incomingJobs)
+	overrideClassification(incomingJobs, ch.Settings)
+
+	dbJobs, err := s.jr.GetByChannelID(ctx, ch.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing jobs: %w", err)
+	}
+
+	existingJobs := make([]*job, len(dbJobs))
+	for i, job := range dbJobs {
+		existingJobs[i] = newJobFromAggregator(job)
+	}
+
+	f, err := loadFilter(ctx, s.br, s.lr, ch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create filter for channel %s: %w", ch.ID, err)
+	}
+
+	rec := reconcile(incomingJobs, existingJobs, newGrace(ch.Settings), newExpiry(ch.Settings), f, time.Now())
+
+	// Jobs on the pages that were not fetched would all look missing
+	if truncated {
+		rec.missing = nil
+		rec.pending = nil
+	}
+
+	return &aggregator.ImportPreview{
+		ChannelID: ch.ID,
+		Pages:     pages,
+		Truncated: truncated,
+		Total:     len(incomingJobs),
+		New:       toAggregatorJobs(rec.new),
+		Updated:   toAggregatorJobs(rec.updated),
+		NoChange:  len(rec.noChange),
+		Missing:   toAggregatorJobs(rec.missing),
+		Pending:   len(rec.pending),
+		Expired:   len(rec.expired),
+		Filtered:  len(rec.filtered),
+		Blocked:   len(rec.blocked),
+		DeadLinks: len(rec.dead),
+		Problems:  validateJobs(incomingJobs),
+	}, nil
+}
+
+type contextClient struct {
+	c   HTTPClient
+	ctx context.Context
+}
+
+func (c *contextClient) Do(req *http.Request) (*http.Response, error) {
+	return c.c.Do(req.WithContext(c.ctx))
+}
@@ -0,0 +1,14 @@
+package importing
+
+import (
+	"github.com/aviseu/jobs-backoffice/internal/quality"
+)
+
+func score(jobs []*job) {
+	// Duplicates are detected across all jobs of the same import
+	aa := toAggregatorJobs(jobs)
+	s := quality.NewScorer(aa)
+	for i, j := range jobs {
+		j.quality = s.Score(aa[i])
+	}
+}
@@ -0,0 +1,118 @@
+package importing
+
+import (
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+type reconciliation struct {
+	new      []*job
+	updated  []*job
+	noChange []*job
+	missing  []*job
+	pending  []*job
+	seen     []*job
+	expired  []*job
+	filtered []*job
+	blocked  []*job
+	dead     []*job
+	previous map[uuid.UUID]*job
+}
+
+func reconcile(incoming, existing []*job, g *grace, x *expiry, f *filter, now time.Time) *reconciliation {
+	r := &reconciliation{
+		new:      make([]*job, 0),
+		updated:  make([]*job, 0),
+		noChange: make([]*job, 0),
+		missing:  make([]*job, 0),
+		pending:  make([]*job, 0),
+		seen:     make([]*job, 0),
+		expired:  make([]*job, 0),
+		filtered: make([]*job, 0),
+		blocked:  make([]*job, 0),
+		dead:     make([]*job, 0),
+		previous: make(map[uuid.UUID]*job),
+	}
+
+	existingByID := make(map[uuid.UUID]*job, len(existing))
+	for _, e := range existing {
+		existingByID[e.id] = e
+	}
+
+	// Classify incoming jobs against what is already stored
+	incomingIDs := make(map[uuid.UUID]struct{}, len(incoming))
+	for _, j := range incoming {
+		incomingIDs[j.id] = struct{}{}
+
+		e, found := existingByID[j.id]
+
+		// Expired postings are never (re)activated, active ones are taken down
+		if x.expired(j, now) {
+			if found && e.status == aggregator.JobStatusActive {
+				r.expired = append(r.expired, e)
+			}
+			continue
+		}
+
+		// Postings with a dead link are never stored, active ones are taken down
+		if f.deadLink(j) {
+			r.dead = append(r.dead, j)
+			if found && e.status == aggregator.JobStatusActive {
+				r.previous[j.id] = e
+			}
+			continue
+		}
+
+		// Blocked postings are never stored, active ones are taken down
+		if f.blocks(j) {
+			r.blocked = append(r.blocked, j)
+			if found && e.status == aggregator.JobStatusActive {
+				r.previous[j.id] = e
+			}
+			continue
+		}
+
+		// Filtered postings are never stored, active ones are taken down
+		if f.excludes(j) {
+			r.filtered = append(r.filtered, j)
+			if found && e.status == aggregator.JobStatusActive {
+				r.previous[j.id] = e
+			}
+			continue
+		}
+
+		switch {
+		case !found:
+			r.new = append(r.new, j)
+		case j.IsEqual(e):
+			r.noChange = append(r.noChange, j)
+			r.previous[j.id] = e
+			if e.isPendingMissing() {
+				r.seen = append(r.seen, e)
+			}
+		default:
+			r.updated = append(r.updated, j)
+			r.previous[j.id] = e
+		}
+	}
+
+	// Active jobs that did not come in anymore expire, or go missing once their grace period is over
+	for _, e := range existing {
+		if e.status != aggregator.JobStatusInactive {
+			if _, ok := incomingIDs[e.id]; !ok {
+				switch {
+				case x.expired(e, now):
+					r.expired = append(r.expired, e)
+				case g.expired(e, now):
+					r.missing = append(r.missing, e)
+				default:
+					r.pending = append(r.pending, e)
+				}
+			}
+		}
+	}
+
+	return r
+}
@@ -7,7 +7,9 @@ import (
 	"log/slog"
 	"net/http"
 	"sync"
+	"time"
 
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/api/arbeitnow"
 	"github.com/google/uuid"
@@ -17,6 +19,7 @@ import (
 type PubSubService interface {
 	PublishJobInformation(ctx context.Context, job *aggregator.Job) error
 	PublishJobMissing(ctx context.Context, job *aggregator.Job) error
+	PublishJobHeld(ctx context.Context, job *aggregator.Job) error
 }
 
 type ConfigWorker struct {
@@ -24,8 +27,22 @@ type ConfigWorker struct {
 	Workers    int `env:"WORKERS" envDefault:"10"`
 }
 
+type ConfigArchive struct {
+	Enabled   bool          `env:"ENABLED" envDefault:"true"`
+	Retention time.Duration `env:"RETENTION" envDefault:"720h"`
+}
+
+type ConfigGuard struct {
+	MaxMissingPercentage float64 `env:"MAX_MISSING_PERCENTAGE" envDefault:"50"`
+	MaxMissingCount      int     `env:"MAX_MISSING_COUNT" envDefault:"0"`
+	MinActiveJobs        int     `env:"MIN_ACTIVE_JOBS" envDefault:"10"`
+}
+
 type Config struct {
 	Arbeitnow arbeitnow.Config `env:"ARBEITNOW"`
+	Archive   ConfigArchive    `envPrefix:"ARCHIVE_"`
+	Guard     ConfigGuard      `envPrefix:"GUARD_"`
+	Preview   ConfigPreview    `envPrefix:"PREVIEW_"`
 
 	Import struct {
 		Metric  ConfigWorker `envPrefix:"METRIC_"`
@@ -37,14 +54,21 @@ type Config struct {
 type ImportRepository interface {
 	SaveImport(ctx context.Context, i *aggregator.Import) error
 	SaveImportMetric(ctx context.Context, importID uuid.UUID, m *aggregator.ImportMetric) error
+	SaveImportCheckpoint(ctx context.Context, cp *aggregator.ImportCheckpoint, jobs []*aggregator.Job) error
+	ClearStagedJobs(ctx context.Context, importID uuid.UUID) error
 
 	FindImport(ctx context.Context, id uuid.UUID) (*aggregator.Import, error)
+	GetImports(ctx context.Context) ([]*aggregator.Import, error)
+	FindImportCheckpoint(ctx context.Context, importID uuid.UUID) (*aggregator.ImportCheckpoint, error)
+	GetStagedJobs(ctx context.Context, importID uuid.UUID) ([]*aggregator.Job, error)
 }
 
 type JobRepository interface {
 	Save(ctx context.Context, j *aggregator.Job) error
 	GetByChannelID(ctx context.Context, chID uuid.UUID) ([]*aggregator.Job, error)
+	GetActive(ctx context.Context) ([]*aggregator.Job, error)
 	GetActiveUnpublishedByChannelID(ctx context.Context, chID uuid.UUID) ([]*aggregator.Job, error)
+	SaveVersion(ctx context.Context, v *aggregator.JobVersion) error
 }
 
 type ChannelRepository interface {
@@ -52,6 +76,14 @@ type ChannelRepository interface {
 	Find(ctx context.Context, id uuid.UUID) (*aggregator.Channel, error)
 }
 
+type BlocklistRepository interface {
+	All(ctx context.Context) ([]*aggregator.BlocklistEntry, error)
+}
+
+type LinkRepository interface {
+	GetDeadByChannelID(ctx context.Context, chID uuid.UUID) ([]*aggregator.Link, error)
+}
+
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
@@ -60,21 +92,33 @@ type Service struct {
 	jr  JobRepository
 	ir  ImportRepository
 	chr ChannelRepository
+	br  BlocklistRepository
+	lr  LinkRepository
 	pjs PubSubService
 	f   *factory
+	a   *archive
+	g   *guard
 	log *slog.Logger
 	cfg Config
+
+	*enrichers
 }
 
-func NewService(chr ChannelRepository, ir ImportRepository, jr JobRepository, c HTTPClient, cfg Config, pjs PubSubService, log *slog.Logger) *Service {
+func NewService(chr ChannelRepository, ir ImportRepository, jr JobRepository, br BlocklistRepository, lr LinkRepository, c HTTPClient, cfg Config, pjs PubSubService, bs BlobStore, log *slog.Logger) *Service {
 	return &Service{
 		chr: chr,
 		jr:  jr,
 		ir:  ir,
+		br:  br,
+		lr:  lr,
 		f:   newFactory(c, cfg),
+		a:   newArchive(bs),
+		g:   newGuard(cfg.Guard),
 		pjs: pjs,
 		log: log,
 		cfg: cfg,
+
+		enrichers: newEnrichers(log),
 	}
 }
 
@@ -89,7 +133,7 @@ func (s *Service) metricWorker(ctx context.Context, wg *sync.WaitGroup, i *impor
 	wg.Done()
 }
 
-func (s *Service) jobWorker(ctx context.Context, wg *sync.WaitGroup, jobs <-chan *job, metrics chan<- *aggregator.ImportMetric, errs chan<- error, publishMetric aggregator.ImportMetricType) {
+func (s *Service) jobWorker(ctx context.Context, wg *sync.WaitGroup, importID uuid.UUID, jobs <-chan *job, metrics chan<- *aggregator.ImportMetric, errs chan<- error, publishMetric aggregator.ImportMetricType, minScore int) {
 	for j := range jobs {
 		if j.needsPublishing() {
 			if j.status == aggregator.JobStatusInactive {
@@ -101,7 +145,7 @@ func (s *Service) jobWorker(ctx context.Context, wg *sync.WaitGroup, jobs <-chan
 					j.markAsPublished()
 					metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeMissingPublish}
 				}
-			} else {
+			} else if j.meetsQuality(minScore) {
 				err := s.pjs.PublishJobInformation(ctx, j.toAggregator())
 				if err != nil {
 					errs <- fmt.Errorf("failed to publish job %s: %w", j.id, err)
@@ -110,12 +154,34 @@ func (s *Service) jobWorker(ctx context.Context, wg *sync.WaitGroup, jobs <-chan
 					j.markAsPublished()
 					metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: publishMetric}
 				}
+			} else if j.wasPublished {
+				// Jobs below the quality threshold of the channel are stored, but held back, a published version is taken down
+				err := s.pjs.PublishJobHeld(ctx, j.toAggregator())
+				if err != nil {
+					// The published version is still up, the next import takes it down
+					j.markAsPublished()
+					errs <- fmt.Errorf("failed to publish job %s: %w", j.id, err)
+					metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeError}
+				} else {
+					j.markAsHeld()
+					metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeHeldPublish}
+				}
+			} else {
+				j.markAsHeld()
 			}
 		}
 
 		if err := s.jr.Save(ctx, j.toAggregator()); err != nil {
 			errs <- fmt.Errorf("failed to save job %s: %w", j.id, err)
 			metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeError}
+			continue
+		}
+
+		if j.versioned {
+			if err := s.jr.SaveVersion(ctx, j.newVersion(uuid.NullUUID{UUID: importID, Valid: true})); err != nil {
+				errs <- fmt.Errorf("failed to save version of job %s: %w", j.id, err)
+				metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeError}
+			}
 		}
 	}
 	wg.Done()
@@ -153,8 +219,13 @@ func (s *Service) Import(ctx context.Context, importID uuid.UUID) error {
 		return fmt.Errorf("failed to set status fetching for import %s: %w", i.id, err)
 	}
 
-	// Fetch jobs from external API
-	pJobs, err := p.GetJobs()
+	// Fetch jobs from external API, or from the archive of the replayed import
+	var pJobs []*aggregator.Job
+	if i.isReplay() {
+		pJobs, err = s.replay(ctx, p, ch, i.replayOf.UUID)
+	} else {
+		pJobs, err = s.fetch(ctx, p, ch, i)
+	}
 	if err != nil {
 		err := fmt.Errorf("failed to import channel %s: %w", ch.ID, err)
 		i.markAsFailed(err)
@@ -165,10 +236,60 @@ func (s *Service) Import(ctx context.Context, importID uuid.UUID) error {
 		return err
 	}
 
-	// Convert aggregator jobs into domain jobs
+	// Convert aggregator jobs into domain jobs with normalized descriptions
 	incomingJobs := make([]*job, len(pJobs))
 	for i, job := range pJobs {
 		incomingJobs[i] = newJobFromAggregator(job)
+		incomingJobs[i].normalize(ch.Integration.DescriptionFormat())
+	}
+
+	// Derive extra attributes with the enrichers configured on the channel
+	incomingJobs = s.enrich(ctx, ch, incomingJobs)
+
+	// Score the quality of the jobs, low quality jobs are held back from publishing
+	score(incomingJobs)
+
+	// Overrides of the channel win over the classification of the enricher
+	overrideClassification(incomingJobs, ch.Settings)
+
+	// Get existing jobs from the database
+	dbJobs, err := s.jr.GetByChannelID(ctx, ch.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get existing jobs: %w", err)
+	}
+
+	// Convert aggregator jobs into domain jobs
+	existingJobs := make([]*job, len(dbJobs))
+	for i, job := range dbJobs {
+		existingJobs[i] = newJobFromAggregator(job)
+	}
+
+	f, err := s.newFilter(ctx, ch)
+	if err != nil {
+		return fmt.Errorf("failed to create filter for channel %s: %w", ch.ID, err)
+	}
+
+	rec := reconcile(incomingJobs, existingJobs, newGrace(ch.Settings), newExpiry(ch.Settings), f, time.Now())
+
+	// A replay only records what would change, it never overwrites current jobs with old data or publishes it
+	if i.isReplay() {
+		return s.dryRun(ctx, i, rec)
+	}
+
+	// *******************************************************
+	// Import status: needs review
+	// *******************************************************
+	// Hold the import when too many jobs would disappear at once, unless an operator approved it
+	if !i.approved {
+		if reason, ok := s.g.check(rec, existingJobs, ch.Settings); !ok {
+			i.markAsNeedsReview(reason)
+			if err := s.ir.SaveImport(ctx, i.toAggregate()); err != nil {
+				return fmt.Errorf("failed to mark import %s as needs review: %w", i.id, err)
+			}
+
+			s.log.Warn(fmt.Sprintf("import %s for channel %s needs review: %s", i.id, ch.ID, reason))
+			return nil
+		}
 	}
 
 	// *******************************************************
@@ -194,7 +315,7 @@ func (s *Service) Import(ctx context.Context, importID uuid.UUID) error {
 	jobsToSave := make(chan *job, s.cfg.Import.Job.BufferSize)
 	for w := 1; w <= s.cfg.Import.Job.Workers; w++ {
 		jobsWG.Add(1)
-		go s.jobWorker(ctx, &jobsWG, jobsToSave, metrics, errs, aggregator.ImportMetricTypePublish)
+		go s.jobWorker(ctx, &jobsWG, i.id, jobsToSave, metrics, errs, aggregator.ImportMetricTypePublish, ch.Settings.MinQualityScore)
 	}
 
 	// Error workers
@@ -208,58 +329,69 @@ func (s *Service) Import(ctx context.Context, importID uuid.UUID) error {
 		errorWG.Done()
 	}(errs)
 
-	// Get existing jobs from the database
-	dbJobs, err := s.jr.GetByChannelID(ctx, ch.ID)
-	if err != nil {
-		return fmt.Errorf("failed to get existing jobs: %w", err)
-	}
+	// Save incoming job if different or new, and mark as missing if exists but didn't income
+	for _, j := range rec.noChange {
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeNoChange}
 
-	// Convert aggregator jobs into domain jobs
-	existingJobs := make([]*job, len(dbJobs))
-	for i, job := range dbJobs {
-		existingJobs[i] = newJobFromAggregator(job)
-	}
-
-	// Save incoming job if different or new
-	for _, incoming := range incomingJobs {
-		found := false
-		for _, existing := range existingJobs {
-			if incoming.id == existing.id {
-				found = true
-				if incoming.IsEqual(existing) {
-					metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: incoming.id, MetricType: aggregator.ImportMetricTypeNoChange}
-					goto next
-				}
-			}
+		// Published jobs that no longer meet the threshold are taken down, after a raised threshold or a failed take down
+		// Jobs seen again after going missing are saved below, the next import takes them down
+		if e := rec.previous[j.id]; !e.isPendingMissing() && e.isPublishedBelow(j.quality, ch.Settings.MinQualityScore) {
+			e.markForTakeDown(j.quality)
+			jobsToSave <- e
 		}
-
-		incoming.markAsChanged()
-		jobsToSave <- incoming
-		if found {
-			metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: incoming.id, MetricType: aggregator.ImportMetricTypeUpdated}
-		} else {
-			metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: incoming.id, MetricType: aggregator.ImportMetricTypeNew}
+	}
+	for _, j := range rec.new {
+		j.markAsChanged()
+		j.recordChanges(nil, aggregator.ImportMetricTypeNew)
+		jobsToSave <- j
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeNew}
+	}
+	for _, j := range rec.updated {
+		j.wasPublished = rec.previous[j.id].publishStatus == aggregator.JobPublishStatusPublished
+		j.markAsChanged()
+		j.recordChanges(rec.previous[j.id], aggregator.ImportMetricTypeUpdated)
+		jobsToSave <- j
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeUpdated}
+	}
+	for _, j := range rec.missing {
+		j.markAsMissing()
+		jobsToSave <- j
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeMissing}
+	}
+	for _, j := range rec.pending {
+		j.markAsPendingMissing(time.Now())
+		jobsToSave <- j
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypePendingMissing}
+	}
+	for _, j := range rec.seen {
+		j.markAsSeen()
+		jobsToSave <- j
+	}
+	for _, j := range rec.expired {
+		j.markAsExpired()
+		jobsToSave <- j
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeExpired}
+	}
+	for _, j := range rec.filtered {
+		if e, ok := rec.previous[j.id]; ok {
+			e.markAsFiltered()
+			jobsToSave <- e
 		}
-
-	next:
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeFiltered}
 	}
-
-	// Mark as missing if exists but didn't income
-	for _, existing := range existingJobs {
-		if existing.status == aggregator.JobStatusInactive {
-			continue
+	for _, j := range rec.blocked {
+		if e, ok := rec.previous[j.id]; ok {
+			e.markAsBlocked()
+			jobsToSave <- e
 		}
-		for _, incoming := range incomingJobs {
-			if existing.id == incoming.id {
-				goto skip
-			}
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeBlocked}
+	}
+	for _, j := range rec.dead {
+		if e, ok := rec.previous[j.id]; ok {
+			e.markAsDeadLink()
+			jobsToSave <- e
 		}
-
-		existing.markAsMissing()
-		jobsToSave <- existing
-		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: existing.id, MetricType: aggregator.ImportMetricTypeMissing}
-
-	skip:
+		metrics <- &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: aggregator.ImportMetricTypeDeadLink}
 	}
 
 	// Close channels and wait for workers to finish
@@ -279,7 +411,7 @@ func (s *Service) Import(ctx context.Context, importID uuid.UUID) error {
 	jobsToLatePublish := make(chan *job, s.cfg.Import.Publish.BufferSize)
 	for w := 1; w <= s.cfg.Import.Publish.Workers; w++ {
 		latePublishWG.Add(1)
-		go s.jobWorker(ctx, &latePublishWG, jobsToLatePublish, metrics, errs, aggregator.ImportMetricTypeLatePublish)
+		go s.jobWorker(ctx, &latePublishWG, i.id, jobsToLatePublish, metrics, errs, aggregator.ImportMetricTypeLatePublish, ch.Settings.MinQualityScore)
 	}
 
 	// Get all jobs needing publishing
@@ -289,7 +421,12 @@ func (s *Service) Import(ctx context.Context, importID uuid.UUID) error {
 	}
 
 	for _, j := range jj {
-		jobsToLatePublish <- newJobFromAggregator(j)
+		// Held jobs stay held until they change, or the channel lowers its threshold
+		nj := newJobFromAggregator(j)
+		if nj.isHeld() && !nj.meetsQuality(ch.Settings.MinQualityScore) {
+			continue
+		}
+		jobsToLatePublish <- nj
 	}
 
 	close(jobsToLatePublish)
@@ -307,5 +444,170 @@ func (s *Service) Import(ctx context.Context, importID uuid.UUID) error {
 		return fmt.Errorf("failed to mark import %s as completed: %w", i.id, err)
 	}
 
+	if err := s.ir.ClearStagedJobs(ctx, i.id); err != nil {
+		return fmt.Errorf("failed to clear staged jobs of import %s: %w", i.id, err)
+	}
+
+	// Remove archived pages of this channel that are past retention
+	if _, err := s.a.purge(ctx, ch.ID, time.Now().Add(-s.cfg.Archive.Retention)); err != nil {
+		s.log.Error(fmt.Errorf("failed to purge archive of channel %s: %w", ch.ID, err).Error())
+	}
+
 	return nil
 }
+
+// FailInterrupted marks imports that are pending or running as failed, so they can be resumed. Only call it
+// when no import can be running, like at the start of a process that queues and runs its imports in memory.
+func (s *Service) FailInterrupted(ctx context.Context) (int, error) {
+	imports, err := s.ir.GetImports(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get imports: %w", err)
+	}
+
+	failed := 0
+	for _, ai := range imports {
+		i := newImportFromAggregator(ai)
+		if !i.isUnfinished() {
+			continue
+		}
+
+		i.markAsFailed(ErrInterrupted)
+		if err := s.ir.SaveImport(ctx, i.toAggregate()); err != nil {
+			return failed, fmt.Errorf("failed to mark import %s as failed: %w", i.id, err)
+		}
+		failed++
+	}
+
+	return failed, nil
+}
+
+func (s *Service) newFilter(ctx context.Context, ch *aggregator.Channel) (*filter, error) {
+	return loadFilter(ctx, s.br, s.lr, ch)
+}
+
+func loadFilter(ctx context.Context, br BlocklistRepository, lr LinkRepository, ch *aggregator.Channel) (*filter, error) {
+	entries, err := br.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocklist: %w", err)
+	}
+
+	dead, err := lr.GetDeadByChannelID(ctx, ch.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead links: %w", err)
+	}
+
+	return newFilter(ch.Settings, entries, dead)
+}
+
+func (s *Service) fetch(ctx context.Context, p provider, ch *aggregator.Channel, i *importEntry) ([]*aggregator.Job, error) {
+	// Resume from the checkpoint of a previous attempt, if any
+	cp, err := s.findCheckpoint(ctx, i.id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find checkpoint: %w", err)
+	}
+
+	pJobs, err := s.ir.GetStagedJobs(ctx, i.id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staged jobs: %w", err)
+	}
+
+	if cp.completed {
+		return pJobs, nil
+	}
+
+	// Fetch remaining jobs, archiving and staging every page
+	err = p.GetJobsFrom(cp.nextLink, cp.nextPage(), func(page *aggregator.JobPage) error {
+		if s.cfg.Archive.Enabled {
+			if err := s.a.store(ctx, ch.ID, i.id, page); err != nil {
+				s.log.Error(fmt.Errorf("failed to archive page %d of import %s: %w", page.Number, i.id, err).Error())
+			}
+		}
+
+		cp.advance(page)
+		if err := s.ir.SaveImportCheckpoint(ctx, cp.toAggregator(), page.Jobs); err != nil {
+			return fmt.Errorf("failed to save checkpoint: %w", err)
+		}
+		pJobs = append(pJobs, page.Jobs...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pJobs, nil
+}
+
+func (s *Service) replay(ctx context.Context, p provider, ch *aggregator.Channel, sourceID uuid.UUID) ([]*aggregator.Job, error) {
+	pages, err := s.a.pages(ctx, ch.ID, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive of import %s: %w", sourceID, err)
+	}
+	if len(pages) == 0 {
+		return nil, fmt.Errorf("failed to replay import %s: %w", sourceID, ErrArchiveNotFound)
+	}
+
+	// Only replay the full feed, a page may be missing when fetching stopped halfway or archiving it failed
+	cp, err := s.findCheckpoint(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find checkpoint of import %s: %w", sourceID, err)
+	}
+	if !cp.completed || len(pages) != cp.pages {
+		return nil, fmt.Errorf("failed to replay import %s with %d of %d pages archived: %w", sourceID, len(pages), cp.pages, ErrArchiveIncomplete)
+	}
+
+	pJobs := make([]*aggregator.Job, 0)
+	for _, ap := range pages {
+		page, err := p.ParsePage(ap.raw, ap.number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay import %s: %w", sourceID, err)
+		}
+		pJobs = append(pJobs, page.Jobs...)
+	}
+
+	return pJobs, nil
+}
+
+func (s *Service) dryRun(ctx context.Context, i *importEntry, rec *reconciliation) error {
+	buckets := []struct {
+		jobs       []*job
+		metricType aggregator.ImportMetricType
+	}{
+		{rec.new, aggregator.ImportMetricTypeNew},
+		{rec.updated, aggregator.ImportMetricTypeUpdated},
+		{rec.noChange, aggregator.ImportMetricTypeNoChange},
+		{rec.missing, aggregator.ImportMetricTypeMissing},
+		{rec.pending, aggregator.ImportMetricTypePendingMissing},
+		{rec.expired, aggregator.ImportMetricTypeExpired},
+		{rec.filtered, aggregator.ImportMetricTypeFiltered},
+		{rec.blocked, aggregator.ImportMetricTypeBlocked},
+		{rec.dead, aggregator.ImportMetricTypeDeadLink},
+	}
+	for _, b := range buckets {
+		for _, j := range b.jobs {
+			if err := s.ir.SaveImportMetric(ctx, i.id, &aggregator.ImportMetric{ID: uuid.New(), JobID: j.id, MetricType: b.metricType}); err != nil {
+				return fmt.Errorf("failed to save job result %s for import %s: %w", j.id, i.id, err)
+			}
+		}
+	}
+
+	i.markAsCompleted()
+	if err := s.ir.SaveImport(ctx, i.toAggregate()); err != nil {
+		return fmt.Errorf("failed to mark import %s as completed: %w", i.id, err)
+	}
+
+	return nil
+}
+
+func (s *Service) findCheckpoint(ctx context.Context, importID uuid.UUID) (*checkpoint, error) {
+	cp, err := s.ir.FindImportCheckpoint(ctx, importID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrImportCheckpointNotFound) {
+			return newCheckpoint(importID), nil
+		}
+
+		return nil, err
+	}
+
+	return newCheckpointFromAggregator(cp), nil
+}
@@ -1,12 +1,19 @@
 package importing_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/guregu/null.v3"
+	"io"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -39,7 +46,7 @@ func (suite *ServiceSuite) Test_Success() {
 			testutils.WithJobPublishStatus(aggregator.JobPublishStatusPublished),
 			testutils.WithJobURL("https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"),
 			testutils.WithJobTitle("Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)"),
-			testutils.WithJobDescription("<p>Unser Kunde ist im Bereich Vermögensverwaltung und Fondmanagement ein führender Finanzdienstleister mit Sitz in München. Als zuverlässiger Partner unabhängiger Vermögensberater und ausgewählter institutioneller Kunden verfügt das Unternehmen über ein Verwaltungsvolumen mehrerer Mrd. EUR. Mit derzeit über 40 Mitarbeitern befasst sich das Unternehmen um alle Vermögensbelange seines Kunden. Nachhaltige Qualität und Kundenzufriedenheit stehen im Mittelpunkt des Unternehmens.</p>\n<p>Wir freuen uns auf Ihre Bewerbung als</p>\n<p><strong>Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Überprüfung und Dokumentation von Daueraufträgen sowie (Dauer)-Lastschriften.</li>\n<li>Abwicklung des Zahlungsverkehrs im In- und Ausland.</li>\n<li>Bearbeitung von Nachlasskonten im Zusammenhang mit der Kontolöschung.</li>\n<li>Erfassung interner Kostenrechnungen und Kundenbuchungen.</li>\n<li>Überprüfung und Erfassung von Kontolöschungen. </li>\n<li>Durchführung von Tests für bestehende und neu einzuführende Prozesse.</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung als Bankkaufmann (m/w/d) oder vergleichbare kaufmännische Qualifikation.</li>\n<li>Expertise im nationalen und internationalen Zahlungsverkehr.</li>\n<li>Kenntnisse in der Kundenstammdatenpflege.</li>\n<li>Fähigkeit zur selbstständigen Arbeit sowie analytische Herangehensweise</li>\n<li>Anwendungssicher in MS Office, insbesondere Excel von Vorteil.</li>\n<li>Hohes Maß an sorgfältiger und präziser Arbeitsweise</li>\n</ul>\n<h2>Benefits</h2>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen) </li>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching </li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen </li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen </li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers </li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Wir freuen uns darauf, Dich kennen zu lernen! Sende Deine aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Deinem Gehaltswunsch sowie Deinem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Dich ist und ob wir Dir außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>DEIN ANSPRECHPARTNER:</strong></p>\n<p>Frau Elwira Dabrowska | Tel.: 089/890 648 1039</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\">Jobs in Germany</a> on Arbeitnow</a>"),
+			testutils.WithJobDescription("<p>Unser Kunde ist im Bereich Vermögensverwaltung und Fondmanagement ein führender Finanzdienstleister mit Sitz in München. Als zuverlässiger Partner unabhängiger Vermögensberater und ausgewählter institutioneller Kunden verfügt das Unternehmen über ein Verwaltungsvolumen mehrerer Mrd. EUR. Mit derzeit über 40 Mitarbeitern befasst sich das Unternehmen um alle Vermögensbelange seines Kunden. Nachhaltige Qualität und Kundenzufriedenheit stehen im Mittelpunkt des Unternehmens.</p>\n<p>Wir freuen uns auf Ihre Bewerbung als</p>\n<p><strong>Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Überprüfung und Dokumentation von Daueraufträgen sowie (Dauer)-Lastschriften.</li>\n<li>Abwicklung des Zahlungsverkehrs im In- und Ausland.</li>\n<li>Bearbeitung von Nachlasskonten im Zusammenhang mit der Kontolöschung.</li>\n<li>Erfassung interner Kostenrechnungen und Kundenbuchungen.</li>\n<li>Überprüfung und Erfassung von Kontolöschungen. </li>\n<li>Durchführung von Tests für bestehende und neu einzuführende Prozesse.</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung als Bankkaufmann (m/w/d) oder vergleichbare kaufmännische Qualifikation.</li>\n<li>Expertise im nationalen und internationalen Zahlungsverkehr.</li>\n<li>Kenntnisse in der Kundenstammdatenpflege.</li>\n<li>Fähigkeit zur selbstständigen Arbeit sowie analytische Herangehensweise</li>\n<li>Anwendungssicher in MS Office, insbesondere Excel von Vorteil.</li>\n<li>Hohes Maß an sorgfältiger und präziser Arbeitsweise</li>\n</ul>\n<h2>Benefits</h2>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen) </li>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching </li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen </li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen </li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers </li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Wir freuen uns darauf, Dich kennen zu lernen! Sende Deine aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Deinem Gehaltswunsch sowie Deinem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Dich ist und ob wir Dir außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>DEIN ANSPRECHPARTNER:</strong></p>\n<p>Frau Elwira Dabrowska | Tel.: 089/890 648 1039</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\" rel=\"nofollow noopener\">Jobs in Germany</a> on Arbeitnow</p>"),
 			testutils.WithJobSource(aggregator.IntegrationArbeitnow.String()),
 			testutils.WithJobLocation("Munich"),
 			testutils.WithJobRemote(true),
@@ -123,7 +130,7 @@ func (suite *ServiceSuite) Test_Success() {
 	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(j1ID).PublishStatus)
 	suite.Equal("https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288", dsl.Job(j1ID).URL)
 	suite.Equal("Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)", dsl.Job(j1ID).Title)
-	suite.Equal("<p>Unser Kunde ist im Bereich Vermögensverwaltung und Fondmanagement ein führender Finanzdienstleister mit Sitz in München. Als zuverlässiger Partner unabhängiger Vermögensberater und ausgewählter institutioneller Kunden verfügt das Unternehmen über ein Verwaltungsvolumen mehrerer Mrd. EUR. Mit derzeit über 40 Mitarbeitern befasst sich das Unternehmen um alle Vermögensbelange seines Kunden. Nachhaltige Qualität und Kundenzufriedenheit stehen im Mittelpunkt des Unternehmens.</p>\n<p>Wir freuen uns auf Ihre Bewerbung als</p>\n<p><strong>Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Überprüfung und Dokumentation von Daueraufträgen sowie (Dauer)-Lastschriften.</li>\n<li>Abwicklung des Zahlungsverkehrs im In- und Ausland.</li>\n<li>Bearbeitung von Nachlasskonten im Zusammenhang mit der Kontolöschung.</li>\n<li>Erfassung interner Kostenrechnungen und Kundenbuchungen.</li>\n<li>Überprüfung und Erfassung von Kontolöschungen. </li>\n<li>Durchführung von Tests für bestehende und neu einzuführende Prozesse.</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung als Bankkaufmann (m/w/d) oder vergleichbare kaufmännische Qualifikation.</li>\n<li>Expertise im nationalen und internationalen Zahlungsverkehr.</li>\n<li>Kenntnisse in der Kundenstammdatenpflege.</li>\n<li>Fähigkeit zur selbstständigen Arbeit sowie analytische Herangehensweise</li>\n<li>Anwendungssicher in MS Office, insbesondere Excel von Vorteil.</li>\n<li>Hohes Maß an sorgfältiger und präziser Arbeitsweise</li>\n</ul>\n<h2>Benefits</h2>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen) </li>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching </li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen </li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen </li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers </li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Wir freuen uns darauf, Dich kennen zu lernen! Sende Deine aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Deinem Gehaltswunsch sowie Deinem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Dich ist und ob wir Dir außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>DEIN ANSPRECHPARTNER:</strong></p>\n<p>Frau Elwira Dabrowska | Tel.: 089/890 648 1039</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\">Jobs in Germany</a> on Arbeitnow</a>", dsl.Job(j1ID).Description)
+	suite.Equal("<p>Unser Kunde ist im Bereich Vermögensverwaltung und Fondmanagement ein führender Finanzdienstleister mit Sitz in München. Als zuverlässiger Partner unabhängiger Vermögensberater und ausgewählter institutioneller Kunden verfügt das Unternehmen über ein Verwaltungsvolumen mehrerer Mrd. EUR. Mit derzeit über 40 Mitarbeitern befasst sich das Unternehmen um alle Vermögensbelange seines Kunden. Nachhaltige Qualität und Kundenzufriedenheit stehen im Mittelpunkt des Unternehmens.</p>\n<p>Wir freuen uns auf Ihre Bewerbung als</p>\n<p><strong>Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Überprüfung und Dokumentation von Daueraufträgen sowie (Dauer)-Lastschriften.</li>\n<li>Abwicklung des Zahlungsverkehrs im In- und Ausland.</li>\n<li>Bearbeitung von Nachlasskonten im Zusammenhang mit der Kontolöschung.</li>\n<li>Erfassung interner Kostenrechnungen und Kundenbuchungen.</li>\n<li>Überprüfung und Erfassung von Kontolöschungen. </li>\n<li>Durchführung von Tests für bestehende und neu einzuführende Prozesse.</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung als Bankkaufmann (m/w/d) oder vergleichbare kaufmännische Qualifikation.</li>\n<li>Expertise im nationalen und internationalen Zahlungsverkehr.</li>\n<li>Kenntnisse in der Kundenstammdatenpflege.</li>\n<li>Fähigkeit zur selbstständigen Arbeit sowie analytische Herangehensweise</li>\n<li>Anwendungssicher in MS Office, insbesondere Excel von Vorteil.</li>\n<li>Hohes Maß an sorgfältiger und präziser Arbeitsweise</li>\n</ul>\n<h2>Benefits</h2>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen) </li>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching </li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen </li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen </li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers </li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Wir freuen uns darauf, Dich kennen zu lernen! Sende Deine aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Deinem Gehaltswunsch sowie Deinem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Dich ist und ob wir Dir außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>DEIN ANSPRECHPARTNER:</strong></p>\n<p>Frau Elwira Dabrowska | Tel.: 089/890 648 1039</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\" rel=\"nofollow noopener\">Jobs in Germany</a> on Arbeitnow</p>", dsl.Job(j1ID).Description)
 	suite.Equal(aggregator.IntegrationArbeitnow.String(), dsl.Job(j1ID).Source)
 	suite.Equal("Munich", dsl.Job(j1ID).Location)
 	suite.True(dsl.Job(j1ID).Remote)
@@ -149,7 +156,7 @@ func (suite *ServiceSuite) Test_Success() {
 	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(jNew1ID).PublishStatus)
 	suite.Equal("https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/bankkaufmann-fur-front-office-middle-office-back-office-munich-304839", dsl.Job(jNew1ID).URL)
 	suite.Equal("Bankkaufmann (m/w/d) für Front Office | Middle Office | Back Office", dsl.Job(jNew1ID).Title)
-	suite.Equal("<p>Das Wichtigste für unseren Kunden: Mitarbeiter, auf die er sich verlassen kann. Und dieses Vertrauen zahlt sich aus. Auch für Sie. Neben kurzen Entscheidungswegen profitieren Sie von ausgezeichneten Entwicklungsmöglichkeiten und einem kollegialen Umfeld. Bewerben Sie sich bei uns als <strong>Bankkaufmann | Bankkauffrau (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<p>Sie haben Ihre Ausbildung als <strong>Bankkaufmann | Bankkauffrau (m/w/d)</strong> bereits beendet und wollen erste Berufserfahrungen sammeln? Oder sind Sie bereits Spezialist im Bereich Bank- und Finanzwesen und suchen einen neuen Wirkungskreis in München? Wir werden Ihnen dabei helfen!</p>\n<p>Bei unserem Kunden handelt sich um ein Unternehmen im Bereich Finanzdienstleistungen mit Hauptsitz in München.</p>\n<p><strong>Starten Sie Ihre Karriere und bewerben Sie sich bei uns!</strong></p>\n<p>Wir bieten Ihnen bei einer renommierten Bank Positionen in den unterschiedlichen Bereichen - Front Office | Middle Office | Back Office:</p>\n<p>...z.B. im <strong>Back Office</strong>:</p>\n<ul>\n<li>Sachbearbeitung im Kontenservice oder Vertragsservice oder Depotservice</li>\n<li>Dokumentensachbearbeiter (m/w/d)</li>\n<li>Unterstützung in der qualifizierten Kreditsachbearbeitung<br>\nWertpapierabwicklung im nationalen oder internationalen Umfeld</li>\n<li>Wertpapiersachbearbeiter (m/w/d)</li>\n<li>Mitarbeiter Meldewesen</li>\n<li>Mitarbeiter im Zahlungsverkehr (m/w/d) </li>\n<li>Mitarbeiter Compliance</li>\n<li>…oder im <strong>Front Office</strong>:</li>\n<li>Kundenbetreuer mit Entwicklungspotential zum Privatkundenberater</li>\n<li>Serviceberater</li>\n<li>Assistenz Private Banking (m/w/d)</li>\n<li>Spezieller Fokus auf Finanzierungsberatung</li>\n<li>Einstieg in die Geschäftskundenberatung</li>\n<li>Direkt in die Privatkundenberatung und Firmenkundenberatung</li>\n<li>Vermögenskundenbetreuer | Private Banker (m/w/d)</li>\n</ul>\n<h2>Qualifikation</h2>\n<p>⭐ Abgeschlossene Bankausbildung oder relevantes wirtschaftliches Studium</p>\n<p>⭐ Erste Berufserfahrungen im Finanzbereich sind von Vorteil</p>\n<p>⭐ IT-Affinität und gute MS-Office Kenntnisse</p>\n<p>⭐ Sehr gute Deutschkenntnisse</p>\n<h2>Benefits</h2>\n<p>Als Ex­perten für Personal­aus­wahl stehen wir Ihnen bei der Suche nach einer neuen Heraus­forderung zur Seite. <strong>OPUS ONE</strong> bietetIhnen eine Viel­zahl an Mög­lich­keiten, sich beruflich zu ver­ändern oder weiter­zu­entwickeln. Pro­fitieren von unseren Kennt­nissen in Ihrem Berufs­feld und Ihrer Branche. Eine Viel­zahl an Jobs ist verfügbar!</p>\n<p><strong>So profitieren Sie mit uns:</strong></p>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen für das Vorstellungsgespräch beim Unternehme</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen)</li>\n<li>Persönliches oder telefonisches Interview mit anschließendem Karrierecoaching</li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen</li>\n<li>Beratung zum Arbeitsvertrag des neuen ArbeitgeberSelbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n</ul>\n<p><strong>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos.</strong></p>\n<p>Werden Sie aktiv! Wir freuen uns darauf, Sie kennen zu lernen! Senden Sie Ihre aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Ihrem Gehaltswunsch sowie Ihrem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position als <strong>Bankkaufmann | Bankkauffrau (m/w/d)</strong> die Richtige für Sie ist und ob wir Ihnen außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>IHR ANSPRECHPARTNER:</strong></p>\n<p>Herr Florian Fendt</p>\n<p>Tel.: 089 890 648 127</p>\n<p>Find more <a href=\"https://www.arbeitnow.com/english-speaking-jobs\">English Speaking Jobs in Germany</a> on Arbeitnow</a>", dsl.Job(jNew1ID).Description)
+	suite.Equal("<p>Das Wichtigste für unseren Kunden: Mitarbeiter, auf die er sich verlassen kann. Und dieses Vertrauen zahlt sich aus. Auch für Sie. Neben kurzen Entscheidungswegen profitieren Sie von ausgezeichneten Entwicklungsmöglichkeiten und einem kollegialen Umfeld. Bewerben Sie sich bei uns als <strong>Bankkaufmann | Bankkauffrau (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<p>Sie haben Ihre Ausbildung als <strong>Bankkaufmann | Bankkauffrau (m/w/d)</strong> bereits beendet und wollen erste Berufserfahrungen sammeln? Oder sind Sie bereits Spezialist im Bereich Bank- und Finanzwesen und suchen einen neuen Wirkungskreis in München? Wir werden Ihnen dabei helfen!</p>\n<p>Bei unserem Kunden handelt sich um ein Unternehmen im Bereich Finanzdienstleistungen mit Hauptsitz in München.</p>\n<p><strong>Starten Sie Ihre Karriere und bewerben Sie sich bei uns!</strong></p>\n<p>Wir bieten Ihnen bei einer renommierten Bank Positionen in den unterschiedlichen Bereichen - Front Office | Middle Office | Back Office:</p>\n<p>...z.B. im <strong>Back Office</strong>:</p>\n<ul>\n<li>Sachbearbeitung im Kontenservice oder Vertragsservice oder Depotservice</li>\n<li>Dokumentensachbearbeiter (m/w/d)</li>\n<li>Unterstützung in der qualifizierten Kreditsachbearbeitung<br>\nWertpapierabwicklung im nationalen oder internationalen Umfeld</li>\n<li>Wertpapiersachbearbeiter (m/w/d)</li>\n<li>Mitarbeiter Meldewesen</li>\n<li>Mitarbeiter im Zahlungsverkehr (m/w/d) </li>\n<li>Mitarbeiter Compliance</li>\n<li>…oder im <strong>Front Office</strong>:</li>\n<li>Kundenbetreuer mit Entwicklungspotential zum Privatkundenberater</li>\n<li>Serviceberater</li>\n<li>Assistenz Private Banking (m/w/d)</li>\n<li>Spezieller Fokus auf Finanzierungsberatung</li>\n<li>Einstieg in die Geschäftskundenberatung</li>\n<li>Direkt in die Privatkundenberatung und Firmenkundenberatung</li>\n<li>Vermögenskundenbetreuer | Private Banker (m/w/d)</li>\n</ul>\n<h2>Qualifikation</h2>\n<p>⭐ Abgeschlossene Bankausbildung oder relevantes wirtschaftliches Studium</p>\n<p>⭐ Erste Berufserfahrungen im Finanzbereich sind von Vorteil</p>\n<p>⭐ IT-Affinität und gute MS-Office Kenntnisse</p>\n<p>⭐ Sehr gute Deutschkenntnisse</p>\n<h2>Benefits</h2>\n<p>Als Ex­perten für Personal­aus­wahl stehen wir Ihnen bei der Suche nach einer neuen Heraus­forderung zur Seite. <strong>OPUS ONE</strong> bietetIhnen eine Viel­zahl an Mög­lich­keiten, sich beruflich zu ver­ändern oder weiter­zu­entwickeln. Pro­fitieren von unseren Kennt­nissen in Ihrem Berufs­feld und Ihrer Branche. Eine Viel­zahl an Jobs ist verfügbar!</p>\n<p><strong>So profitieren Sie mit uns:</strong></p>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen für das Vorstellungsgespräch beim Unternehme</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen)</li>\n<li>Persönliches oder telefonisches Interview mit anschließendem Karrierecoaching</li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen</li>\n<li>Beratung zum Arbeitsvertrag des neuen ArbeitgeberSelbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n</ul>\n<p><strong>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos.</strong></p>\n<p>Werden Sie aktiv! Wir freuen uns darauf, Sie kennen zu lernen! Senden Sie Ihre aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Ihrem Gehaltswunsch sowie Ihrem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position als <strong>Bankkaufmann | Bankkauffrau (m/w/d)</strong> die Richtige für Sie ist und ob wir Ihnen außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>IHR ANSPRECHPARTNER:</strong></p>\n<p>Herr Florian Fendt</p>\n<p>Tel.: 089 890 648 127</p>\n<p>Find more <a href=\"https://www.arbeitnow.com/english-speaking-jobs\" rel=\"nofollow noopener\">English Speaking Jobs in Germany</a> on Arbeitnow</p>", dsl.Job(jNew1ID).Description)
 	suite.Equal(aggregator.IntegrationArbeitnow.String(), dsl.Job(jNew1ID).Source)
 	suite.Equal("Munich", dsl.Job(jNew1ID).Location)
 	suite.False(dsl.Job(jNew1ID).Remote)
@@ -162,7 +169,7 @@ func (suite *ServiceSuite) Test_Success() {
 	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(jNew2ID).PublishStatus)
 	suite.Equal("https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/fund-accountant-wertpapierfonds-munich-310570", dsl.Job(jNew2ID).URL)
 	suite.Equal("Fund Accountant Wertpapierfonds (m/w/d)", dsl.Job(jNew2ID).Title)
-	suite.Equal("<p>Unser Kunde gehört zu einem der größten Marktteilnehmer im Bereich der Wertpapierabwicklung und -verwahrung. Hier wird der Fokus daraufgelegt, seinen Kunden einen allumfassenden, individuellen Service anbieten zu können. Flexibilität und Sorgfalt werden hier großgeschrieben. Langjährige Erfahrung und die Kooperation mit vielen Unternehmen im Finanzumfeld zeichnen diesen Bereich des Unternehmens aus. Das Unternehmen bearbeitet die Themengebiete mit seinen qualifizierten Mitarbeitern, einer leistungsfähigen IT-Landschaft und dem gelebten Servicegedanken für alle Geschäftspartner. </p>\n<p>Profitieren Sie von flexiblen Arbeitszeiten mit Homeoffice-Option sowie Aufstiegs- und Weiterbildungsmöglichkeiten. Zudem bietet das Unternehmen familienfreundlichen Arbeitsbedingungen, Sportangebote und abwechslungsreichen Aufgaben. Dies macht unseren Kunden zum Top-Arbeitgeber für Sie. </p>\n<p>Also nutzen Sie die Chance und bewerben Sie sich jetzt!</p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Kontrolle von Differenzen und Absprache mit Fachabteilungen und externen Serviceprovidern</li>\n<li>Verantwortlich für Fondsmigrationen, -verschmelzungen oder -schließungen sowie für die korrekte Verbuchung sämtlicher Geschäftsvorfälle für den Fonds</li>\n<li>Berechnung der Anteilpreise für Publikums und Spezialfonds</li>\n<li>Bearbeitung relevanter Kapitalmaßnahmen </li>\n<li>Tatkräftige Unterstützung bei Projekten</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung im Bank- oder im Investmentfondsbereich oder eine vergleichbare Qualifikation</li>\n<li>Erste Berufserfahrung im Umgang mit Wertpapierfonds und im Finanzproduktbereich</li>\n<li>Sichere Handhabung mit Wertpapierfondsbuchhaltungssystemen</li>\n<li>Analytische und selbständige Arbeitsweise</li>\n<li>Sehr gute Deutsch- und Englischkenntnisse; Französischkenntnisse von Vorteil.</li>\n</ul>\n<h2>Benefits</h2>\n<p>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen)</p>\n<ul>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching</li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen</li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen</li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers</li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Werden Sie aktiv! Wir freuen uns darauf, Sie kennen zu lernen! Senden Sie Ihre aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Ihrem Gehaltswunsch sowie Ihrem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Sie ist und ob wir Ihnen außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>IHR ANSPRECHPARTNER:</strong></p>\n<p>Herr Florian Fendt</p>\n<p>Tel.: 089/ 890 648 127</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\">Jobs in Germany</a> on Arbeitnow</a>", dsl.Job(jNew2ID).Description)
+	suite.Equal("<p>Unser Kunde gehört zu einem der größten Marktteilnehmer im Bereich der Wertpapierabwicklung und -verwahrung. Hier wird der Fokus daraufgelegt, seinen Kunden einen allumfassenden, individuellen Service anbieten zu können. Flexibilität und Sorgfalt werden hier großgeschrieben. Langjährige Erfahrung und die Kooperation mit vielen Unternehmen im Finanzumfeld zeichnen diesen Bereich des Unternehmens aus. Das Unternehmen bearbeitet die Themengebiete mit seinen qualifizierten Mitarbeitern, einer leistungsfähigen IT-Landschaft und dem gelebten Servicegedanken für alle Geschäftspartner. </p>\n<p>Profitieren Sie von flexiblen Arbeitszeiten mit Homeoffice-Option sowie Aufstiegs- und Weiterbildungsmöglichkeiten. Zudem bietet das Unternehmen familienfreundlichen Arbeitsbedingungen, Sportangebote und abwechslungsreichen Aufgaben. Dies macht unseren Kunden zum Top-Arbeitgeber für Sie. </p>\n<p>Also nutzen Sie die Chance und bewerben Sie sich jetzt!</p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Kontrolle von Differenzen und Absprache mit Fachabteilungen und externen Serviceprovidern</li>\n<li>Verantwortlich für Fondsmigrationen, -verschmelzungen oder -schließungen sowie für die korrekte Verbuchung sämtlicher Geschäftsvorfälle für den Fonds</li>\n<li>Berechnung der Anteilpreise für Publikums und Spezialfonds</li>\n<li>Bearbeitung relevanter Kapitalmaßnahmen </li>\n<li>Tatkräftige Unterstützung bei Projekten</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung im Bank- oder im Investmentfondsbereich oder eine vergleichbare Qualifikation</li>\n<li>Erste Berufserfahrung im Umgang mit Wertpapierfonds und im Finanzproduktbereich</li>\n<li>Sichere Handhabung mit Wertpapierfondsbuchhaltungssystemen</li>\n<li>Analytische und selbständige Arbeitsweise</li>\n<li>Sehr gute Deutsch- und Englischkenntnisse; Französischkenntnisse von Vorteil.</li>\n</ul>\n<h2>Benefits</h2>\n<p>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen)</p>\n<ul>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching</li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen</li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen</li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers</li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Werden Sie aktiv! Wir freuen uns darauf, Sie kennen zu lernen! Senden Sie Ihre aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Ihrem Gehaltswunsch sowie Ihrem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Sie ist und ob wir Ihnen außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>IHR ANSPRECHPARTNER:</strong></p>\n<p>Herr Florian Fendt</p>\n<p>Tel.: 089/ 890 648 127</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\" rel=\"nofollow noopener\">Jobs in Germany</a> on Arbeitnow</p>", dsl.Job(jNew2ID).Description)
 	suite.Equal(aggregator.IntegrationArbeitnow.String(), dsl.Job(jNew2ID).Source)
 	suite.Equal("Munich", dsl.Job(jNew2ID).Location)
 	suite.False(dsl.Job(jNew2ID).Remote)
@@ -257,3 +264,2485 @@ func (suite *ServiceSuite) Test_Execute_GatewayFail() {
 	// Assert Logs
 	suite.Empty(dsl.LogLines())
 }
+
+func (suite *ServiceSuite) Test_Execute_SecondPageFail_KeepsCheckpoint() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSecondPageFails)
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to get jobs page 2 on channel "+chID.String())
+
+	// Assert Import
+	suite.Equal(aggregator.ImportStatusFailed, dsl.FirstImport().Status)
+
+	// Assert checkpoint
+	cp := dsl.ImportCheckpoint(iID)
+	suite.NotNil(cp)
+	suite.Equal(1, cp.Pages)
+	suite.Equal(2, cp.Jobs)
+	suite.False(cp.Completed)
+	suite.True(cp.NextLink.Valid)
+	suite.Equal(dsl.AirbeitnowServer.URL+"/api/job-board-api?page=2", cp.NextLink.String)
+	suite.Len(dsl.StagedJobs(iID), 2)
+
+	// Assert no jobs persisted
+	suite.Empty(dsl.Jobs())
+	suite.Empty(dsl.PublishedJobInformations())
+}
+
+func (suite *ServiceSuite) Test_Execute_ResumeFromCheckpoint_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	jStagedID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	dsl.ImportRepository.AddCheckpoint(
+		&aggregator.ImportCheckpoint{
+			ImportID:  iID,
+			NextLink:  null.StringFrom(dsl.AirbeitnowServer.URL + "/api/job-board-api?page=2"),
+			Pages:     1,
+			Jobs:      1,
+			UpdatedAt: time.Now(),
+		},
+		&aggregator.Job{
+			ID:        jStagedID,
+			ChannelID: chID,
+			Status:    aggregator.JobStatusActive,
+			URL:       "https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288",
+			Title:     "Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)",
+			Source:    aggregator.IntegrationArbeitnow.String(),
+			Location:  "Munich",
+			Remote:    true,
+			PostedAt:  time.Unix(1739357344, 0),
+		},
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert only remaining page was requested
+	suite.Len(dsl.RequestLogger.Logs, 1)
+	suite.Contains(dsl.RequestLogger.Logs[0].URL, "page=2")
+
+	// Assert Import
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusCompleted, dbImport.Status)
+	suite.Equal(2, dbImport.NewJobs())
+	suite.Equal(2, dbImport.TotalJobs())
+
+	// Assert jobs from staging and remaining page
+	suite.Len(dsl.Jobs(), 2)
+	suite.NotNil(dsl.Job(jStagedID))
+	suite.NotNil(dsl.Job(uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))))
+
+	// Assert checkpoint
+	cp := dsl.ImportCheckpoint(iID)
+	suite.NotNil(cp)
+	suite.Equal(2, cp.Pages)
+	suite.Equal(2, cp.Jobs)
+	suite.True(cp.Completed)
+	suite.False(cp.NextLink.Valid)
+	suite.Empty(dsl.StagedJobs(iID))
+}
+
+func (suite *ServiceSuite) Test_Execute_ArchivesPages_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	oldKey := "imports/" + chID.String() + "/" + uuid.New().String() + "/00001.json.gz"
+	suite.NoError(dsl.BlobStore.Put(context.Background(), oldKey, []byte("old")))
+	dsl.BlobStore.Age(31 * 24 * time.Hour)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert archive contains compressed raw pages of this import only
+	suite.Len(dsl.BlobStore.Blobs, 2)
+	prefix := "imports/" + chID.String() + "/" + iID.String() + "/"
+	for _, page := range []string{"00001", "00002"} {
+		data, ok := dsl.BlobStore.Data[prefix+page+".json.gz"]
+		suite.True(ok)
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		suite.NoError(err)
+		raw, err := io.ReadAll(zr)
+		suite.NoError(err)
+		suite.Contains(string(raw), `"data":[`)
+	}
+
+	// Assert Logs
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ServiceSuite) Test_Execute_ArchiveFail_StillCompletes() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithBlobStoreError(errors.New("boom")),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(aggregator.ImportStatusCompleted, dsl.FirstImport().Status)
+	suite.Len(dsl.Jobs(), 3)
+
+	// Assert Logs
+	lines := dsl.LogLines()
+	suite.Len(lines, 3)
+	suite.Contains(lines[0], "failed to archive page 1 of import "+iID.String()+": failed to store page 1: boom")
+	suite.Contains(lines[1], "failed to archive page 2 of import "+iID.String())
+	suite.Contains(lines[2], "failed to purge archive of channel "+chID.String())
+}
+
+func (suite *ServiceSuite) Test_Execute_Replay_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	rID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	suite.NoError(dsl.ImportService.Import(context.Background(), iID))
+	dsl.RequestLogger.Logs = nil
+	dsl.PubSubJobService.JobInformations = nil
+
+	// The board changed a job after the archived import, and listed a new one
+	changedID := uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))
+	dsl.Job(changedID).Title = "Newer title"
+	newerID := uuid.New()
+	dsl.JobRepository.Add(&aggregator.Job{
+		ID:            newerID,
+		ChannelID:     chID,
+		Title:         "Newer job",
+		Status:        aggregator.JobStatusActive,
+		PublishStatus: aggregator.JobPublishStatusPublished,
+	})
+	dsl.ImportRepository.AddImport(&aggregator.Import{
+		ID:        rID,
+		ChannelID: chID,
+		Status:    aggregator.ImportStatusPending,
+		StartedAt: time.Now(),
+		ReplayOf:  uuid.NullUUID{UUID: iID, Valid: true},
+	})
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), rID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert board was not called
+	suite.Empty(dsl.RequestLogger.Logs)
+
+	// Assert reconciliation ran on archived payloads
+	replay := dsl.ImportRepository.Imports[rID]
+	suite.Equal(aggregator.ImportStatusCompleted, replay.Status)
+	suite.Equal(0, replay.NewJobs())
+	suite.Equal(1, replay.UpdatedJobs())
+	suite.Equal(2, replay.NoChangeJobs())
+	suite.Equal(1, replay.MissingJobs())
+
+	// Assert current jobs were not overwritten and nothing was published
+	suite.Len(dsl.Jobs(), 4)
+	suite.Equal("Newer title", dsl.Job(changedID).Title)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(newerID).Status)
+	suite.Empty(dsl.PublishedJobInformations())
+	suite.Empty(dsl.PublishedJobMissings())
+	suite.Len(dsl.JobVersions(changedID), 1)
+
+	// Assert replay is not archived itself
+	suite.Len(dsl.BlobStore.Blobs, 2)
+}
+
+func (suite *ServiceSuite) Test_Execute_Replay_ArchiveMissingFail() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	rID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(rID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+			testutils.WithImportReplayOf(iID),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), rID)
+
+	// Assert
+	suite.ErrorIs(err, importing.ErrArchiveNotFound)
+	suite.ErrorContains(err, "failed to replay import "+iID.String())
+	suite.Equal(aggregator.ImportStatusFailed, dsl.FirstImport().Status)
+	suite.Empty(dsl.RequestLogger.Logs)
+}
+
+func (suite *ServiceSuite) Test_Execute_Replay_FetchIncompleteFail() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	rID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	suite.NoError(dsl.ImportService.Import(context.Background(), iID))
+	dsl.RequestLogger.Logs = nil
+
+	// The import failed before it fetched the last page
+	dsl.ImportCheckpoint(iID).Completed = false
+	dsl.ImportRepository.AddImport(&aggregator.Import{
+		ID:        rID,
+		ChannelID: chID,
+		Status:    aggregator.ImportStatusPending,
+		StartedAt: time.Now(),
+		ReplayOf:  uuid.NullUUID{UUID: iID, Valid: true},
+	})
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), rID)
+
+	// Assert
+	suite.ErrorIs(err, importing.ErrArchiveIncomplete)
+	suite.ErrorContains(err, "failed to replay import "+iID.String()+" with 2 of 2 pages archived")
+	suite.Equal(aggregator.ImportStatusFailed, dsl.ImportRepository.Imports[rID].Status)
+	suite.Empty(dsl.RequestLogger.Logs)
+	suite.Empty(dsl.ImportRepository.Imports[rID].Metrics)
+}
+
+func (suite *ServiceSuite) Test_Execute_Replay_PageMissingFail() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	rID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	suite.NoError(dsl.ImportService.Import(context.Background(), iID))
+
+	// Archiving the last page failed
+	for key := range dsl.BlobStore.Blobs {
+		if strings.HasSuffix(key, "/00002.json.gz") {
+			suite.NoError(dsl.BlobStore.Delete(context.Background(), key))
+		}
+	}
+	dsl.ImportRepository.AddImport(&aggregator.Import{
+		ID:        rID,
+		ChannelID: chID,
+		Status:    aggregator.ImportStatusPending,
+		StartedAt: time.Now(),
+		ReplayOf:  uuid.NullUUID{UUID: iID, Valid: true},
+	})
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), rID)
+
+	// Assert
+	suite.ErrorIs(err, importing.ErrArchiveIncomplete)
+	suite.ErrorContains(err, "with 1 of 2 pages archived")
+	suite.Equal(aggregator.ImportStatusFailed, dsl.ImportRepository.Imports[rID].Status)
+}
+
+func (suite *ServiceSuite) Test_Execute_GuardTripped_NeedsReview() {
+	// Prepare
+	chID := uuid.New()
+	j1ID := uuid.New()
+	j2ID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithImportGuard(50, 0, 0),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j1ID),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j2ID),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert Import
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusNeedsReview, dbImport.Status)
+	suite.True(dbImport.EndedAt.Valid)
+	suite.Equal("2 of 2 active jobs (100%) would be marked missing, more than the allowed 50%", dbImport.Error.String)
+	suite.Empty(dsl.ImportMetrics())
+
+	// Assert nothing was touched or published
+	suite.Len(dsl.Jobs(), 2)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(j1ID).Status)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(j2ID).Status)
+	suite.Empty(dsl.PublishedJobInformations())
+	suite.Empty(dsl.PublishedJobMissings())
+
+	// Assert fetched jobs are kept for approval
+	cp := dsl.ImportCheckpoint(iID)
+	suite.NotNil(cp)
+	suite.True(cp.Completed)
+	suite.Len(dsl.StagedJobs(iID), 3)
+
+	// Assert log
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "import "+iID.String()+" for channel "+chID.String()+" needs review")
+}
+
+func (suite *ServiceSuite) Test_Execute_GuardMaxCount_NeedsReview() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithImportGuard(0, 1, 0),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(testutils.WithJobChannelID(chID)),
+		testutils.WithJob(testutils.WithJobChannelID(chID)),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusNeedsReview, dbImport.Status)
+	suite.Equal("2 of 2 active jobs would be marked missing, more than the allowed 1", dbImport.Error.String)
+	suite.Empty(dsl.PublishedJobMissings())
+}
+
+func (suite *ServiceSuite) Test_Execute_GuardBelowMinActiveJobs_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithImportGuard(50, 0, 4),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithJob(testutils.WithJobChannelID(chID)),
+		testutils.WithJob(testutils.WithJobChannelID(chID)),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusCompleted, dbImport.Status)
+	suite.Equal(2, dbImport.MissingJobs())
+	suite.Len(dsl.PublishedJobMissings(), 2)
+}
+
+func (suite *ServiceSuite) Test_Execute_GuardBelowMinActiveJobs_AllMissing_NeedsReview() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithImportGuard(50, 0, 3),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(testutils.WithJobChannelID(chID)),
+		testutils.WithJob(testutils.WithJobChannelID(chID)),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusNeedsReview, dbImport.Status)
+	suite.Equal("all 2 active jobs would be marked missing", dbImport.Error.String)
+	suite.Empty(dsl.PublishedJobMissings())
+}
+
+func (suite *ServiceSuite) Test_Execute_GuardChannelThreshold_NeedsReview() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithImportGuard(90, 0, 10),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MaxMissingPercentage: 50}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithJob(testutils.WithJobChannelID(chID)),
+		testutils.WithJob(testutils.WithJobChannelID(chID)),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert the threshold of the channel applies, even below the minimum of active jobs
+	suite.NoError(err)
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusNeedsReview, dbImport.Status)
+	suite.Equal("2 of 3 active jobs (67%) would be marked missing, more than the allowed 50%", dbImport.Error.String)
+	suite.Empty(dsl.PublishedJobMissings())
+}
+
+func (suite *ServiceSuite) Test_Execute_GuardApproved_Success() {
+	// Prepare
+	chID := uuid.New()
+	j1ID := uuid.New()
+	j2ID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithImportGuard(50, 0, 0),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j1ID),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j2ID),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+			testutils.WithImportApproved(),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert Import
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusCompleted, dbImport.Status)
+	suite.True(dbImport.Approved)
+	suite.Equal(3, dbImport.NewJobs())
+	suite.Equal(2, dbImport.MissingJobs())
+
+	// Assert missing jobs
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(j1ID).Status)
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(j2ID).Status)
+	suite.Len(dsl.PublishedJobMissings(), 2)
+}
+
+func (suite *ServiceSuite) Test_Execute_MissingGraceImports_Pending() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MissingAfterImports: 2}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobURL("https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/another"),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert Import
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusCompleted, dbImport.Status)
+	suite.Equal(0, dbImport.MissingJobs())
+	suite.Equal(1, dbImport.PendingMissingJobs())
+	suite.Equal(1, dsl.ImportMetricsByJobID(jID)[aggregator.ImportMetricTypePendingMissing])
+
+	// Assert job is still online
+	j := dsl.Job(jID)
+	suite.Equal(aggregator.JobStatusActive, j.Status)
+	suite.Equal(aggregator.JobPublishStatusPublished, j.PublishStatus)
+	suite.Equal(1, j.MissedImports)
+	suite.True(j.MissingSince.Valid)
+	suite.Nil(dsl.PublishedJobMissing(jID))
+}
+
+func (suite *ServiceSuite) Test_Execute_MissingGraceImports_Expired() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MissingAfterImports: 2}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobURL("https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/another"),
+			testutils.WithJobMissed(1, time.Now().Add(-time.Hour)),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	dbImport := dsl.FirstImport()
+	suite.Equal(1, dbImport.MissingJobs())
+	suite.Equal(0, dbImport.PendingMissingJobs())
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(jID).Status)
+	suite.NotNil(dsl.PublishedJobMissing(jID))
+}
+
+func (suite *ServiceSuite) Test_Execute_MissingGraceDuration() {
+	// Prepare
+	chID := uuid.New()
+	jRecentID := uuid.New()
+	jOldID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MissingAfter: 24 * time.Hour}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jRecentID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobMissed(3, time.Now().Add(-time.Hour)),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jOldID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobMissed(1, time.Now().Add(-48*time.Hour)),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	dbImport := dsl.FirstImport()
+	suite.Equal(1, dbImport.MissingJobs())
+	suite.Equal(1, dbImport.PendingMissingJobs())
+
+	// Assert job within grace period
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(jRecentID).Status)
+	suite.Equal(4, dsl.Job(jRecentID).MissedImports)
+	suite.Nil(dsl.PublishedJobMissing(jRecentID))
+
+	// Assert job past grace period
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(jOldID).Status)
+	suite.NotNil(dsl.PublishedJobMissing(jOldID))
+}
+
+func (suite *ServiceSuite) Test_Execute_MissingGrace_SeenAgainResets() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MissingAfterImports: 3}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobMissed(2, time.Now().Add(-time.Hour)),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(1, dsl.ImportMetricsByJobID(jID)[aggregator.ImportMetricTypeNoChange])
+
+	// Assert counters were reset without republishing
+	j := dsl.Job(jID)
+	suite.Equal(aggregator.JobStatusActive, j.Status)
+	suite.Equal(0, j.MissedImports)
+	suite.False(j.MissingSince.Valid)
+	suite.Nil(dsl.PublishedJobInformation(jID))
+}
+
+func (suite *ServiceSuite) Test_Execute_MaxAge_Expired() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MaxAge: 24 * time.Hour}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert Import
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusCompleted, dbImport.Status)
+	suite.Equal(1, dbImport.ExpiredJobs())
+	suite.Equal(0, dbImport.NewJobs())
+	suite.Equal(0, dbImport.MissingJobs())
+	suite.Equal(1, dsl.ImportMetricsByJobID(jID)[aggregator.ImportMetricTypeExpired])
+	suite.Equal(1, dsl.ImportMetricsByJobID(jID)[aggregator.ImportMetricTypeMissingPublish])
+
+	// Assert old postings are taken down and not stored
+	suite.Len(dsl.Jobs(), 1)
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(jID).Status)
+	suite.NotNil(dsl.PublishedJobMissing(jID))
+	suite.Empty(dsl.PublishedJobInformations())
+}
+
+func (suite *ServiceSuite) Test_Execute_ValidThrough_Expired() {
+	// Prepare
+	chID := uuid.New()
+	jClosedID := uuid.New()
+	jOpenID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MissingAfterImports: 3}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jClosedID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobValidThrough(time.Now().Add(-time.Hour)),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jOpenID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobValidThrough(time.Now().Add(time.Hour)),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	dbImport := dsl.FirstImport()
+	suite.Equal(1, dbImport.ExpiredJobs())
+	suite.Equal(1, dbImport.PendingMissingJobs())
+
+	// Assert closed job expires without grace period
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(jClosedID).Status)
+	suite.NotNil(dsl.PublishedJobMissing(jClosedID))
+
+	// Assert open job is only pending missing
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(jOpenID).Status)
+	suite.Nil(dsl.PublishedJobMissing(jOpenID))
+}
+
+func (suite *ServiceSuite) Test_Execute_RecordsVersions_Success() {
+	// Prepare
+	chID := uuid.New()
+	jUpdatedID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	jMissingID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jUpdatedID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobTitle("Old title"),
+			testutils.WithJobLocation("Berlin"),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jMissingID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobURL("https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/another"),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert updated job records the changed fields only
+	vv := dsl.JobVersions(jUpdatedID)
+	suite.Len(vv, 1)
+	suite.Equal(1, vv[0].Version)
+	suite.Equal(uuid.NullUUID{UUID: iID, Valid: true}, vv[0].ImportID)
+	suite.Equal(aggregator.ImportMetricTypeUpdated, vv[0].Reason)
+	suite.Equal(aggregator.JobChanges{
+		{Field: "title", Old: "Old title", New: "Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)"},
+		{Field: "location", Old: "Berlin", New: "Munich"},
+	}, vv[0].Changes)
+
+	// Assert missing job records the status change
+	vv = dsl.JobVersions(jMissingID)
+	suite.Len(vv, 1)
+	suite.Equal(aggregator.ImportMetricTypeMissing, vv[0].Reason)
+	suite.Equal(aggregator.JobChanges{
+		{Field: "status", Old: "active", New: "inactive"},
+	}, vv[0].Changes)
+
+	// Assert new jobs start their history without changes
+	jNewID := uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))
+	vv = dsl.JobVersions(jNewID)
+	suite.Len(vv, 1)
+	suite.Equal(aggregator.ImportMetricTypeNew, vv[0].Reason)
+	suite.Empty(vv[0].Changes)
+}
+
+func (suite *ServiceSuite) Test_Execute_NoChange_NoVersion() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(dsl.JobVersions(jID))
+}
+
+func (suite *ServiceSuite) Test_Execute_NormalizesDescription_Success() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.NewSHA1(chID, []byte("bankkaufmann-fur-front-office-middle-office-back-office-munich-304839"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert html is sanitized and renderings are stored alongside
+	j := dsl.Job(jID)
+	suite.True(strings.HasSuffix(j.Description, `<a href="https://www.arbeitnow.com/english-speaking-jobs" rel="nofollow noopener">English Speaking Jobs in Germany</a> on Arbeitnow</p>`))
+	suite.True(strings.HasPrefix(j.DescriptionText, "Das Wichtigste für unseren Kunden: Mitarbeiter, auf die er sich verlassen kann."))
+	suite.Contains(j.DescriptionText, "\n\nAufgaben\n\n")
+	suite.Contains(j.DescriptionText, "- Dokumentensachbearbeiter (m/w/d)\n")
+	suite.NotContains(j.DescriptionText, "<")
+	suite.Contains(j.DescriptionMarkdown, "\n\n## Aufgaben\n\n")
+	suite.True(strings.HasSuffix(j.DescriptionMarkdown, "Find more [English Speaking Jobs in Germany](https://www.arbeitnow.com/english-speaking-jobs) on Arbeitnow"))
+
+	// Assert published job carries the sanitized html
+	suite.Equal(j.Description, dsl.PublishedJobInformation(jID).Description)
+}
+
+func (suite *ServiceSuite) Test_Execute_ExtractsSalary_Success() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert salary of the title wins over the description and is normalized to annual amounts
+	expected := &aggregator.Salary{Currency: "EUR", Min: 60000, Max: 75000, AnnualMin: 60000, AnnualMax: 75000, Period: aggregator.SalaryPeriodYear}
+	suite.Equal(expected, dsl.Job(jID).Salary)
+	suite.Equal(expected, dsl.PublishedJobInformation(jID).Salary)
+
+	// Assert jobs without a salary mention keep it empty
+	suite.Nil(dsl.Job(uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))).Salary)
+}
+
+func (suite *ServiceSuite) Test_Execute_ExtractsSalary_OnlyWhenConfigured() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithEnricher("city", importing.EnricherFunc(func(_ context.Context, j *aggregator.Job) error {
+			j.Enrich("city", strings.ToLower(j.Location))
+			return nil
+		})),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Enrichers: []string{"city"}}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert the configured enrichers replace the built-in ones
+	suite.Nil(dsl.Job(jID).Salary)
+	suite.Empty(dsl.Job(jID).Geo.CountryCode)
+	suite.Empty(dsl.Job(jID).Language)
+	suite.Empty(dsl.Job(jID).Skills)
+	suite.Equal(aggregator.Classification{}, dsl.Job(jID).Classification)
+	suite.Equal(aggregator.Enrichments{"city": "berlin"}, dsl.Job(jID).Enrichments)
+}
+
+func (suite *ServiceSuite) Test_Execute_ResolvesLocation_Success() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert remote jobs are scoped to the country of their city
+	j := dsl.Job(uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288")))
+	suite.Equal("Munich", j.Location)
+	suite.Equal(aggregator.Geo{City: "Munich", Region: "Bavaria", CountryCode: "DE", Latitude: null.FloatFrom(48.13743), Longitude: null.FloatFrom(11.57549), RemoteScope: aggregator.RemoteScopeCountry}, j.Geo)
+
+	// Assert on-site jobs have no remote scope
+	j = dsl.Job(uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022")))
+	suite.Equal(aggregator.Geo{City: "Berlin", Region: "Berlin", CountryCode: "DE", Latitude: null.FloatFrom(52.52437), Longitude: null.FloatFrom(13.41053)}, j.Geo)
+}
+
+func (suite *ServiceSuite) Test_Execute_DetectsLanguage_Success() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowEnglishJobs)
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(dsl.Jobs(), 4)
+
+	// Assert language is stored and published
+	deID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	suite.Equal("de", dsl.Job(deID).Language)
+	suite.Equal("de", dsl.PublishedJobInformation(deID).Language)
+
+	enID := uuid.NewSHA1(chID, []byte("backend-engineer-go-berlin-520311"))
+	suite.Equal("en", dsl.Job(enID).Language)
+	suite.Equal("en", dsl.PublishedJobInformation(enID).Language)
+}
+
+func (suite *ServiceSuite) Test_Execute_ExtractsSkills_Success() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowEnglishJobs)
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert skills of title, description and provider tags are counted and published
+	enID := uuid.NewSHA1(chID, []byte("backend-engineer-go-berlin-520311"))
+	expected := aggregator.Skills{{Name: "Go", Count: 3}, {Name: "Kubernetes", Count: 1}}
+	suite.Equal(expected, dsl.Job(enID).Skills)
+	suite.Equal(expected, dsl.PublishedJobInformation(enID).Skills)
+
+	// Assert jobs without a known technology have no skills
+	suite.Nil(dsl.Job(uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))).Skills)
+}
+
+func (suite *ServiceSuite) Test_Execute_CanonicalizesURLs_Success() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowEnglishJobs)
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert tracking parameters, casing and default ports are stripped before storing and publishing
+	enID := uuid.NewSHA1(chID, []byte("backend-engineer-go-berlin-520311"))
+	expected := "https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/backend-engineer-go-berlin-520311"
+	suite.Equal(expected, dsl.Job(enID).URL)
+	suite.Equal(expected, dsl.PublishedJobInformation(enID).URL)
+}
+
+func (suite *ServiceSuite) Test_Execute_ClassifiesJobs_Success() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowEnglishJobs)
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert provider job types are classified and published
+	enID := uuid.NewSHA1(chID, []byte("backend-engineer-go-berlin-520311"))
+	expected := aggregator.Classification{
+		Seniority:                aggregator.SeniorityMid,
+		SeniorityConfidence:      0.8,
+		EmploymentType:           aggregator.EmploymentTypeFullTime,
+		EmploymentTypeConfidence: 0.95,
+	}
+	suite.Equal(expected, dsl.Job(enID).Classification)
+	suite.Equal(expected, dsl.PublishedJobInformation(enID).Classification)
+}
+
+func (suite *ServiceSuite) Test_Execute_ClassificationOverrides_Success() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowEnglishJobs)
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Seniority: "senior", EmploymentType: "contract"}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert overrides of the channel apply to every job with full confidence
+	suite.Len(dsl.Jobs(), 4)
+	for _, j := range dsl.Jobs() {
+		suite.Equal(aggregator.Classification{
+			Seniority:                aggregator.SenioritySenior,
+			SeniorityConfidence:      1,
+			EmploymentType:           aggregator.EmploymentTypeContract,
+			EmploymentTypeConfidence: 1,
+		}, j.Classification)
+	}
+}
+
+func (suite *ServiceSuite) Test_Execute_LanguageFilter_SkipsJobs() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowEnglishJobs)
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Languages: []string{"en"}}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert only jobs in the configured languages are imported, the others are recorded as filtered
+	enID := uuid.NewSHA1(chID, []byte("backend-engineer-go-berlin-520311"))
+	suite.Len(dsl.Jobs(), 1)
+	suite.Equal("en", dsl.Job(enID).Language)
+	suite.Len(dsl.PublishedJobInformations(), 1)
+	suite.NotNil(dsl.PublishedJobInformation(enID))
+	suite.Equal(1, dsl.FirstImport().NewJobs())
+	suite.Equal(3, dsl.FirstImport().FilteredJobs())
+}
+
+func (suite *ServiceSuite) Test_Execute_LanguageFilter_TakesDownExistingJobs() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowEnglishJobs)
+	deID := uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithImportGuard(50, 0, 0),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Languages: []string{"en"}}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(deID),
+			testutils.WithJobChannelID(chID),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert the job is filtered instead of missing, so it does not count towards the guard
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusCompleted, dbImport.Status)
+	suite.Equal(0, dbImport.MissingJobs())
+	suite.Equal(3, dbImport.FilteredJobs())
+	suite.Equal(1, dsl.ImportMetricsByJobID(deID)[aggregator.ImportMetricTypeFiltered])
+
+	// Assert the job is taken down
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(deID).Status)
+	suite.NotNil(dsl.PublishedJobMissing(deID))
+	vv := dsl.JobVersions(deID)
+	suite.Len(vv, 1)
+	suite.Equal(aggregator.ImportMetricTypeFiltered, vv[0].Reason)
+}
+
+func (suite *ServiceSuite) Test_Execute_ScoresQuality_Success() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert short descriptions are penalized
+	q := dsl.Job(jID).Quality
+	suite.Require().NotNil(q)
+	suite.Equal(60, q.Score)
+	suite.Require().Len(q.Signals, 1)
+	suite.Equal("description_length", q.Signals[0].Name)
+	suite.Equal(q, dsl.PublishedJobInformation(jID).Quality)
+}
+
+func (suite *ServiceSuite) Test_Execute_MinQualityScore_HoldsBackJobs() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MinQualityScore: 70}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert low quality jobs are stored and held, nothing is taken down as they were never published
+	suite.Len(dsl.Jobs(), 4)
+	suite.Equal(aggregator.JobPublishStatusHeld, dsl.Job(jID).PublishStatus)
+	suite.Nil(dsl.PublishedJobInformation(jID))
+	suite.Len(dsl.PublishedJobInformations(), 3)
+	suite.Empty(dsl.PublishedJobHelds())
+	suite.Equal(0, dsl.FirstImport().HeldPublished())
+}
+
+func (suite *ServiceSuite) Test_Execute_MinQualityScore_TakesDownPublishedJob() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MinQualityScore: 70}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusActive),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusPublished),
+			testutils.WithJobTitle("Senior Accountant"),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert the published version of the job is taken down, and the update held back
+	suite.Equal(aggregator.JobPublishStatusHeld, dsl.Job(jID).PublishStatus)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(jID).Status)
+	suite.Equal("Senior Accountant (€60k–75k)", dsl.Job(jID).Title)
+	suite.Nil(dsl.PublishedJobInformation(jID))
+	suite.Len(dsl.PublishedJobHelds(), 1)
+	suite.Equal(jID, dsl.PublishedJobHelds()[0].ID)
+	suite.Empty(dsl.PublishedJobMissings())
+	suite.Equal(1, dsl.FirstImport().HeldPublished())
+}
+
+func (suite *ServiceSuite) Test_Execute_MinQualityScore_TakeDownFail() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MinQualityScore: 70}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusActive),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusPublished),
+			testutils.WithJobTitle("Senior Accountant"),
+		),
+	)
+	dsl.PubSubJobService.FailWith(errors.New("boom"))
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert the job is not held, as its published version is still up
+	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(jID).PublishStatus)
+	suite.Equal(1, dsl.ImportMetricsByJobID(jID)[aggregator.ImportMetricTypeError])
+	suite.Equal(0, dsl.FirstImport().HeldPublished())
+	suite.Empty(dsl.PublishedJobHelds())
+
+	// Assert the next import takes it down
+	i2ID := uuid.New()
+	dsl.ImportRepository.AddImport(&aggregator.Import{ID: i2ID, ChannelID: chID, Status: aggregator.ImportStatusPending, StartedAt: time.Now()})
+	dsl.PubSubJobService.FailWith(nil)
+	suite.NoError(dsl.ImportService.Import(context.Background(), i2ID))
+	suite.Equal(aggregator.JobPublishStatusHeld, dsl.Job(jID).PublishStatus)
+	suite.Len(dsl.PublishedJobHelds(), 1)
+	suite.Equal(1, dsl.ImportRepository.Imports[i2ID].HeldPublished())
+}
+
+func (suite *ServiceSuite) Test_Execute_MinQualityScore_Raised_TakesDownUnchangedJob() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	i1ID := uuid.New()
+	i2ID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MinQualityScore: 50}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(i1ID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(i2ID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	suite.NoError(dsl.ImportService.Import(context.Background(), i1ID))
+	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(jID).PublishStatus)
+	dsl.Channel(chID).Settings.MinQualityScore = 70
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), i2ID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(aggregator.JobPublishStatusHeld, dsl.Job(jID).PublishStatus)
+	suite.Len(dsl.PublishedJobHelds(), 1)
+	suite.Equal(jID, dsl.PublishedJobHelds()[0].ID)
+	suite.Equal(4, dsl.ImportRepository.Imports[i2ID].NoChangeJobs())
+	suite.Equal(1, dsl.ImportRepository.Imports[i2ID].HeldPublished())
+}
+
+func (suite *ServiceSuite) Test_Execute_MinQualityScore_LatePublishSkipsHeldJobs() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	i1ID := uuid.New()
+	i2ID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MinQualityScore: 70}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(i1ID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(i2ID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	suite.NoError(dsl.ImportService.Import(context.Background(), i1ID))
+	heldAt := dsl.Job(jID).UpdatedAt
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), i2ID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert the held job is neither published nor saved again
+	suite.Equal(aggregator.JobPublishStatusHeld, dsl.Job(jID).PublishStatus)
+	suite.Equal(heldAt, dsl.Job(jID).UpdatedAt)
+	suite.Nil(dsl.PublishedJobInformation(jID))
+	suite.Equal(0, dsl.ImportRepository.Imports[i2ID].LatePublished())
+	suite.Equal(0, dsl.ImportRepository.Imports[i2ID].Errors())
+}
+
+func (suite *ServiceSuite) Test_Execute_MinQualityScore_PublishesHeldJobsOnceLowered() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	i1ID := uuid.New()
+	i2ID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MinQualityScore: 70}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(i1ID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(i2ID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	suite.NoError(dsl.ImportService.Import(context.Background(), i1ID))
+	dsl.Channel(chID).Settings.MinQualityScore = 50
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), i2ID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(jID).PublishStatus)
+	suite.NotNil(dsl.PublishedJobInformation(jID))
+	suite.Equal(1, dsl.ImportRepository.Imports[i2ID].LatePublished())
+}
+
+func (suite *ServiceSuite) Test_Execute_MinQualityScore_PublishesOnceLowered() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSalaryJobs)
+	jID := uuid.NewSHA1(chID, []byte("senior-accountant-berlin-410022"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MinQualityScore: 50}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusUnpublished),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+
+	// Assert held back jobs are published once they meet the threshold
+	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(jID).PublishStatus)
+	suite.NotNil(dsl.PublishedJobInformation(jID))
+}
+
+func (suite *ServiceSuite) Test_Execute_Rules_FilterJobs() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	j1ID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	j2ID := uuid.NewSHA1(chID, []byte("bankkaufmann-fur-front-office-middle-office-back-office-munich-304839"))
+	j3ID := uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Rules: []*aggregator.Rule{
+				{Name: "no bank clerks", Action: aggregator.RuleActionExclude, Condition: &aggregator.RuleCondition{Field: "title", Op: "matches", Value: "bankkauf(frau|mann)"}},
+			}}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j1ID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusActive),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusPublished),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(dsl.LogLines())
+
+	// Assert filtered jobs are counted
+	suite.Equal(2, dsl.FirstImport().FilteredJobs())
+	suite.Equal(1, dsl.ImportMetricsByJobID(j1ID)[aggregator.ImportMetricTypeFiltered])
+	suite.Equal(1, dsl.ImportMetricsByJobID(j2ID)[aggregator.ImportMetricTypeFiltered])
+
+	// Assert active jobs that are filtered now are taken down
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(j1ID).Status)
+	suite.NotNil(dsl.PublishedJobMissing(j1ID))
+	suite.Equal(aggregator.ImportMetricTypeFiltered, dsl.JobVersions(j1ID)[0].Reason)
+
+	// Assert new filtered jobs are not stored
+	suite.Nil(dsl.Job(j2ID))
+	suite.Len(dsl.PublishedJobInformations(), 1)
+	suite.NotNil(dsl.PublishedJobInformation(j3ID))
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(j3ID).Status)
+}
+
+func (suite *ServiceSuite) Test_Execute_Blocklist_BlocksJobs() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	j1ID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	j2ID := uuid.NewSHA1(chID, []byte("bankkaufmann-fur-front-office-middle-office-back-office-munich-304839"))
+	j3ID := uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithBlocklistEntry(aggregator.BlocklistKindCompany, "opus one  recruitment gmbh"),
+		testutils.WithJob(
+			testutils.WithJobID(j1ID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusActive),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusPublished),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(dsl.LogLines())
+
+	// Assert blocked jobs are counted
+	suite.Equal(3, dsl.FirstImport().BlockedJobs())
+	suite.Equal(1, dsl.ImportMetricsByJobID(j1ID)[aggregator.ImportMetricTypeBlocked])
+	suite.Equal(1, dsl.ImportMetricsByJobID(j2ID)[aggregator.ImportMetricTypeBlocked])
+	suite.Equal(1, dsl.ImportMetricsByJobID(j3ID)[aggregator.ImportMetricTypeBlocked])
+
+	// Assert active jobs that are blocked now are taken down
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(j1ID).Status)
+	suite.NotNil(dsl.PublishedJobMissing(j1ID))
+	suite.Equal(aggregator.ImportMetricTypeBlocked, dsl.JobVersions(j1ID)[0].Reason)
+
+	// Assert new blocked jobs are neither stored nor published
+	suite.Nil(dsl.Job(j2ID))
+	suite.Nil(dsl.Job(j3ID))
+	suite.Empty(dsl.PublishedJobInformations())
+}
+
+func (suite *ServiceSuite) Test_Execute_DeadLink_KeepsJobsDown() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	j1ID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	j2ID := uuid.NewSHA1(chID, []byte("bankkaufmann-fur-front-office-middle-office-back-office-munich-304839"))
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j1ID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusInactive),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusPublished),
+		),
+		testutils.WithLink(j1ID, aggregator.LinkStatusDead, 3),
+		testutils.WithJob(
+			testutils.WithJobID(j2ID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusInactive),
+			testutils.WithJobPublishStatus(aggregator.JobPublishStatusPublished),
+		),
+		testutils.WithLink(j2ID, aggregator.LinkStatusDead, 3, testutils.WithLinkURL("https://www.arbeitnow.com/jobs/old")),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(dsl.LogLines())
+
+	// Assert jobs taken down for a dead link are not brought back
+	suite.Equal(1, dsl.ImportMetricsByJobID(j1ID)[aggregator.ImportMetricTypeDeadLink])
+	suite.Equal(0, dsl.ImportMetricsByJobID(j1ID)[aggregator.ImportMetricTypeBlocked])
+	suite.Equal(1, dsl.FirstImport().DeadLinkJobs())
+	suite.Equal(0, dsl.FirstImport().BlockedJobs())
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(j1ID).Status)
+	suite.Nil(dsl.PublishedJobInformation(j1ID))
+
+	// Assert jobs with a new link get another chance
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(j2ID).Status)
+	suite.NotNil(dsl.PublishedJobInformation(j2ID))
+}
+
+func (suite *ServiceSuite) Test_Execute_LinkRepositoryFail() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithLinkRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to get dead links")
+	suite.ErrorContains(err, "boom")
+	suite.Empty(dsl.Jobs())
+}
+
+func (suite *ServiceSuite) Test_Execute_BlocklistRepositoryFail() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithBlocklistRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to get blocklist")
+	suite.ErrorContains(err, "boom")
+	suite.Empty(dsl.Jobs())
+}
+
+func (suite *ServiceSuite) Test_Preview_Blocklist_BlocksJobs() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithBlocklistEntry(aggregator.BlocklistKindKeyword, "Fund Accountant"),
+	)
+
+	// Execute
+	p, err := dsl.PreviewService.Preview(context.Background(), chID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(3, p.Total)
+	suite.Equal(1, p.Blocked)
+	suite.Len(p.New, 2)
+}
+
+func (suite *ServiceSuite) Test_Preview_Rules_FilterJobs() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Rules: []*aggregator.Rule{
+				{Name: "remote only", Action: aggregator.RuleActionInclude, Condition: &aggregator.RuleCondition{Field: "remote", Op: "equals", Value: "true"}},
+			}}),
+		),
+	)
+
+	// Execute
+	p, err := dsl.PreviewService.Preview(context.Background(), chID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(3, p.Total)
+	suite.Equal(2, p.Filtered)
+	suite.Len(p.New, 1)
+	suite.Equal(uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288")), p.New[0].ID)
+}
+
+func (suite *ServiceSuite) Test_Execute_Enrichers_Success() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithEnricher("city", importing.EnricherFunc(func(_ context.Context, j *aggregator.Job) error {
+			j.Enrich("city", strings.ToLower(j.Location))
+			return nil
+		})),
+		testutils.WithEnricher("region", importing.EnricherFunc(func(_ context.Context, j *aggregator.Job) error {
+			j.Enrich("region", j.Enrichments["city"]+", bavaria")
+			return nil
+		})),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Enrichers: []string{"city", "region"}}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(dsl.LogLines())
+
+	// Assert enrichers ran in order and the result is stored and published
+	expected := aggregator.Enrichments{"city": "munich", "region": "munich, bavaria"}
+	suite.Equal(expected, dsl.Job(jID).Enrichments)
+	suite.Equal(expected, dsl.PublishedJobInformation(jID).Enrichments)
+}
+
+func (suite *ServiceSuite) Test_Execute_Enrichers_ChangeUpdatesJob() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithEnricher("city", importing.EnricherFunc(func(_ context.Context, j *aggregator.Job) error {
+			j.Enrich("city", "munich")
+			return nil
+		})),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Enrichers: append(slices.Clone(importing.DefaultEnrichers), "city")}),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobEnrichments(map[string]string{"city": "berlin"}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(1, dsl.FirstImport().UpdatedJobs())
+	suite.Equal(aggregator.Enrichments{"city": "munich"}, dsl.Job(jID).Enrichments)
+
+	// Assert version records the changed enrichment
+	vv := dsl.JobVersions(jID)
+	suite.Len(vv, 1)
+	suite.Equal(aggregator.JobChanges{
+		{Field: "enrichments.city", Old: "berlin", New: "munich"},
+	}, vv[0].Changes)
+}
+
+func (suite *ServiceSuite) Test_Execute_DifferentBatch_NoUpdate() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	dupID := uuid.NewSHA1(chID, []byte("bankkaufmann-fur-front-office-middle-office-back-office-munich-304839"))
+	iID1 := uuid.New()
+	iID2 := uuid.New()
+	firstRun := true
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		// Only the first batch contains a duplicate of the job
+		testutils.WithEnricher("copy", importing.EnricherFunc(func(_ context.Context, j *aggregator.Job) error {
+			if firstRun && j.ID == dupID {
+				j.Title = "Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)"
+			}
+			return nil
+		})),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Enrichers: append(slices.Clone(importing.DefaultEnrichers), "copy")}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID1),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID2),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+	suite.NoError(dsl.ImportService.Import(context.Background(), iID1))
+	suite.Equal("duplicates", dsl.Job(jID).Quality.Signals[len(dsl.Job(jID).Quality.Signals)-1].Name)
+	firstRun = false
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID2)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(1, dsl.ImportMetricsByJobID(jID)[aggregator.ImportMetricTypeNoChange])
+	suite.Equal(0, dsl.ImportMetricsByJobID(jID)[aggregator.ImportMetricTypeUpdated])
+	suite.Equal(1, dsl.ImportMetricsByJobID(dupID)[aggregator.ImportMetricTypeUpdated])
+	suite.Len(dsl.JobVersions(jID), 1)
+}
+
+func (suite *ServiceSuite) Test_Execute_Enrichers_FailuresDoNotFailImport() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.NewSHA1(chID, []byte("fund-accountant-wertpapierfonds-munich-310570"))
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithEnricher("broken", importing.EnricherFunc(func(_ context.Context, _ *aggregator.Job) error {
+			return errors.New("boom")
+		})),
+		testutils.WithEnricher("city", importing.EnricherFunc(func(_ context.Context, j *aggregator.Job) error {
+			j.Enrich("city", "munich")
+			return nil
+		})),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Enrichers: []string{"unknown", "broken", "city"}}),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.ImportService.Import(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(aggregator.ImportStatusCompleted, dsl.FirstImport().Status)
+	suite.Len(dsl.Jobs(), 3)
+	suite.Equal(aggregator.Enrichments{"city": "munich"}, dsl.Job(jID).Enrichments)
+
+	// Assert Logs
+	lines := dsl.LogLines()
+	suite.Len(lines, 4)
+	suite.Contains(lines[0], "unknown enricher unknown configured for channel "+chID.String())
+	suite.Contains(lines[1], "with broken: boom")
+}
+
+func (suite *ServiceSuite) Test_Preview_Success() {
+	// Prepare
+	chID := uuid.New()
+	j1ID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	j2ID := uuid.NewSHA1(chID, []byte("bankkaufmann-fur-front-office-middle-office-back-office-munich-304839"))
+	j3ID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+			testutils.WithChannelDeactivated(),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j1ID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusActive),
+			testutils.WithJobTitle("Old title"),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j3ID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusActive),
+		),
+	)
+
+	// Execute
+	p, err := dsl.PreviewService.Preview(context.Background(), chID)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(chID, p.ChannelID)
+	suite.Equal(2, p.Pages)
+	suite.Equal(3, p.Total)
+	suite.Len(p.New, 2)
+	suite.Equal(j2ID, p.New[0].ID)
+	suite.Len(p.Updated, 1)
+	suite.Equal(j1ID, p.Updated[0].ID)
+	suite.Equal(0, p.NoChange)
+	suite.Len(p.Missing, 1)
+	suite.Equal(j3ID, p.Missing[0].ID)
+	suite.Empty(p.Problems)
+
+	// Assert nothing was persisted or published
+	suite.Len(dsl.Jobs(), 2)
+	suite.Equal("Old title", dsl.Job(j1ID).Title)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(j3ID).Status)
+	suite.Empty(dsl.Imports())
+	suite.Empty(dsl.BlobStore.Blobs)
+	suite.Empty(dsl.PublishedJobInformations())
+	suite.Empty(dsl.PublishedJobMissings())
+
+	// Assert Logs
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ServiceSuite) Test_Preview_ValidationProblems() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowInvalidJobs)
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+	)
+
+	// Execute
+	p, err := dsl.PreviewService.Preview(context.Background(), chID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(4, p.Total)
+	suite.Len(p.Problems, 4)
+	invalidID := uuid.NewSHA1(chID, []byte("invalid-job"))
+	fields := make([]string, 0)
+	for _, problem := range p.Problems {
+		suite.Equal(invalidID, problem.JobID)
+		suite.Equal("/jobs/invalid-job", problem.URL)
+		fields = append(fields, problem.Field)
+	}
+	suite.Equal([]string{"title", "description", "url", "posted_at"}, fields)
+}
+
+func (suite *ServiceSuite) Test_Preview_PageLimit_Truncated() {
+	// Prepare
+	chID := uuid.New()
+	j1ID := uuid.NewSHA1(chID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288"))
+	j3ID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithPreviewLimit(1, 5*time.Second),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(j3ID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobStatus(aggregator.JobStatusActive),
+		),
+	)
+
+	// Execute
+	p, err := dsl.PreviewService.Preview(context.Background(), chID)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(1, p.Pages)
+	suite.True(p.Truncated)
+	suite.Equal(2, p.Total)
+	suite.Len(p.New, 2)
+	suite.Equal(j1ID, p.New[0].ID)
+
+	// Assert jobs on pages that were not fetched are not reported missing
+	suite.Empty(p.Missing)
+	suite.Equal(0, p.Pending)
+
+	// Assert only the first page was requested
+	suite.Len(dsl.RequestLogger.Logs, 1)
+}
+
+func (suite *ServiceSuite) Test_Preview_LastPage_NotTruncated() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithPreviewLimit(2, 5*time.Second),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+	)
+
+	// Execute
+	p, err := dsl.PreviewService.Preview(context.Background(), chID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(2, p.Pages)
+	suite.False(p.Truncated)
+	suite.Equal(3, p.Total)
+}
+
+func (suite *ServiceSuite) Test_Preview_TimeoutFail() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowSlow)
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithPreviewLimit(1, 50*time.Millisecond),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+	)
+
+	// Execute
+	start := time.Now()
+	p, err := dsl.PreviewService.Preview(context.Background(), chID)
+
+	// Assert
+	suite.Nil(p)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+	suite.ErrorContains(err, "failed to preview channel "+chID.String())
+	suite.Less(time.Since(start), 2*time.Second)
+}
+
+func (suite *ServiceSuite) Test_Preview_ChannelNotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	// Execute
+	p, err := dsl.PreviewService.Preview(context.Background(), uuid.New())
+
+	// Assert
+	suite.Nil(p)
+	suite.ErrorIs(err, importing.ErrChannelNotFound)
+}
+
+func (suite *ServiceSuite) Test_Preview_GatewayFail() {
+	// Prepare
+	chID := uuid.MustParse(testutils.ArbeitnowMethodNotFound)
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+	)
+
+	// Execute
+	p, err := dsl.PreviewService.Preview(context.Background(), chID)
+
+	// Assert
+	suite.Nil(p)
+	suite.ErrorContains(err, "failed to preview channel "+chID.String())
+	suite.ErrorContains(err, "failed to get jobs page 1")
+}
+
+func (suite *ServiceSuite) Test_FailInterrupted_Success() {
+	// Prepare
+	chID := uuid.New()
+	iPendingID := uuid.New()
+	iProcessingID := uuid.New()
+	iCompletedID := uuid.New()
+	iFailedID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iPendingID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iProcessingID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusProcessing),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iCompletedID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iFailedID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+			testutils.WithImportError("boom!"),
+		),
+	)
+
+	// Execute
+	n, err := dsl.ImportService.FailInterrupted(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	// Assert unfinished imports failed
+	for _, id := range []uuid.UUID{iPendingID, iProcessingID} {
+		i := dsl.ImportRepository.Imports[id]
+		suite.Equal(aggregator.ImportStatusFailed, i.Status)
+		suite.True(i.EndedAt.Valid)
+		suite.Equal(importing.ErrInterrupted.Error(), i.Error.String)
+	}
+
+	// Assert finished imports untouched
+	suite.Equal(aggregator.ImportStatusCompleted, dsl.ImportRepository.Imports[iCompletedID].Status)
+	suite.Equal("boom!", dsl.ImportRepository.Imports[iFailedID].Error.String)
+}
+
+func (suite *ServiceSuite) Test_FailInterrupted_ImportRepositoryFail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithImportRepositoryError(errors.New("boom!")),
+	)
+
+	// Execute
+	n, err := dsl.ImportService.FailInterrupted(context.Background())
+
+	// Assert
+	suite.Error(err)
+	suite.Equal(0, n)
+	suite.ErrorContains(err, "failed to get imports: boom!")
+}
+
+func (suite *ServiceSuite) Test_ExpireJobs_Success() {
+	// Prepare
+	chPausedID := uuid.New()
+	chID := uuid.New()
+	jOldID := uuid.New()
+	jRecentID := uuid.New()
+	jClosedID := uuid.New()
+	jOpenID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chPausedID),
+			testutils.WithChannelDeactivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{MaxAge: 24 * time.Hour}),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jOldID),
+			testutils.WithJobChannelID(chPausedID),
+			testutils.WithJobPostedAt(time.Now().Add(-48*time.Hour)),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jRecentID),
+			testutils.WithJobChannelID(chPausedID),
+			testutils.WithJobPostedAt(time.Now().Add(-time.Hour)),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jClosedID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobValidThrough(time.Now().Add(-time.Hour)),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jOpenID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobValidThrough(time.Now().Add(time.Hour)),
+		),
+	)
+
+	// Execute
+	n, err := dsl.ImportService.ExpireJobs(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	// Assert expired jobs are taken down without an import
+	suite.Empty(dsl.Imports())
+	for _, id := range []uuid.UUID{jOldID, jClosedID} {
+		suite.Equal(aggregator.JobStatusInactive, dsl.Job(id).Status)
+		suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(id).PublishStatus)
+		suite.NotNil(dsl.PublishedJobMissing(id))
+
+		vv := dsl.JobVersions(id)
+		suite.Len(vv, 1)
+		suite.Equal(aggregator.ImportMetricTypeExpired, vv[0].Reason)
+		suite.False(vv[0].ImportID.Valid)
+		suite.Equal(aggregator.JobChanges{{Field: "status", Old: "active", New: "inactive"}}, vv[0].Changes)
+	}
+
+	// Assert other jobs are untouched
+	for _, id := range []uuid.UUID{jRecentID, jOpenID} {
+		suite.Equal(aggregator.JobStatusActive, dsl.Job(id).Status)
+		suite.Nil(dsl.PublishedJobMissing(id))
+		suite.Empty(dsl.JobVersions(id))
+	}
+}
+
+func (suite *ServiceSuite) Test_ExpireJobs_PublishFail_StaysActive() {
+	// Prepare
+	chID := uuid.New()
+	jID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+		),
+		testutils.WithJob(
+			testutils.WithJobID(jID),
+			testutils.WithJobChannelID(chID),
+			testutils.WithJobValidThrough(time.Now().Add(-time.Hour)),
+		),
+	)
+	dsl.PubSubJobService.FailWith(errors.New("boom!"))
+
+	// Execute
+	n, err := dsl.ImportService.ExpireJobs(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(0, n)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(jID).Status)
+	suite.Empty(dsl.JobVersions(jID))
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to publish expired job "+jID.String()+": boom!")
+}
+
+func (suite *ServiceSuite) Test_ExpireJobs_JobRepositoryFail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithJobRepositoryError(errors.New("boom!")),
+	)
+
+	// Execute
+	n, err := dsl.ImportService.ExpireJobs(context.Background())
+
+	// Assert
+	suite.Error(err)
+	suite.Equal(0, n)
+	suite.ErrorContains(err, "failed to get active jobs: boom!")
+}
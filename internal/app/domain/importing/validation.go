@@ -0,0 +1,45 @@
+package importing
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+func validateJobs(jobs []*job) []*aggregator.JobProblem {
+	problems := make([]*aggregator.JobProblem, 0)
+	seen := make(map[uuid.UUID]struct{}, len(jobs))
+
+	for _, j := range jobs {
+		add := func(field, message string) {
+			problems = append(problems, &aggregator.JobProblem{JobID: j.id, URL: j.url, Field: field, Message: message})
+		}
+
+		if _, ok := seen[j.id]; ok {
+			add("id", "duplicate job in feed")
+		}
+		seen[j.id] = struct{}{}
+
+		if strings.TrimSpace(j.title) == "" {
+			add("title", "title is empty")
+		}
+		if strings.TrimSpace(j.description) == "" {
+			add("description", "description is empty")
+		}
+
+		if u, err := url.Parse(j.url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			add("url", "url is not an absolute http(s) url")
+		}
+
+		if j.postedAt.IsZero() || j.postedAt.Unix() == 0 {
+			add("posted_at", "posted at is missing")
+		} else if j.postedAt.After(time.Now().Add(24 * time.Hour)) {
+			add("posted_at", "posted at is in the future")
+		}
+	}
+
+	return problems
+}
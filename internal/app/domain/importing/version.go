@@ -0,0 +1,100 @@
+package importing
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
+)
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatNullTime(t null.Time) string {
+	if !t.Valid {
+		return ""
+	}
+
+	return formatTime(t.Time)
+}
+
+func diff(prev, next *job) []*aggregator.JobFieldChange {
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{"status", prev.status.String(), next.status.String()},
+		{"url", prev.url, next.url},
+		{"title", prev.title, next.title},
+		{"description", prev.description, next.description},
+		{"source", prev.source, next.source},
+		{"company", prev.company, next.company},
+		{"location", prev.location, next.location},
+		{"remote", strconv.FormatBool(prev.remote), strconv.FormatBool(next.remote)},
+		{"posted_at", formatTime(prev.postedAt), formatTime(next.postedAt)},
+		{"valid_through", formatNullTime(prev.validThrough), formatNullTime(next.validThrough)},
+		{"salary", prev.salary.String(), next.salary.String()},
+		{"city", prev.geo.City, next.geo.City},
+		{"region", prev.geo.Region, next.geo.Region},
+		{"country_code", prev.geo.CountryCode, next.geo.CountryCode},
+		{"remote_scope", prev.geo.RemoteScope.String(), next.geo.RemoteScope.String()},
+		{"language", prev.language, next.language},
+		{"quality_score", prev.quality.String(), next.quality.String()},
+		{"skills", prev.skills.String(), next.skills.String()},
+		{"seniority", prev.classification.Seniority.String(), next.classification.Seniority.String()},
+		{"employment_type", prev.classification.EmploymentType.String(), next.classification.EmploymentType.String()},
+	}
+
+	changes := make([]*aggregator.JobFieldChange, 0)
+	for _, f := range fields {
+		if f.old != f.new {
+			changes = append(changes, &aggregator.JobFieldChange{Field: f.name, Old: f.old, New: f.new})
+		}
+	}
+
+	// Every enrichment is tracked as a field of its own
+	keys := make(map[string]struct{}, len(prev.enrichments)+len(next.enrichments))
+	for k := range prev.enrichments {
+		keys[k] = struct{}{}
+	}
+	for k := range next.enrichments {
+		keys[k] = struct{}{}
+	}
+	for _, k := range slices.Sorted(maps.Keys(keys)) {
+		if prev.enrichments[k] != next.enrichments[k] {
+			changes = append(changes, &aggregator.JobFieldChange{Field: "enrichments." + k, Old: prev.enrichments[k], New: next.enrichments[k]})
+		}
+	}
+
+	return changes
+}
+
+func (j *job) recordChanges(prev *job, reason aggregator.ImportMetricType) {
+	// New jobs start their history without changes
+	j.changes = make([]*aggregator.JobFieldChange, 0)
+	if prev != nil {
+		j.changes = diff(prev, j)
+	}
+	j.changeReason = reason
+	j.versioned = true
+}
+
+func (j *job) newVersion(importID uuid.NullUUID) *aggregator.JobVersion {
+	return &aggregator.JobVersion{
+		ID:        uuid.New(),
+		JobID:     j.id,
+		ImportID:  importID,
+		Reason:    j.changeReason,
+		Changes:   j.changes,
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,244 @@
+package linkchecking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/links"
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+)
+
+type Config struct {
+	Workers      int           `env:"WORKERS" envDefault:"10"`
+	HostInterval time.Duration `env:"HOST_INTERVAL" envDefault:"1s"`
+	Interval     time.Duration `env:"INTERVAL" envDefault:"24h"`
+	DeadAfter    int           `env:"DEAD_AFTER" envDefault:"3"`
+	UserAgent    string        `env:"USER_AGENT" envDefault:"jobs-backoffice-linkcheck/1.0"`
+}
+
+type LinkRepository interface {
+	GetActive(ctx context.Context) ([]*aggregator.Link, error)
+	Save(ctx context.Context, l *aggregator.Link) error
+}
+
+type JobRepository interface {
+	GetActive(ctx context.Context) ([]*aggregator.Job, error)
+	Save(ctx context.Context, j *aggregator.Job) error
+}
+
+type PubSubService interface {
+	PublishJobMissing(ctx context.Context, job *aggregator.Job) error
+}
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type Service struct {
+	lr  LinkRepository
+	jr  JobRepository
+	ps  PubSubService
+	c   HTTPClient
+	cfg Config
+	log *slog.Logger
+
+	m        sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func NewService(lr LinkRepository, jr JobRepository, ps PubSubService, c HTTPClient, cfg Config, log *slog.Logger) *Service {
+	return &Service{
+		lr:       lr,
+		jr:       jr,
+		ps:       ps,
+		c:        c,
+		cfg:      cfg,
+		log:      log,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+type check struct {
+	job  *aggregator.Job
+	link *aggregator.Link
+}
+
+func (s *Service) CheckActiveJobs(ctx context.Context) (int, error) {
+	jobs, err := s.jr.GetActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active jobs: %w", err)
+	}
+
+	existing, err := s.lr.GetActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get links of active jobs: %w", err)
+	}
+	linksByJobID := make(map[uuid.UUID]*aggregator.Link, len(existing))
+	for _, l := range existing {
+		linksByJobID[l.JobID] = l
+	}
+
+	// Only links that were never checked, changed or are due again are checked
+	now := time.Now()
+	due := make([]*check, 0)
+	for _, j := range jobs {
+		l, ok := linksByJobID[j.ID]
+		if !ok || l.URL != j.URL {
+			l = &aggregator.Link{JobID: j.ID, URL: j.URL}
+		} else if now.Sub(l.CheckedAt) < s.cfg.Interval {
+			continue
+		}
+		due = append(due, &check{job: j, link: l})
+	}
+
+	var wg sync.WaitGroup
+	checks := make(chan *check)
+	results := make(chan *check)
+	for w := 1; w <= s.cfg.Workers; w++ {
+		wg.Add(1)
+		go s.worker(ctx, &wg, checks, results)
+	}
+	go func() {
+		defer close(checks)
+		for _, c := range due {
+			select {
+			case checks <- c:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Results are handled one at a time, jobs that fail here are checked again next run
+	dead := 0
+	for c := range results {
+		if err := s.lr.Save(ctx, c.link); err != nil {
+			s.log.Error(fmt.Errorf("failed to save link of job %s: %w", c.job.ID, err).Error())
+			continue
+		}
+		if c.link.Status == aggregator.LinkStatusDead && s.unpublish(ctx, c.job) {
+			dead++
+		}
+	}
+
+	return dead, ctx.Err()
+}
+
+func (s *Service) worker(ctx context.Context, wg *sync.WaitGroup, checks <-chan *check, results chan<- *check) {
+	defer wg.Done()
+
+	for c := range checks {
+		if err := s.limiter(links.Host(c.link.URL)).Wait(ctx); err != nil {
+			return
+		}
+
+		status, code, err := s.probe(ctx, c.link.URL)
+		c.link.Status = status
+		c.link.StatusCode = code
+		c.link.Error = ""
+		if err != nil {
+			c.link.Error = err.Error()
+		}
+		c.link.CheckedAt = time.Now()
+
+		// Inconclusive checks neither count against a link nor clear it
+		if status == aggregator.LinkStatusAlive {
+			c.link.Failures = 0
+		}
+		if status == aggregator.LinkStatusBroken {
+			c.link.Failures++
+			if c.link.Failures >= s.cfg.DeadAfter {
+				c.link.Status = aggregator.LinkStatusDead
+			}
+		}
+
+		results <- c
+	}
+}
+
+func (s *Service) limiter(host string) *rate.Limiter {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	l, ok := s.limiters[host]
+	if !ok {
+		l = rate.NewLimiter(rate.Every(s.cfg.HostInterval), 1)
+		s.limiters[host] = l
+	}
+
+	return l
+}
+
+func (s *Service) probe(ctx context.Context, url string) (aggregator.LinkStatus, int, error) {
+	// Not every server answers HEAD requests properly, a failed HEAD is confirmed with a GET
+	status, code, err := s.request(ctx, http.MethodHead, url)
+	if status == aggregator.LinkStatusAlive {
+		return status, code, err
+	}
+
+	return s.request(ctx, http.MethodGet, url)
+}
+
+func (s *Service) request(ctx context.Context, method, url string) (aggregator.LinkStatus, int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return aggregator.LinkStatusBroken, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", s.cfg.UserAgent)
+
+	resp, err := s.c.Do(req)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return aggregator.LinkStatusBroken, 0, err
+		}
+		return aggregator.LinkStatusUnknown, 0, err
+	}
+	defer func(body io.ReadCloser) {
+		_, _ = io.Copy(io.Discard, io.LimitReader(body, 1<<16))
+		_ = body.Close()
+	}(resp.Body)
+
+	// Rate limits, bot protection and server errors say nothing about the posting itself
+	switch {
+	case resp.StatusCode < http.StatusBadRequest:
+		return aggregator.LinkStatusAlive, resp.StatusCode, nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return aggregator.LinkStatusBroken, resp.StatusCode, nil
+	default:
+		return aggregator.LinkStatusUnknown, resp.StatusCode, nil
+	}
+}
+
+func (s *Service) unpublish(ctx context.Context, j *aggregator.Job) bool {
+	j.Status = aggregator.JobStatusInactive
+	j.PublishStatus = aggregator.JobPublishStatusUnpublished
+	if err := s.ps.PublishJobMissing(ctx, j); err != nil {
+		s.log.Error(fmt.Errorf("failed to publish job %s with dead link: %w", j.ID, err).Error())
+		j.Status = aggregator.JobStatusActive
+		j.PublishStatus = aggregator.JobPublishStatusPublished
+		return false
+	}
+
+	j.PublishStatus = aggregator.JobPublishStatusPublished
+	j.UpdatedAt = time.Now()
+	if err := s.jr.Save(ctx, j); err != nil {
+		s.log.Error(fmt.Errorf("failed to save job %s with dead link: %w", j.ID, err).Error())
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,215 @@
+package linkchecking_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/linkchecking"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestService(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+type ServiceSuite struct {
+	suite.Suite
+}
+
+func newServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/alive":
+			w.WriteHeader(http.StatusOK)
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/gone":
+			w.WriteHeader(http.StatusGone)
+		case "/unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func (suite *ServiceSuite) Test_CheckActiveJobs_Success() {
+	// Prepare
+	server := newServer()
+	defer server.Close()
+
+	aliveID := uuid.New()
+	noHeadID := uuid.New()
+	brokenID := uuid.New()
+	deadID := uuid.New()
+	unavailableID := uuid.New()
+	recentID := uuid.New()
+	inactiveID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(testutils.WithJobID(aliveID), testutils.WithJobURL(server.URL+"/alive")),
+		testutils.WithJob(testutils.WithJobID(noHeadID), testutils.WithJobURL(server.URL+"/no-head")),
+		testutils.WithJob(testutils.WithJobID(brokenID), testutils.WithJobURL(server.URL+"/missing")),
+		testutils.WithJob(testutils.WithJobID(deadID), testutils.WithJobURL(server.URL+"/gone")),
+		testutils.WithJob(testutils.WithJobID(unavailableID), testutils.WithJobURL(server.URL+"/unavailable")),
+		testutils.WithJob(testutils.WithJobID(recentID), testutils.WithJobURL(server.URL+"/missing")),
+		testutils.WithJob(testutils.WithJobID(inactiveID), testutils.WithJobURL(server.URL+"/missing"), testutils.WithJobStatus(aggregator.JobStatusInactive)),
+		testutils.WithLink(aliveID, aggregator.LinkStatusBroken, 2, testutils.WithLinkURL(server.URL+"/alive")),
+		testutils.WithLink(deadID, aggregator.LinkStatusBroken, 2, testutils.WithLinkURL(server.URL+"/gone")),
+		testutils.WithLink(unavailableID, aggregator.LinkStatusBroken, 1, testutils.WithLinkURL(server.URL+"/unavailable")),
+		testutils.WithLink(recentID, aggregator.LinkStatusAlive, 0, testutils.WithLinkURL(server.URL+"/missing"), testutils.WithLinkCheckedAt(time.Now().Add(-time.Hour))),
+	)
+
+	// Execute
+	n, err := dsl.LinkService.CheckActiveJobs(context.Background())
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal(1, n)
+
+	// Assert alive links clear earlier failures
+	suite.Equal(aggregator.LinkStatusAlive, dsl.Link(aliveID).Status)
+	suite.Equal(http.StatusOK, dsl.Link(aliveID).StatusCode)
+	suite.Equal(0, dsl.Link(aliveID).Failures)
+	suite.True(dsl.Link(aliveID).CheckedAt.After(time.Now().Add(-time.Minute)))
+
+	// Assert servers that refuse HEAD are checked with GET
+	suite.Equal(aggregator.LinkStatusAlive, dsl.Link(noHeadID).Status)
+
+	// Assert broken links are counted
+	suite.Equal(aggregator.LinkStatusBroken, dsl.Link(brokenID).Status)
+	suite.Equal(http.StatusNotFound, dsl.Link(brokenID).StatusCode)
+	suite.Equal(1, dsl.Link(brokenID).Failures)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(brokenID).Status)
+
+	// Assert persistently broken links are dead and their job is taken down
+	suite.Equal(aggregator.LinkStatusDead, dsl.Link(deadID).Status)
+	suite.Equal(3, dsl.Link(deadID).Failures)
+	suite.Equal(aggregator.JobStatusInactive, dsl.Job(deadID).Status)
+	suite.Equal(aggregator.JobPublishStatusPublished, dsl.Job(deadID).PublishStatus)
+	suite.Len(dsl.PublishedJobMissings(), 1)
+	suite.NotNil(dsl.PublishedJobMissing(deadID))
+
+	// Assert inconclusive checks leave the failures alone
+	suite.Equal(aggregator.LinkStatusUnknown, dsl.Link(unavailableID).Status)
+	suite.Equal(http.StatusServiceUnavailable, dsl.Link(unavailableID).StatusCode)
+	suite.Equal(1, dsl.Link(unavailableID).Failures)
+
+	// Assert recently checked links and inactive jobs are skipped
+	suite.Equal(aggregator.LinkStatusAlive, dsl.Link(recentID).Status)
+	suite.Nil(dsl.Link(inactiveID))
+
+	// Assert log
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *ServiceSuite) Test_CheckActiveJobs_URLChanged_Success() {
+	// Prepare
+	server := newServer()
+	defer server.Close()
+
+	jID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(testutils.WithJobID(jID), testutils.WithJobURL(server.URL+"/gone")),
+		testutils.WithLink(jID, aggregator.LinkStatusBroken, 2, testutils.WithLinkURL(server.URL+"/old"), testutils.WithLinkCheckedAt(time.Now())),
+	)
+
+	// Execute
+	n, err := dsl.LinkService.CheckActiveJobs(context.Background())
+
+	// Assert the new link is checked right away and starts over
+	suite.NoError(err)
+	suite.Equal(0, n)
+	suite.Equal(server.URL+"/gone", dsl.Link(jID).URL)
+	suite.Equal(aggregator.LinkStatusBroken, dsl.Link(jID).Status)
+	suite.Equal(1, dsl.Link(jID).Failures)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(jID).Status)
+}
+
+func (suite *ServiceSuite) Test_CheckActiveJobs_HostRateLimit_Success() {
+	// Prepare
+	server := newServer()
+	defer server.Close()
+
+	dsl := testutils.NewDSL(
+		testutils.WithLinkConfig(linkchecking.Config{Workers: 3, HostInterval: 100 * time.Millisecond, DeadAfter: 3}),
+		testutils.WithJob(testutils.WithJobURL(server.URL+"/alive")),
+		testutils.WithJob(testutils.WithJobURL(server.URL+"/alive")),
+		testutils.WithJob(testutils.WithJobURL(server.URL+"/alive")),
+	)
+	start := time.Now()
+
+	// Execute
+	_, err := dsl.LinkService.CheckActiveJobs(context.Background())
+
+	// Assert requests to the same host are spread out, whatever the number of workers
+	suite.NoError(err)
+	suite.GreaterOrEqual(time.Since(start), 200*time.Millisecond)
+}
+
+func (suite *ServiceSuite) Test_CheckActiveJobs_PublishError_Fail() {
+	// Prepare
+	server := newServer()
+	defer server.Close()
+
+	jID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithJob(testutils.WithJobID(jID), testutils.WithJobURL(server.URL+"/gone")),
+		testutils.WithLink(jID, aggregator.LinkStatusBroken, 2, testutils.WithLinkURL(server.URL+"/gone")),
+	)
+	dsl.PubSubJobService.FailWith(errors.New("boom"))
+
+	// Execute
+	n, err := dsl.LinkService.CheckActiveJobs(context.Background())
+
+	// Assert the link is recorded and the job is taken down on a later run
+	suite.NoError(err)
+	suite.Equal(0, n)
+	suite.Equal(aggregator.LinkStatusDead, dsl.Link(jID).Status)
+	suite.Equal(aggregator.JobStatusActive, dsl.Job(jID).Status)
+
+	// Assert log
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to publish job "+jID.String()+" with dead link: boom")
+}
+
+func (suite *ServiceSuite) Test_CheckActiveJobs_JobRepositoryError_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithJobRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	n, err := dsl.LinkService.CheckActiveJobs(context.Background())
+
+	// Assert
+	suite.Equal(0, n)
+	suite.EqualError(err, "failed to get active jobs: boom")
+}
+
+func (suite *ServiceSuite) Test_CheckActiveJobs_LinkRepositoryError_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithJob(),
+		testutils.WithLinkRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	n, err := dsl.LinkService.CheckActiveJobs(context.Background())
+
+	// Assert
+	suite.Equal(0, n)
+	suite.EqualError(err, "failed to get links of active jobs: boom")
+}
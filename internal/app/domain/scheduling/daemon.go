@@ -0,0 +1,289 @@
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/schedule"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
+)
+
+// LeaderLease is the lease a daemon has to hold to schedule imports
+const LeaderLease = "scheduler"
+
+type Config struct {
+	Tick        time.Duration `env:"TICK" envDefault:"30s"`
+	Grace       time.Duration `env:"GRACE" envDefault:"5m"`
+	MaxCatchUp  int           `env:"MAX_CATCH_UP" envDefault:"5"`
+	DefaultCron string        `env:"DEFAULT_CRON" envDefault:"@daily"`
+	CatchUp     string        `env:"CATCH_UP" envDefault:"once"`
+	LeaseTTL    time.Duration `env:"LEASE_TTL" envDefault:"90s"`
+	Holder      string        `env:"HOLDER"`
+	StuckAfter  time.Duration `env:"STUCK_AFTER" envDefault:"6h"`
+}
+
+type ScheduleRepository interface {
+	All(ctx context.Context) ([]*aggregator.ScheduleState, error)
+	Save(ctx context.Context, s *aggregator.ScheduleState) error
+}
+
+type LeaseRepository interface {
+	Acquire(ctx context.Context, name, holder string, ttl time.Duration) (*aggregator.Lease, error)
+	Release(ctx context.Context, l *aggregator.Lease) error
+}
+
+type Daemon struct {
+	s     *Service
+	chr   ChannelRepository
+	sr    ScheduleRepository
+	lr    LeaseRepository
+	cfg   Config
+	log   *slog.Logger
+	lease *aggregator.Lease
+	// deadline is when the lease expires by the local clock, measured from before it was renewed
+	deadline time.Time
+}
+
+func NewDaemon(s *Service, chr ChannelRepository, sr ScheduleRepository, lr LeaseRepository, cfg Config, log *slog.Logger) *Daemon {
+	return &Daemon{
+		s:   s,
+		chr: chr,
+		sr:  sr,
+		lr:  lr,
+		cfg: cfg,
+		log: log,
+	}
+}
+
+func (d *Daemon) Run(ctx context.Context) error {
+	catchUp, ok := schedule.ParseCatchUp(d.cfg.CatchUp)
+	if !ok {
+		return fmt.Errorf("failed to start daemon with catch up policy %s: %w", d.cfg.CatchUp, schedule.ErrInvalidCatchUp)
+	}
+	if _, err := schedule.Parse(aggregator.ChannelSchedule{Cron: d.cfg.DefaultCron}, catchUp); err != nil {
+		return fmt.Errorf("failed to start daemon with default schedule %s: %w", d.cfg.DefaultCron, err)
+	}
+	// The lease is renewed every tick, it has to outlive at least a couple of them
+	if d.cfg.LeaseTTL < 2*d.cfg.Tick {
+		return fmt.Errorf("failed to start daemon with lease ttl %s: must be at least twice the tick of %s", d.cfg.LeaseTTL, d.cfg.Tick)
+	}
+	if d.cfg.Holder == "" {
+		return errors.New("failed to start daemon: holder is required")
+	}
+	if d.cfg.StuckAfter <= 0 {
+		return fmt.Errorf("failed to start daemon with stuck after %s: must be positive", d.cfg.StuckAfter)
+	}
+
+	ticker := time.NewTicker(d.cfg.Tick)
+	defer ticker.Stop()
+
+	for {
+		leader, err := d.Lead(ctx)
+		if err != nil {
+			d.log.Error(fmt.Errorf("failed to lead scheduler: %w", err).Error())
+		}
+
+		// A failing tick is retried on the next one, the daemon keeps running
+		if leader {
+			if err := d.Tick(ctx, time.Now()); err != nil {
+				d.log.Error(fmt.Errorf("failed to schedule due channels: %w", err).Error())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			d.resign(context.WithoutCancel(ctx))
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Lead acquires or renews the lease, only the daemon holding it schedules imports.
+// When the leader dies, its lease expires and a standby takes over with a newer fencing token.
+func (d *Daemon) Lead(ctx context.Context) (bool, error) {
+	start := time.Now()
+	l, err := d.lr.Acquire(ctx, LeaderLease, d.cfg.Holder, d.cfg.LeaseTTL)
+	if err != nil {
+		// Without a renewed lease another daemon may take over at any time
+		if d.lease != nil {
+			d.log.Warn(fmt.Sprintf("%s lost leadership with fencing token %d", d.cfg.Holder, d.lease.Token))
+		}
+		d.lease = nil
+
+		if errors.Is(err, infrastructure.ErrLeaseHeld) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to acquire lease %s: %w", LeaderLease, err)
+	}
+
+	if d.lease == nil || d.lease.Token != l.Token {
+		d.log.Info(fmt.Sprintf("%s became leader with fencing token %d", d.cfg.Holder, l.Token))
+	}
+	d.lease = l
+	d.deadline = start.Add(d.cfg.LeaseTTL)
+
+	return true, nil
+}
+
+func (d *Daemon) resign(ctx context.Context) {
+	if d.lease == nil {
+		return
+	}
+
+	// Releasing lets a standby take over right away instead of waiting for the lease to expire
+	if err := d.lr.Release(ctx, d.lease); err != nil {
+		d.log.Error(fmt.Errorf("failed to release lease %s: %w", LeaderLease, err).Error())
+	}
+	d.lease = nil
+}
+
+func (d *Daemon) fence() int64 {
+	if d.lease == nil {
+		return 0
+	}
+
+	return d.lease.Token
+}
+
+func (d *Daemon) Tick(ctx context.Context, now time.Time) error {
+	channels, err := d.chr.GetActive(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch active channels: %w", err)
+	}
+
+	states, err := d.sr.All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch schedules: %w", err)
+	}
+	statesByChannelID := make(map[uuid.UUID]*aggregator.ScheduleState, len(states))
+	for _, st := range states {
+		statesByChannelID[st.ChannelID] = st
+	}
+
+	var errs error
+	for _, ch := range channels {
+		// A tick outliving the lease stops, the fenced saves would be rejected after a takeover anyway
+		if d.lease != nil && !time.Now().Before(d.deadline) {
+			d.lease = nil
+			return errors.Join(errs, fmt.Errorf("failed to schedule channel %s: %w", ch.ID, ErrLeaseExpired))
+		}
+
+		if err := d.tickChannel(ctx, ch, statesByChannelID[ch.ID], now); err != nil {
+			// Another daemon took over, it schedules the remaining channels
+			if errors.Is(err, infrastructure.ErrStaleFence) {
+				d.lease = nil
+				return errors.Join(errs, fmt.Errorf("failed to schedule channel %s after losing leadership: %w", ch.ID, err))
+			}
+			errs = errors.Join(errs, fmt.Errorf("failed to schedule channel %s: %w", ch.ID, err))
+		}
+	}
+
+	return errs
+}
+
+func (d *Daemon) tickChannel(ctx context.Context, ch *aggregator.Channel, st *aggregator.ScheduleState, now time.Time) error {
+	defaultCatchUp, _ := schedule.ParseCatchUp(d.cfg.CatchUp)
+	cfg := aggregator.ChannelSchedule{Cron: d.cfg.DefaultCron}
+	if ch.Settings.Schedule != nil {
+		cfg = *ch.Settings.Schedule
+	}
+	sch, err := schedule.Parse(cfg, defaultCatchUp)
+	if err != nil {
+		return fmt.Errorf("failed to parse schedule: %w", err)
+	}
+
+	// New and changed schedules are planned from now on, earlier runs under another schedule do not count
+	if st == nil || st.Spec != sch.Spec() {
+		if st == nil {
+			st = &aggregator.ScheduleState{ChannelID: ch.ID}
+		}
+		st.Spec = sch.Spec()
+		st.NextRunAt = sch.Next(now)
+		st.Fence = d.fence()
+		if err := d.sr.Save(ctx, st); err != nil {
+			return fmt.Errorf("failed to plan schedule: %w", err)
+		}
+		return nil
+	}
+
+	if now.Before(st.NextRunAt) {
+		return nil
+	}
+
+	runs := 1
+	planned := st.NextRunAt
+	next := sch.Next(now)
+	late := now.Sub(planned) > d.cfg.Grace
+	switch {
+	case late && sch.CatchUp() == schedule.CatchUpSkip:
+		runs = 0
+	case late && sch.CatchUp() == schedule.CatchUpAll:
+		// Missed runs fetch the same feed, they are caught up one per tick instead of racing each other
+		planned = sch.Oldest(planned, now, d.cfg.MaxCatchUp)
+		next = sch.Next(planned)
+	}
+
+	// An import still pending or running for the channel fetches the same jobs, the run is skipped.
+	// Missed runs still to catch up wait for it instead.
+	if runs > 0 {
+		running, err := d.s.ir.HasRunningImport(ctx, ch.ID, now.Add(-d.cfg.StuckAfter))
+		if err != nil {
+			return fmt.Errorf("failed to check running imports: %w", err)
+		}
+		if running && !next.After(now) {
+			d.log.Info(fmt.Sprintf("channel %s still has an import pending or running, holding back %d imports", ch.ID, d.missed(sch, planned, now, runs)))
+			return nil
+		}
+		if running {
+			d.log.Info(fmt.Sprintf("channel %s still has an import pending or running, skipping the run", ch.ID))
+			runs = 0
+		}
+	}
+	if late {
+		d.log.Warn(fmt.Sprintf("channel %s missed its run at %s, catching up with %d imports [policy: %s]", ch.ID, st.NextRunAt.Format(time.RFC3339), d.missed(sch, planned, now, runs), sch.CatchUp()))
+	}
+
+	// The run is claimed with a fenced write first, so a deposed leader is stopped before it schedules imports
+	st.NextRunAt = next
+	st.Fence = d.fence()
+	if err := d.sr.Save(ctx, st); err != nil {
+		return fmt.Errorf("failed to save schedule: %w", err)
+	}
+	if runs == 0 {
+		return nil
+	}
+
+	// The planned run is put back when scheduling fails, so the next tick tries again
+	i, err := d.s.ScheduleImport(ctx, ch)
+	if err != nil {
+		st.NextRunAt = planned
+		if err := d.sr.Save(ctx, st); err != nil {
+			d.log.Error(fmt.Errorf("failed to put back planned run of channel %s: %w", ch.ID, err).Error())
+		}
+		return err
+	}
+	st.LastImportID = uuid.NullUUID{UUID: i.ID, Valid: true}
+	st.LastRunAt = null.TimeFrom(now)
+
+	if err := d.sr.Save(ctx, st); err != nil {
+		return fmt.Errorf("failed to save schedule: %w", err)
+	}
+
+	return nil
+}
+
+// missed counts the runs left to catch up, only catching up all missed runs leaves more than one
+func (d *Daemon) missed(sch *schedule.Schedule, planned, now time.Time, runs int) int {
+	if runs == 0 || sch.CatchUp() != schedule.CatchUpAll {
+		return runs
+	}
+
+	return sch.Due(planned, now, d.cfg.MaxCatchUp)
+}
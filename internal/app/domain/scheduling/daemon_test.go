@@ -0,0 +1,681 @@
+package scheduling_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDaemon(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DaemonSuite))
+}
+
+type DaemonSuite struct {
+	suite.Suite
+}
+
+func (suite *DaemonSuite) Test_Tick_PlansNewSchedules_Success() {
+	// Prepare
+	ch1ID := uuid.New()
+	ch2ID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(ch1ID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "0 */6 * * *"}}),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelID(ch2ID),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelDeactivated(),
+		),
+	)
+	now := time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), now)
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert the first run of active channels is planned, without importing right away
+	suite.Len(dsl.ScheduleRepository.States, 2)
+	suite.Equal("0 */6 * * *", dsl.ScheduleState(ch1ID).Spec)
+	suite.Equal(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), dsl.ScheduleState(ch1ID).NextRunAt)
+	suite.False(dsl.ScheduleState(ch1ID).LastRunAt.Valid)
+
+	// Assert channels without a schedule follow the default one
+	suite.Equal("@daily", dsl.ScheduleState(ch2ID).Spec)
+	suite.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), dsl.ScheduleState(ch2ID).NextRunAt)
+
+	suite.Empty(dsl.Imports())
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *DaemonSuite) Test_Tick_RunsDueChannels_Success() {
+	// Prepare
+	dueID := uuid.New()
+	laterID := uuid.New()
+	now := time.Date(2025, 1, 1, 12, 1, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(dueID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "0 */6 * * *"}}),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelID(laterID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Interval: time.Hour}}),
+		),
+		testutils.WithScheduleState(dueID, "0 */6 * * *", time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)),
+		testutils.WithScheduleState(laterID, "@every 1h0m0s", time.Date(2025, 1, 1, 12, 30, 0, 0, time.UTC)),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), now)
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert due channels are imported and planned again
+	suite.Len(dsl.Imports(), 1)
+	i := dsl.FirstImport()
+	suite.Equal(dueID, i.ChannelID)
+	suite.Equal([]uuid.UUID{i.ID}, dsl.PublishedImports())
+	suite.Equal(i.ID, dsl.ScheduleState(dueID).LastImportID.UUID)
+	suite.Equal(now, dsl.ScheduleState(dueID).LastRunAt.Time)
+	suite.Equal(time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC), dsl.ScheduleState(dueID).NextRunAt)
+
+	// Assert channels that are not due are left alone
+	suite.Equal(time.Date(2025, 1, 1, 12, 30, 0, 0, time.UTC), dsl.ScheduleState(laterID).NextRunAt)
+	suite.False(dsl.ScheduleState(laterID).LastRunAt.Valid)
+
+	// Assert log
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "scheduling import for channel "+dueID.String())
+}
+
+func (suite *DaemonSuite) Test_Tick_ChangedSchedule_Success() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly"}}),
+		),
+		testutils.WithScheduleState(chID, "@daily", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 12, 10, 0, 0, time.UTC))
+
+	// Assert runs planned under the old schedule are dropped
+	suite.NoError(err)
+	suite.Empty(dsl.Imports())
+	suite.Equal("@hourly", dsl.ScheduleState(chID).Spec)
+	suite.Equal(time.Date(2025, 1, 1, 13, 0, 0, 0, time.UTC), dsl.ScheduleState(chID).NextRunAt)
+}
+
+func (suite *DaemonSuite) Test_Tick_CatchUp_Success() {
+	cases := map[string]struct {
+		imports int
+		next    time.Time
+	}{
+		"once": {imports: 1, next: time.Date(2025, 1, 2, 6, 0, 0, 0, time.UTC)},
+		"skip": {imports: 0, next: time.Date(2025, 1, 2, 6, 0, 0, 0, time.UTC)},
+		"all":  {imports: 1, next: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for policy, expected := range cases {
+		// Prepare
+		chID := uuid.New()
+		dsl := testutils.NewDSL(
+			testutils.WithChannel(
+				testutils.WithChannelID(chID),
+				testutils.WithChannelActivated(),
+				testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "0 */6 * * *", CatchUp: policy}}),
+			),
+			testutils.WithScheduleState(chID, "0 */6 * * *", time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)),
+		)
+
+		// Execute
+		err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC))
+
+		// Assert the runs missed during the downtime are caught up according to the policy, one per tick
+		suite.NoError(err, policy)
+		suite.Len(dsl.Imports(), expected.imports, policy)
+		suite.Equal(expected.next, dsl.ScheduleState(chID).NextRunAt, policy)
+		suite.Equal(expected.imports > 0, dsl.ScheduleState(chID).LastRunAt.Valid, policy)
+		suite.Contains(dsl.LogLines()[0], "missed its run at 2025-01-01T06:00:00Z", policy)
+	}
+}
+
+func (suite *DaemonSuite) Test_Tick_CatchUp_Limited() {
+	// Prepare
+	chID := uuid.New()
+	now := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithScheduleConfig(scheduling.Config{Grace: time.Minute, MaxCatchUp: 2, DefaultCron: "@daily", CatchUp: "all", StuckAfter: time.Hour}),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Interval: time.Hour}}),
+		),
+		testutils.WithScheduleState(chID, "@every 1h0m0s", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+
+	// Execute
+	for range 3 {
+		err := dsl.SchedulingDaemon.Tick(context.Background(), now)
+		suite.NoError(err)
+		for _, i := range dsl.Imports() {
+			i.Status = aggregator.ImportStatusCompleted
+		}
+	}
+
+	// Assert the default policy applies and catching up is capped
+	suite.Len(dsl.Imports(), 2)
+	suite.Equal(time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC), dsl.ScheduleState(chID).NextRunAt)
+}
+
+func (suite *DaemonSuite) Test_Tick_CatchUp_WaitsForRunningImport() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	planned := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "0 */6 * * *", CatchUp: "all"}}),
+		),
+		testutils.WithScheduleState(chID, "0 */6 * * *", planned),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+		),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC))
+
+	// Assert the missed runs wait for the import still pending
+	suite.NoError(err)
+	suite.Len(dsl.Imports(), 1)
+	suite.Empty(dsl.PublishedImports())
+	suite.False(dsl.ScheduleState(chID).LastRunAt.Valid)
+	suite.Equal(planned, dsl.ScheduleState(chID).NextRunAt)
+
+	// Assert log
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "channel "+chID.String()+" still has an import pending or running, holding back 4 imports")
+}
+
+func (suite *DaemonSuite) Test_Tick_SkipsRunningImports() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly"}}),
+		),
+		testutils.WithScheduleState(chID, "@hourly", time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)),
+		testutils.WithImport(
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusFetching),
+		),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 1, 0, 0, time.UTC))
+
+	// Assert the run is skipped next to the import still running
+	suite.NoError(err)
+	suite.Len(dsl.Imports(), 1)
+	suite.Empty(dsl.PublishedImports())
+	suite.Equal(time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC), dsl.ScheduleState(chID).NextRunAt)
+
+	// Assert log
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "channel "+chID.String()+" still has an import pending or running, skipping the run")
+}
+
+func (suite *DaemonSuite) Test_Tick_RunningImportCheckFailed() {
+	// Prepare
+	chID := uuid.New()
+	planned := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly"}}),
+		),
+		testutils.WithScheduleState(chID, "@hourly", planned),
+		testutils.WithImportRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 1, 0, 0, time.UTC))
+
+	// Assert the planned run is kept for the next tick
+	suite.EqualError(err, "failed to schedule channel "+chID.String()+": failed to check running imports: boom")
+	suite.Equal(planned, dsl.ScheduleState(chID).NextRunAt)
+}
+
+func (suite *DaemonSuite) Test_Tick_WithinGrace_NotLate() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly", CatchUp: "skip"}}),
+		),
+		testutils.WithScheduleState(chID, "@hourly", time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 4, 0, 0, time.UTC))
+
+	// Assert runs a little behind are not considered missed
+	suite.NoError(err)
+	suite.Len(dsl.Imports(), 1)
+}
+
+func (suite *DaemonSuite) Test_Tick_ScheduleImportFailed() {
+	// Prepare
+	chID := uuid.New()
+	planned := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly"}}),
+		),
+		testutils.WithScheduleState(chID, "@hourly", planned),
+		testutils.WithPubSubServiceError(errors.New("boom")),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 1, 0, 0, time.UTC))
+
+	// Assert the planned run is kept for the next tick
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to schedule channel "+chID.String())
+	suite.ErrorContains(err, "boom")
+	suite.Equal(planned, dsl.ScheduleState(chID).NextRunAt)
+}
+
+func (suite *DaemonSuite) Test_Tick_ScheduleImportFailed_NextTickRetries() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly"}}),
+		),
+		testutils.WithScheduleState(chID, "@hourly", time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)),
+		testutils.WithPubSubServiceError(errors.New("boom")),
+	)
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 1, 0, 0, time.UTC))
+	suite.ErrorContains(err, "boom")
+	dsl.PubSubImportService.FailWith(nil)
+
+	// Execute
+	err = dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 2, 0, 0, time.UTC))
+
+	// Assert the import without a command failed and does not hold back the retry
+	suite.NoError(err)
+	suite.Len(dsl.Imports(), 2)
+	suite.Len(dsl.PublishedImports(), 1)
+	failed := 0
+	for _, i := range dsl.Imports() {
+		if i.Status == aggregator.ImportStatusFailed {
+			failed++
+			suite.Contains(i.Error.String, "boom")
+			suite.True(i.EndedAt.Valid)
+		}
+	}
+	suite.Equal(1, failed)
+	suite.Equal(time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC), dsl.ScheduleState(chID).NextRunAt)
+}
+
+func (suite *DaemonSuite) Test_Tick_StuckImport_DoesNotHoldBack() {
+	// Prepare
+	chID := uuid.New()
+	now := time.Now()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Interval: time.Hour}}),
+		),
+		testutils.WithScheduleState(chID, "@every 1h0m0s", now.Add(-time.Minute)),
+		testutils.WithImport(
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusPending),
+			testutils.WithImportUpdatedAt(now.Add(-2*time.Hour)),
+		),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), now)
+
+	// Assert an import without updates for longer than the cutoff no longer counts as running
+	suite.NoError(err)
+	suite.Len(dsl.Imports(), 2)
+	suite.Len(dsl.PublishedImports(), 1)
+}
+
+func (suite *DaemonSuite) Test_Tick_InvalidSchedule_ContinuesWithOthers() {
+	// Prepare
+	badID := uuid.New()
+	goodID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(badID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "whenever"}}),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelID(goodID),
+			testutils.WithChannelActivated(),
+		),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC))
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to schedule channel "+badID.String())
+	suite.Nil(dsl.ScheduleState(badID))
+	suite.NotNil(dsl.ScheduleState(goodID))
+}
+
+func (suite *DaemonSuite) Test_Tick_ScheduleRepositoryFailed() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(testutils.WithChannelActivated()),
+		testutils.WithScheduleRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Now())
+
+	// Assert
+	suite.EqualError(err, "failed to fetch schedules: boom")
+}
+
+func (suite *DaemonSuite) Test_Tick_ChannelRepositoryFailed() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithChannelRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Tick(context.Background(), time.Now())
+
+	// Assert
+	suite.EqualError(err, "failed to fetch active channels: boom")
+}
+
+func (suite *DaemonSuite) Test_Run_StopsWithContext() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+		),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// Execute
+	err := dsl.SchedulingDaemon.Run(ctx)
+
+	// Assert
+	suite.NoError(err)
+	suite.NotNil(dsl.ScheduleState(chID))
+	suite.Equal(int64(1), dsl.ScheduleState(chID).Fence)
+
+	// Assert the lease is released on shutdown so a standby takes over right away
+	suite.True(dsl.Lease(scheduling.LeaderLease).IsExpired(time.Now()))
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "replica-1 became leader with fencing token 1")
+}
+
+func (suite *DaemonSuite) Test_Run_Standby_Success() {
+	// Prepare
+	chID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithLease(scheduling.LeaderLease, "replica-2", 3, time.Now().Add(time.Minute)),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// Execute
+	err := dsl.SchedulingDaemon.Run(ctx)
+
+	// Assert a standby leaves scheduling to the leader
+	suite.NoError(err)
+	suite.Nil(dsl.ScheduleState(chID))
+	suite.Equal("replica-2", dsl.Lease(scheduling.LeaderLease).Holder)
+	suite.False(dsl.Lease(scheduling.LeaderLease).IsExpired(time.Now()))
+	suite.Empty(dsl.LogLines())
+}
+
+func (suite *DaemonSuite) Test_Run_InvalidLeaseTTL_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithScheduleConfig(scheduling.Config{Tick: time.Minute, DefaultCron: "@daily", CatchUp: "once", LeaseTTL: time.Minute, Holder: "replica-1"}),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Run(context.Background())
+
+	// Assert
+	suite.EqualError(err, "failed to start daemon with lease ttl 1m0s: must be at least twice the tick of 1m0s")
+}
+
+func (suite *DaemonSuite) Test_Lead_Failover_Success() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithLease(scheduling.LeaderLease, "replica-2", 3, time.Now().Add(-time.Second)),
+	)
+
+	// Execute
+	leader, err := dsl.SchedulingDaemon.Lead(context.Background())
+
+	// Assert the expired lease of a dead leader is taken over with a newer fencing token
+	suite.NoError(err)
+	suite.True(leader)
+	suite.Equal("replica-1", dsl.Lease(scheduling.LeaderLease).Holder)
+	suite.Equal(int64(4), dsl.Lease(scheduling.LeaderLease).Token)
+	suite.Contains(dsl.LogLines()[0], "replica-1 became leader with fencing token 4")
+}
+
+func (suite *DaemonSuite) Test_Lead_Renew_Success() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	_, err := dsl.SchedulingDaemon.Lead(context.Background())
+	suite.NoError(err)
+
+	// Execute
+	leader, err := dsl.SchedulingDaemon.Lead(context.Background())
+
+	// Assert renewing keeps the fencing token
+	suite.NoError(err)
+	suite.True(leader)
+	suite.Equal(int64(1), dsl.Lease(scheduling.LeaderLease).Token)
+	suite.Len(dsl.LogLines(), 1)
+}
+
+func (suite *DaemonSuite) Test_Lead_Lost_Success() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	_, err := dsl.SchedulingDaemon.Lead(context.Background())
+	suite.NoError(err)
+	dsl.LeaseRepository.Add(&aggregator.Lease{Name: scheduling.LeaderLease, Holder: "replica-2", Token: 2, ExpiresAt: time.Now().Add(time.Minute)})
+
+	// Execute
+	leader, err := dsl.SchedulingDaemon.Lead(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.False(leader)
+	logs := dsl.LogLines()
+	suite.Len(logs, 2)
+	suite.Contains(logs[1], "replica-1 lost leadership with fencing token 1")
+}
+
+func (suite *DaemonSuite) Test_Lead_LeaseRepositoryFailed() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithLeaseRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	leader, err := dsl.SchedulingDaemon.Lead(context.Background())
+
+	// Assert
+	suite.False(leader)
+	suite.EqualError(err, "failed to acquire lease scheduler: boom")
+}
+
+func (suite *DaemonSuite) Test_Tick_StaleFence_Fail() {
+	// Prepare
+	ch1ID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	ch2ID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(ch1ID),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelID(ch2ID),
+			testutils.WithChannelName("channel 2"),
+			testutils.WithChannelActivated(),
+		),
+	)
+	_, err := dsl.SchedulingDaemon.Lead(context.Background())
+	suite.NoError(err)
+	dsl.ScheduleRepository.Add(&aggregator.ScheduleState{ChannelID: ch1ID, Spec: "@hourly", NextRunAt: time.Now().Add(time.Hour), Fence: 2})
+
+	// Execute
+	err = dsl.SchedulingDaemon.Tick(context.Background(), time.Now())
+
+	// Assert writes of a deposed leader are rejected and it stops scheduling
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+	suite.ErrorContains(err, "failed to schedule channel "+ch1ID.String()+" after losing leadership")
+	suite.Equal("@hourly", dsl.ScheduleState(ch1ID).Spec)
+	suite.Nil(dsl.ScheduleState(ch2ID))
+}
+
+func (suite *DaemonSuite) Test_Tick_LeaseLostMidTick_Fail() {
+	// Prepare
+	ch1ID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	ch2ID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	planned := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(ch1ID),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly"}}),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelID(ch2ID),
+			testutils.WithChannelName("channel 2"),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly"}}),
+		),
+	)
+	_, err := dsl.SchedulingDaemon.Lead(context.Background())
+	suite.NoError(err)
+	token := dsl.Lease(scheduling.LeaderLease).Token
+	dsl.ScheduleRepository.Add(&aggregator.ScheduleState{ChannelID: ch1ID, Spec: "@hourly", NextRunAt: planned, Fence: token})
+	// Another daemon took over while the first channel was scheduled and already wrote the second one
+	dsl.ScheduleRepository.Add(&aggregator.ScheduleState{ChannelID: ch2ID, Spec: "@hourly", NextRunAt: planned, Fence: token + 1})
+
+	// Execute
+	err = dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 1, 0, 0, time.UTC))
+
+	// Assert result
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+	suite.ErrorContains(err, "failed to schedule channel "+ch2ID.String()+" after losing leadership")
+
+	// Assert the deposed leader schedules no import for the channel it lost
+	suite.Len(dsl.Imports(), 1)
+	suite.Equal(ch1ID, dsl.FirstImport().ChannelID)
+	suite.Equal(planned, dsl.ScheduleState(ch2ID).NextRunAt)
+	suite.Equal(token+1, dsl.ScheduleState(ch2ID).Fence)
+}
+
+func (suite *DaemonSuite) Test_Tick_StaleFence_UntouchedSchedule_Fail() {
+	// Prepare
+	chID := uuid.New()
+	planned := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+			testutils.WithChannelSettings(aggregator.ChannelSettings{Schedule: &aggregator.ChannelSchedule{Cron: "@hourly"}}),
+		),
+	)
+	_, err := dsl.SchedulingDaemon.Lead(context.Background())
+	suite.NoError(err)
+	token := dsl.Lease(scheduling.LeaderLease).Token
+	dsl.ScheduleRepository.Add(&aggregator.ScheduleState{ChannelID: chID, Spec: "@hourly", NextRunAt: planned, Fence: token})
+	// Another daemon took over, it did not write the schedule yet
+	dsl.LeaseRepository.Add(&aggregator.Lease{Name: scheduling.LeaderLease, Holder: "replica-2", Token: token + 1, ExpiresAt: time.Now().Add(time.Minute)})
+
+	// Execute
+	err = dsl.SchedulingDaemon.Tick(context.Background(), time.Date(2025, 1, 1, 6, 1, 0, 0, time.UTC))
+
+	// Assert the deposed leader cannot claim the run and schedules no import
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+	suite.Empty(dsl.Imports())
+	suite.Equal(planned, dsl.ScheduleState(chID).NextRunAt)
+	suite.Equal(token, dsl.ScheduleState(chID).Fence)
+}
+
+func (suite *DaemonSuite) Test_Run_InvalidConfig_Fail() {
+	// Prepare
+	dsl := testutils.NewDSL(
+		testutils.WithScheduleConfig(scheduling.Config{Tick: time.Second, DefaultCron: "whenever", CatchUp: "once"}),
+	)
+
+	// Execute
+	err := dsl.SchedulingDaemon.Run(context.Background())
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to start daemon with default schedule whenever")
+}
@@ -0,0 +1,18 @@
+package scheduling
+
+import (
+	"errors"
+
+	"github.com/aviseu/jobs-backoffice/internal/errs"
+)
+
+var (
+	ErrImportNotFound      = errs.NewValidationError(errors.New("import not found"))
+	ErrImportNotResumable  = errs.NewValidationError(errors.New("only failed imports can be resumed"))
+	ErrImportNotReplayable = errs.NewValidationError(errors.New("only finished imports can be replayed"))
+	ErrArchiveIncomplete   = errs.NewValidationError(errors.New("only imports that fetched every page can be replayed"))
+	ErrImportNotInReview   = errs.NewValidationError(errors.New("only imports that need review can be approved or discarded"))
+	ErrImportSuperseded    = errs.NewValidationError(errors.New("a newer import of the channel completed, the import can only be discarded"))
+)
+
+var ErrLeaseExpired = errors.New("lease expired before the tick finished")
@@ -2,10 +2,13 @@ package scheduling
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/google/uuid"
 	"gopkg.in/guregu/null.v3"
@@ -21,37 +24,92 @@ type PubSubService interface {
 
 type ImportRepository interface {
 	SaveImport(ctx context.Context, i *aggregator.Import) error
+	ClearStagedJobs(ctx context.Context, importID uuid.UUID) error
+	FindImport(ctx context.Context, id uuid.UUID) (*aggregator.Import, error)
+	HasRunningImport(ctx context.Context, channelID uuid.UUID, since time.Time) (bool, error)
+	HasCompletedImportSince(ctx context.Context, channelID uuid.UUID, since time.Time) (bool, error)
+}
+
+type ScheduleRunRepository interface {
+	Save(ctx context.Context, run *aggregator.ScheduleRun) error
+}
+
+type ServiceConfig struct {
+	Workers int `env:"WORKERS" envDefault:"4"`
 }
 
 type Service struct {
 	chr ChannelRepository
 	ir  ImportRepository
+	rr  ScheduleRunRepository
 	ps  PubSubService
+	cfg ServiceConfig
 	log *slog.Logger
 }
 
-func NewService(ir ImportRepository, chr ChannelRepository, ps PubSubService, log *slog.Logger) *Service {
+func NewService(ir ImportRepository, chr ChannelRepository, rr ScheduleRunRepository, ps PubSubService, cfg ServiceConfig, log *slog.Logger) *Service {
 	return &Service{
 		ir:  ir,
 		chr: chr,
+		rr:  rr,
 		ps:  ps,
+		cfg: cfg,
 		log: log,
 	}
 }
 
-func (s *Service) ScheduleActiveChannels(ctx context.Context) error {
+// ScheduleActiveChannels schedules every active channel, a failing channel does not hold back the others.
+// The outcome per channel is recorded in a schedule run, which is returned together with the failures.
+func (s *Service) ScheduleActiveChannels(ctx context.Context) (*aggregator.ScheduleRun, error) {
 	channels, err := s.chr.GetActive(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to fetch active channels: %w", err)
+		return nil, fmt.Errorf("failed to fetch active channels: %w", err)
 	}
 
-	for _, ch := range channels {
-		if _, err := s.ScheduleImport(ctx, ch); err != nil {
-			return fmt.Errorf("failed to schedule import for channel %s: %w", ch.ID, err)
+	run := &aggregator.ScheduleRun{
+		ID:        uuid.New(),
+		StartedAt: time.Now(),
+		Results:   make(aggregator.ScheduleRunResults, len(channels)),
+	}
+
+	// Each channel writes to its own slot, so the results keep the order of the channels
+	errs := make([]error, len(channels))
+	sem := make(chan struct{}, max(s.cfg.Workers, 1))
+	var wg sync.WaitGroup
+	for idx, ch := range channels {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			res := &aggregator.ScheduleRunResult{ChannelID: ch.ID, ChannelName: ch.Name}
+			i, err := s.ScheduleImport(ctx, ch)
+			if err != nil {
+				res.Error = err.Error()
+				errs[idx] = fmt.Errorf("failed to schedule import for channel %s: %w", ch.ID, err)
+			} else {
+				res.ImportID = uuid.NullUUID{UUID: i.ID, Valid: true}
+			}
+			run.Results[idx] = res
+		}()
+	}
+	wg.Wait()
+
+	for _, res := range run.Results {
+		if res.Error != "" {
+			run.Failed++
+		} else {
+			run.Scheduled++
 		}
 	}
+	run.EndedAt = time.Now()
+
+	if err := s.rr.Save(ctx, run); err != nil {
+		errs = append(errs, fmt.Errorf("failed to save schedule run %s: %w", run.ID, err))
+	}
 
-	return nil
+	return run, errors.Join(errs...)
 }
 
 func (s *Service) ScheduleImport(ctx context.Context, ch *aggregator.Channel) (*aggregator.Import, error) {
@@ -68,8 +126,169 @@ func (s *Service) ScheduleImport(ctx context.Context, ch *aggregator.Channel) (*
 		return nil, fmt.Errorf("failed to save import for channel %s while starting: %w", ch.ID, err)
 	}
 
+	// An import left pending without a command is never run, it fails so it can be resumed
+	if err := s.ps.PublishImportCommand(ctx, i.ID); err != nil {
+		err := fmt.Errorf("failed to publish import %s for channel %s: %w", i.ID, ch.ID, err)
+		i.Status = aggregator.ImportStatusFailed
+		i.EndedAt = null.TimeFrom(time.Now())
+		i.Error = null.StringFrom(err.Error())
+		if err2 := s.ir.SaveImport(ctx, i); err2 != nil {
+			return nil, fmt.Errorf("failed to mark import %s as failed: %w: %w", i.ID, err2, err)
+		}
+
+		return nil, err
+	}
+
+	return i, nil
+}
+
+func (s *Service) ResumeImport(ctx context.Context, importID uuid.UUID) (*aggregator.Import, error) {
+	i, err := s.ir.FindImport(ctx, importID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrImportNotFound) {
+			return nil, ErrImportNotFound
+		}
+
+		return nil, fmt.Errorf("failed to find import %s: %w", importID, err)
+	}
+
+	if i.Status != aggregator.ImportStatusFailed {
+		return nil, fmt.Errorf("failed to resume import %s with status %s: %w", i.ID, i.Status, ErrImportNotResumable)
+	}
+
+	s.log.Info(fmt.Sprintf("resuming import %s for channel %s", i.ID, i.ChannelID))
+
+	i.Status = aggregator.ImportStatusPending
+	i.EndedAt = null.NewTime(time.Now(), false)
+	i.Error = null.NewString("", false)
+	if err := s.ir.SaveImport(ctx, i); err != nil {
+		return nil, fmt.Errorf("failed to save import %s while resuming: %w", i.ID, err)
+	}
+
 	if err := s.ps.PublishImportCommand(ctx, i.ID); err != nil {
-		return nil, fmt.Errorf("failed to publish import %s for channel %s: %w", i.ID, ch.ID, err)
+		return nil, fmt.Errorf("failed to publish import %s for channel %s: %w", i.ID, i.ChannelID, err)
+	}
+
+	return i, nil
+}
+
+func (s *Service) ScheduleReplay(ctx context.Context, importID uuid.UUID) (*aggregator.Import, error) {
+	source, err := s.ir.FindImport(ctx, importID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrImportNotFound) {
+			return nil, ErrImportNotFound
+		}
+
+		return nil, fmt.Errorf("failed to find import %s: %w", importID, err)
+	}
+
+	if source.Status != aggregator.ImportStatusCompleted && source.Status != aggregator.ImportStatusFailed && source.Status != aggregator.ImportStatusDiscarded {
+		return nil, fmt.Errorf("failed to replay import %s with status %s: %w", source.ID, source.Status, ErrImportNotReplayable)
+	}
+
+	// Replays of a replay read the archive of the original import
+	origin := source
+	if source.ReplayOf.Valid {
+		origin, err = s.ir.FindImport(ctx, source.ReplayOf.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find import %s: %w", source.ReplayOf.UUID, err)
+		}
+	}
+	originID := origin.ID
+
+	// An import that stopped fetching halfway only archived part of the feed
+	if origin.Checkpoint == nil || !origin.Checkpoint.Completed {
+		return nil, fmt.Errorf("failed to replay import %s: %w", originID, ErrArchiveIncomplete)
+	}
+
+	s.log.Info(fmt.Sprintf("scheduling replay of import %s for channel %s", originID, source.ChannelID))
+
+	i := &aggregator.Import{
+		ID:        uuid.New(),
+		ChannelID: source.ChannelID,
+		Status:    aggregator.ImportStatusPending,
+		StartedAt: time.Now(),
+		EndedAt:   null.NewTime(time.Now(), false),
+		ReplayOf:  uuid.NullUUID{UUID: originID, Valid: true},
+	}
+	if err := s.ir.SaveImport(ctx, i); err != nil {
+		return nil, fmt.Errorf("failed to save replay of import %s: %w", originID, err)
+	}
+
+	if err := s.ps.PublishImportCommand(ctx, i.ID); err != nil {
+		return nil, fmt.Errorf("failed to publish import %s for channel %s: %w", i.ID, i.ChannelID, err)
+	}
+
+	return i, nil
+}
+
+func (s *Service) ApproveImport(ctx context.Context, importID uuid.UUID) (*aggregator.Import, error) {
+	i, err := s.findImportInReview(ctx, importID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The staged jobs are a snapshot from before the newer import, applying them would take its jobs down
+	superseded, err := s.ir.HasCompletedImportSince(ctx, i.ChannelID, i.StartedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check newer imports of channel %s: %w", i.ChannelID, err)
+	}
+	if superseded {
+		return nil, fmt.Errorf("failed to approve import %s: %w", i.ID, ErrImportSuperseded)
+	}
+
+	s.log.Info(fmt.Sprintf("approving import %s for channel %s", i.ID, i.ChannelID))
+
+	i.Status = aggregator.ImportStatusPending
+	i.Approved = true
+	i.EndedAt = null.NewTime(time.Now(), false)
+	i.Error = null.NewString("", false)
+	if err := s.ir.SaveImport(ctx, i); err != nil {
+		return nil, fmt.Errorf("failed to save import %s while approving: %w", i.ID, err)
+	}
+
+	if err := s.ps.PublishImportCommand(ctx, i.ID); err != nil {
+		return nil, fmt.Errorf("failed to publish import %s for channel %s: %w", i.ID, i.ChannelID, err)
+	}
+
+	return i, nil
+}
+
+func (s *Service) DiscardImport(ctx context.Context, importID uuid.UUID) (*aggregator.Import, error) {
+	i, err := s.findImportInReview(ctx, importID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info(fmt.Sprintf("discarding import %s for channel %s", i.ID, i.ChannelID))
+
+	// Discarded is final, unlike a failed import it cannot be resumed
+	i.Status = aggregator.ImportStatusDiscarded
+	i.EndedAt = null.TimeFrom(time.Now())
+	i.Error = null.StringFrom("discarded after review")
+	if err := s.ir.SaveImport(ctx, i); err != nil {
+		return nil, fmt.Errorf("failed to save import %s while discarding: %w", i.ID, err)
+	}
+
+	if err := s.ir.ClearStagedJobs(ctx, i.ID); err != nil {
+		return nil, fmt.Errorf("failed to clear staged jobs of import %s: %w", i.ID, err)
+	}
+
+	return i, nil
+}
+
+func (s *Service) findImportInReview(ctx context.Context, importID uuid.UUID) (*aggregator.Import, error) {
+	i, err := s.ir.FindImport(ctx, importID)
+	if err != nil {
+		if errors.Is(err, infrastructure.ErrImportNotFound) {
+			return nil, ErrImportNotFound
+		}
+
+		return nil, fmt.Errorf("failed to find import %s: %w", importID, err)
+	}
+
+	if i.Status != aggregator.ImportStatusNeedsReview {
+		return nil, fmt.Errorf("failed to review import %s with status %s: %w", i.ID, i.Status, ErrImportNotInReview)
 	}
 
 	return i, nil
@@ -3,6 +3,7 @@ package scheduling_test
 import (
 	"context"
 	"errors"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
 	"github.com/google/uuid"
@@ -113,9 +114,10 @@ func (suite *ServiceSuite) Test_ScheduleImport_PubSubFailed() {
 	suite.Len(dsl.Imports(), 1)
 	dbImport := dsl.FirstImport()
 	suite.Equal(ch.ID, dbImport.ChannelID)
-	suite.Equal(aggregator.ImportStatusPending, dbImport.Status)
+	suite.Equal(aggregator.ImportStatusFailed, dbImport.Status)
 	suite.True(dbImport.StartedAt.After(time.Now().Add(-2 * time.Second)))
-	suite.False(dbImport.EndedAt.Valid)
+	suite.True(dbImport.EndedAt.Valid)
+	suite.Contains(dbImport.Error.String, "boom")
 
 	// Assert pubsub message
 	suite.Len(dsl.PublishedImports(), 0)
@@ -134,10 +136,12 @@ func (suite *ServiceSuite) Test_ScheduleActiveChannels_Success() {
 	dsl := testutils.NewDSL(
 		testutils.WithChannel(
 			testutils.WithChannelID(id1),
+			testutils.WithChannelName("channel 1"),
 			testutils.WithChannelActivated(),
 		),
 		testutils.WithChannel(
 			testutils.WithChannelID(id2),
+			testutils.WithChannelName("channel 2"),
 			testutils.WithChannelActivated(),
 		),
 		testutils.WithChannel(
@@ -146,7 +150,7 @@ func (suite *ServiceSuite) Test_ScheduleActiveChannels_Success() {
 	)
 
 	// Execute
-	err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
+	run, err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
 
 	// Assert
 	suite.NoError(err)
@@ -168,20 +172,18 @@ func (suite *ServiceSuite) Test_ScheduleActiveChannels_Success() {
 	// Assert correct values published
 	publishedImportIDs := dsl.PublishedImports()
 	suite.Len(publishedImportIDs, 2)
-	i1Exists := false
-	for _, id := range publishedImportIDs {
-		if id == i1.ID {
-			i1Exists = true
-		}
-	}
-	suite.True(i1Exists)
-	i2Exists := false
-	for _, id := range publishedImportIDs {
-		if id == i2.ID {
-			i2Exists = true
-		}
-	}
-	suite.True(i2Exists)
+	suite.Contains(publishedImportIDs, i1.ID)
+	suite.Contains(publishedImportIDs, i2.ID)
+
+	// Assert the run is recorded with the outcome of every channel
+	suite.Equal(aggregator.ScheduleRunStatusSucceeded, run.Status())
+	suite.Equal(2, run.Scheduled)
+	suite.Equal(0, run.Failed)
+	suite.Equal(aggregator.ScheduleRunResults{
+		{ChannelID: id1, ChannelName: "channel 1", ImportID: uuid.NullUUID{UUID: i1.ID, Valid: true}},
+		{ChannelID: id2, ChannelName: "channel 2", ImportID: uuid.NullUUID{UUID: i2.ID, Valid: true}},
+	}, run.Results)
+	suite.Equal([]*aggregator.ScheduleRun{run}, dsl.ScheduleRuns())
 
 	// Assert logs
 	lines := dsl.LogLines()
@@ -200,6 +202,55 @@ func (suite *ServiceSuite) Test_ScheduleActiveChannels_Success() {
 	suite.True(id2Logged)
 }
 
+func (suite *ServiceSuite) Test_ScheduleActiveChannels_PartialFail() {
+	// Prepare
+	id1 := uuid.New()
+	id2 := uuid.New()
+	id3 := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id1),
+			testutils.WithChannelName("channel 1"),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelID(id2),
+			testutils.WithChannelName("channel 2"),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithChannel(
+			testutils.WithChannelID(id3),
+			testutils.WithChannelName("channel 3"),
+			testutils.WithChannelActivated(),
+		),
+	)
+	dsl.ImportRepository.FailForChannel(id1, errors.New("boom"))
+
+	// Execute
+	run, err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
+
+	// Assert the failing channel is reported
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to schedule import for channel "+id1.String())
+	suite.ErrorContains(err, "boom")
+
+	// Assert the channels after it are still scheduled
+	suite.Len(dsl.Imports(), 2)
+	suite.Len(dsl.PublishedImports(), 2)
+
+	// Assert the run shows the partial failure
+	suite.Equal(aggregator.ScheduleRunStatusPartiallyFailed, run.Status())
+	suite.Equal(2, run.Scheduled)
+	suite.Equal(1, run.Failed)
+	suite.Equal(id1, run.Results[0].ChannelID)
+	suite.False(run.Results[0].ImportID.Valid)
+	suite.Contains(run.Results[0].Error, "failed to save import for channel "+id1.String())
+	suite.True(run.Results[1].ImportID.Valid)
+	suite.Empty(run.Results[1].Error)
+	suite.True(run.Results[2].ImportID.Valid)
+	suite.Len(dsl.ScheduleRuns(), 1)
+}
+
 func (suite *ServiceSuite) Test_ScheduleActiveChannels_ChannelRepositoryFail() {
 	// Prepare
 	dsl := testutils.NewDSL(
@@ -216,12 +267,14 @@ func (suite *ServiceSuite) Test_ScheduleActiveChannels_ChannelRepositoryFail() {
 	)
 
 	// Execute
-	err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
+	run, err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
 
 	// Assert
+	suite.Nil(run)
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to fetch active channels")
 	suite.ErrorContains(err, "boom")
+	suite.Empty(dsl.ScheduleRuns())
 
 	// Assert logs
 	suite.Len(dsl.LogLines(), 0)
@@ -239,13 +292,15 @@ func (suite *ServiceSuite) Test_ScheduleActiveChannels_ImportRepositoryFail() {
 	)
 
 	// Execute
-	err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
+	run, err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
 
 	// Assert
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to save import for channel ")
 	suite.Contains(err.Error(), id.String())
 	suite.ErrorContains(err, "boom")
+	suite.Equal(aggregator.ScheduleRunStatusFailed, run.Status())
+	suite.Len(dsl.ScheduleRuns(), 1)
 
 	// Assert logs
 	logs := dsl.LogLines()
@@ -265,16 +320,508 @@ func (suite *ServiceSuite) Test_ScheduleActiveChannels_PubSubServiceFail() {
 	)
 
 	// Execute
-	err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
+	run, err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
 
 	// Assert
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to publish import ")
 	suite.Contains(err.Error(), id.String())
 	suite.ErrorContains(err, "boom")
+	suite.Equal(1, run.Failed)
 
 	// Assert logs
 	lines := dsl.LogLines()
 	suite.Len(lines, 1)
 	suite.Contains(lines[0], "scheduling import for channel "+id.String())
 }
+
+func (suite *ServiceSuite) Test_ScheduleActiveChannels_ScheduleRunRepositoryFail() {
+	// Prepare
+	id := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(id),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithScheduleRunRepositoryError(errors.New("boom")),
+	)
+
+	// Execute
+	run, err := dsl.SchedulingService.ScheduleActiveChannels(context.Background())
+
+	// Assert the imports go ahead without a record of the run
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to save schedule run "+run.ID.String()+": boom")
+	suite.Equal(1, run.Scheduled)
+	suite.Len(dsl.PublishedImports(), 1)
+}
+
+func (suite *ServiceSuite) Test_ResumeImport_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+			testutils.WithImportEndedAt(time.Date(2020, 1, 1, 0, 0, 2, 0, time.UTC)),
+			testutils.WithImportError("failed to get jobs page 2"),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ResumeImport(context.Background(), iID)
+
+	// Assert return
+	suite.NoError(err)
+	suite.NotNil(i)
+	suite.Equal(iID, i.ID)
+	suite.Equal(aggregator.ImportStatusPending, i.Status)
+	suite.False(i.EndedAt.Valid)
+	suite.False(i.Error.Valid)
+
+	// Assert state change
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusPending, dbImport.Status)
+	suite.False(dbImport.Error.Valid)
+
+	// Assert pubsub message
+	suite.Len(dsl.PublishedImports(), 1)
+	suite.Equal(iID, dsl.PublishedImports()[0])
+
+	// Assert log
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "resuming import "+iID.String()+" for channel "+chID.String())
+}
+
+func (suite *ServiceSuite) Test_ResumeImport_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	// Execute
+	i, err := dsl.SchedulingService.ResumeImport(context.Background(), uuid.New())
+
+	// Assert return
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportNotFound)
+
+	// Assert pubsub message
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_ResumeImport_NotFailed() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ResumeImport(context.Background(), iID)
+
+	// Assert return
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportNotResumable)
+	suite.ErrorContains(err, "with status completed")
+
+	// Assert state change
+	suite.Equal(aggregator.ImportStatusCompleted, dsl.FirstImport().Status)
+
+	// Assert pubsub message
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_ScheduleReplay_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+			testutils.WithImportCheckpoint(2, true),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ScheduleReplay(context.Background(), iID)
+
+	// Assert return
+	suite.NoError(err)
+	suite.NotNil(i)
+	suite.NotEqual(iID, i.ID)
+	suite.Equal(chID, i.ChannelID)
+	suite.Equal(aggregator.ImportStatusPending, i.Status)
+	suite.True(i.ReplayOf.Valid)
+	suite.Equal(iID, i.ReplayOf.UUID)
+
+	// Assert state change
+	suite.Len(dsl.Imports(), 2)
+
+	// Assert pubsub message
+	suite.Len(dsl.PublishedImports(), 1)
+	suite.Equal(i.ID, dsl.PublishedImports()[0])
+
+	// Assert log
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "scheduling replay of import "+iID.String()+" for channel "+chID.String())
+}
+
+func (suite *ServiceSuite) Test_ScheduleReplay_OfReplay_UsesOrigin() {
+	// Prepare
+	originID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(originID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+			testutils.WithImportCheckpoint(2, true),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+			testutils.WithImportReplayOf(originID),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ScheduleReplay(context.Background(), iID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(originID, i.ReplayOf.UUID)
+}
+
+func (suite *ServiceSuite) Test_ScheduleReplay_NotFinished() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusProcessing),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ScheduleReplay(context.Background(), iID)
+
+	// Assert
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportNotReplayable)
+	suite.Len(dsl.Imports(), 1)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_ScheduleReplay_PartialArchiveFail() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+			testutils.WithImportCheckpoint(2, false),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ScheduleReplay(context.Background(), iID)
+
+	// Assert
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrArchiveIncomplete)
+	suite.Len(dsl.Imports(), 1)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_ScheduleReplay_NothingFetchedFail() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ScheduleReplay(context.Background(), iID)
+
+	// Assert
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrArchiveIncomplete)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_ScheduleReplay_OfReplay_PartialOriginFail() {
+	// Prepare
+	originID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(originID),
+			testutils.WithImportStatus(aggregator.ImportStatusFailed),
+			testutils.WithImportCheckpoint(1, false),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+			testutils.WithImportReplayOf(originID),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ScheduleReplay(context.Background(), iID)
+
+	// Assert
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrArchiveIncomplete)
+	suite.ErrorContains(err, "failed to replay import "+originID.String())
+}
+
+func (suite *ServiceSuite) Test_ScheduleReplay_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	// Execute
+	i, err := dsl.SchedulingService.ScheduleReplay(context.Background(), uuid.New())
+
+	// Assert
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportNotFound)
+}
+
+func (suite *ServiceSuite) Test_ApproveImport_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusNeedsReview),
+			testutils.WithImportEndedAt(time.Date(2020, 1, 1, 0, 0, 2, 0, time.UTC)),
+			testutils.WithImportError("3 of 3 active jobs (100%) would be marked missing, more than the allowed 50%"),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ApproveImport(context.Background(), iID)
+
+	// Assert return
+	suite.NoError(err)
+	suite.NotNil(i)
+	suite.Equal(aggregator.ImportStatusPending, i.Status)
+	suite.True(i.Approved)
+	suite.False(i.EndedAt.Valid)
+	suite.False(i.Error.Valid)
+
+	// Assert state change
+	dbImport := dsl.FirstImport()
+	suite.Equal(aggregator.ImportStatusPending, dbImport.Status)
+	suite.True(dbImport.Approved)
+
+	// Assert pubsub message
+	suite.Len(dsl.PublishedImports(), 1)
+	suite.Equal(iID, dsl.PublishedImports()[0])
+
+	// Assert log
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "approving import "+iID.String()+" for channel "+chID.String())
+}
+
+func (suite *ServiceSuite) Test_ApproveImport_NotInReview() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ApproveImport(context.Background(), iID)
+
+	// Assert return
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportNotInReview)
+	suite.ErrorContains(err, "with status completed")
+
+	// Assert state change
+	suite.False(dsl.FirstImport().Approved)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_ApproveImport_Superseded() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusNeedsReview),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		),
+		testutils.WithImport(
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ApproveImport(context.Background(), iID)
+
+	// Assert return
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportSuperseded)
+
+	// Assert the stale snapshot is not applied
+	dbImport := dsl.ImportRepository.Imports[iID]
+	suite.Equal(aggregator.ImportStatusNeedsReview, dbImport.Status)
+	suite.False(dbImport.Approved)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_ApproveImport_OlderCompletedImport_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelActivated(),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusNeedsReview),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+		),
+		testutils.WithImport(
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		),
+		testutils.WithImport(
+			testutils.WithImportStatus(aggregator.ImportStatusCompleted),
+			testutils.WithImportStartedAt(time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ApproveImport(context.Background(), iID)
+
+	// Assert only newer imports of the same channel hold back the approval
+	suite.NoError(err)
+	suite.True(i.Approved)
+	suite.Equal([]uuid.UUID{iID}, dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_ApproveImport_NotFound() {
+	// Prepare
+	dsl := testutils.NewDSL()
+
+	// Execute
+	i, err := dsl.SchedulingService.ApproveImport(context.Background(), uuid.New())
+
+	// Assert return
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportNotFound)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_DiscardImport_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+			testutils.WithImportStatus(aggregator.ImportStatusNeedsReview),
+		),
+	)
+	dsl.ImportRepository.AddCheckpoint(&aggregator.ImportCheckpoint{ImportID: iID, Completed: true}, &aggregator.Job{ID: uuid.New()})
+
+	// Execute
+	i, err := dsl.SchedulingService.DiscardImport(context.Background(), iID)
+
+	// Assert return
+	suite.NoError(err)
+	suite.NotNil(i)
+	suite.Equal(aggregator.ImportStatusDiscarded, i.Status)
+	suite.True(i.EndedAt.Valid)
+	suite.Equal("discarded after review", i.Error.String)
+
+	// Assert state change
+	suite.Equal(aggregator.ImportStatusDiscarded, dsl.FirstImport().Status)
+	suite.Empty(dsl.StagedJobs(iID))
+	suite.Empty(dsl.PublishedImports())
+
+	// Assert log
+	logs := dsl.LogLines()
+	suite.Len(logs, 1)
+	suite.Contains(logs[0], "discarding import "+iID.String()+" for channel "+chID.String())
+}
+
+func (suite *ServiceSuite) Test_ResumeImport_Discarded_Fail() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusDiscarded),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.ResumeImport(context.Background(), iID)
+
+	// Assert
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportNotResumable)
+	suite.ErrorContains(err, "with status discarded")
+	suite.Equal(aggregator.ImportStatusDiscarded, dsl.FirstImport().Status)
+	suite.Empty(dsl.PublishedImports())
+}
+
+func (suite *ServiceSuite) Test_DiscardImport_NotInReview() {
+	// Prepare
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportStatus(aggregator.ImportStatusFetching),
+		),
+	)
+
+	// Execute
+	i, err := dsl.SchedulingService.DiscardImport(context.Background(), iID)
+
+	// Assert return
+	suite.Nil(i)
+	suite.ErrorIs(err, scheduling.ErrImportNotInReview)
+	suite.Equal(aggregator.ImportStatusFetching, dsl.FirstImport().Status)
+}
@@ -0,0 +1,8 @@
+package aggregator
+
+import "time"
+
+type Blob struct {
+	ModifiedAt time.Time
+	Key        string
+}
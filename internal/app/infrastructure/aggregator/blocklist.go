@@ -0,0 +1,36 @@
+package aggregator
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BlocklistKind int
+
+const (
+	BlocklistKindCompany BlocklistKind = iota
+	BlocklistKindKeyword
+)
+
+func (k BlocklistKind) String() string {
+	return [...]string{"company", "keyword"}[k]
+}
+
+func ParseBlocklistKind(s string) (BlocklistKind, bool) {
+	for _, v := range []BlocklistKind{BlocklistKindCompany, BlocklistKindKeyword} {
+		if v.String() == s {
+			return v, true
+		}
+	}
+
+	return -1, false
+}
+
+type BlocklistEntry struct {
+	CreatedAt time.Time     `db:"created_at"`
+	Value     string        `db:"value"`
+	Reason    string        `db:"reason"`
+	ID        uuid.UUID     `db:"id"`
+	Kind      BlocklistKind `db:"kind"`
+}
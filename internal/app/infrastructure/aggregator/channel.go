@@ -1,6 +1,10 @@
 package aggregator
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,11 +21,78 @@ func (s ChannelStatus) String() string {
 	return [...]string{"inactive", "active"}[s]
 }
 
+type ChannelSchedule struct {
+	Cron     string        `json:"cron,omitempty"`
+	Interval time.Duration `json:"interval,omitempty"`
+	Jitter   time.Duration `json:"jitter,omitempty"`
+	CatchUp  string        `json:"catch_up,omitempty"`
+}
+
+const (
+	// DefaultChannelPriority applies to channels without a priority, higher priorities get a larger share of the import workers
+	DefaultChannelPriority = 1
+	MaxChannelPriority     = 10
+)
+
+type ChannelSettings struct {
+	MissingAfterImports  int              `json:"missing_after_imports,omitempty"`
+	MissingAfter         time.Duration    `json:"missing_after,omitempty"`
+	MaxAge               time.Duration    `json:"max_age,omitempty"`
+	MaxMissingPercentage float64          `json:"max_missing_percentage,omitempty"`
+	Enrichers            []string         `json:"enrichers,omitempty"`
+	Languages            []string         `json:"languages,omitempty"`
+	Rules                []*Rule          `json:"rules,omitempty"`
+	MinQualityScore      int              `json:"min_quality_score,omitempty"`
+	Seniority            string           `json:"seniority,omitempty"`
+	EmploymentType       string           `json:"employment_type,omitempty"`
+	Schedule             *ChannelSchedule `json:"schedule,omitempty"`
+	Priority             int              `json:"priority,omitempty"`
+}
+
+func (s ChannelSettings) PriorityOrDefault() int {
+	if s.Priority == 0 {
+		return DefaultChannelPriority
+	}
+
+	return s.Priority
+}
+
+func (s ChannelSettings) Value() (driver.Value, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal channel settings: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (s *ChannelSettings) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*s = ChannelSettings{}
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unsupported type for channel settings")
+	}
+
+	if err := json.Unmarshal(b, s); err != nil {
+		return fmt.Errorf("failed to unmarshal channel settings: %w", err)
+	}
+
+	return nil
+}
+
 type Channel struct {
-	CreatedAt   time.Time     `db:"created_at"`
-	UpdatedAt   time.Time     `db:"updated_at"`
-	Name        string        `db:"name"`
-	Integration Integration   `db:"integration"`
-	Status      ChannelStatus `db:"status"`
-	ID          uuid.UUID     `db:"id"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
+	Name        string          `db:"name"`
+	Integration Integration     `db:"integration"`
+	Settings    ChannelSettings `db:"settings"`
+	Status      ChannelStatus   `db:"status"`
+	ID          uuid.UUID       `db:"id"`
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/stretchr/testify/suite"
 	"testing"
+	"time"
 )
 
 func TestChannel(t *testing.T) {
@@ -19,3 +20,31 @@ func (suite *IntegrationSuite) Test_ChannelStatus_Success() {
 	suite.Equal("inactive", aggregator.ChannelStatusInactive.String())
 	suite.Equal("active", aggregator.ChannelStatusActive.String())
 }
+
+func (suite *ChannelSuite) Test_ChannelSettings_ValueScan_Success() {
+	// Prepare
+	settings := aggregator.ChannelSettings{MissingAfterImports: 3, MissingAfter: 48 * time.Hour}
+
+	// Execute
+	v, err := settings.Value()
+	suite.NoError(err)
+
+	var scanned aggregator.ChannelSettings
+	err = scanned.Scan([]byte(v.(string)))
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(settings, scanned)
+}
+
+func (suite *ChannelSuite) Test_ChannelSettings_ScanEmpty_Success() {
+	// Prepare
+	var settings aggregator.ChannelSettings
+
+	// Execute
+	err := settings.Scan("{}")
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(aggregator.ChannelSettings{}, settings)
+}
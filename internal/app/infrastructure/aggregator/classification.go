@@ -0,0 +1,58 @@
+package aggregator
+
+type Seniority int
+
+const (
+	SeniorityUnknown Seniority = iota
+	SeniorityIntern
+	SeniorityJunior
+	SeniorityMid
+	SenioritySenior
+	SeniorityLead
+)
+
+func (s Seniority) String() string {
+	return [...]string{"unknown", "intern", "junior", "mid", "senior", "lead"}[s]
+}
+
+func ParseSeniority(s string) (Seniority, bool) {
+	for _, v := range []Seniority{SeniorityUnknown, SeniorityIntern, SeniorityJunior, SeniorityMid, SenioritySenior, SeniorityLead} {
+		if v.String() == s {
+			return v, true
+		}
+	}
+
+	return -1, false
+}
+
+type EmploymentType int
+
+const (
+	EmploymentTypeUnknown EmploymentType = iota
+	EmploymentTypeFullTime
+	EmploymentTypePartTime
+	EmploymentTypeContract
+	EmploymentTypeInternship
+	EmploymentTypeWorkingStudent
+)
+
+func (t EmploymentType) String() string {
+	return [...]string{"unknown", "full_time", "part_time", "contract", "internship", "working_student"}[t]
+}
+
+func ParseEmploymentType(s string) (EmploymentType, bool) {
+	for _, v := range []EmploymentType{EmploymentTypeUnknown, EmploymentTypeFullTime, EmploymentTypePartTime, EmploymentTypeContract, EmploymentTypeInternship, EmploymentTypeWorkingStudent} {
+		if v.String() == s {
+			return v, true
+		}
+	}
+
+	return -1, false
+}
+
+type Classification struct {
+	Seniority                Seniority      `db:"seniority"`
+	SeniorityConfidence      float64        `db:"seniority_confidence"`
+	EmploymentType           EmploymentType `db:"employment_type"`
+	EmploymentTypeConfidence float64        `db:"employment_type_confidence"`
+}
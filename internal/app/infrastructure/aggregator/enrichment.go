@@ -0,0 +1,49 @@
+package aggregator
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+)
+
+type Enrichments map[string]string
+
+func (e Enrichments) Equal(other Enrichments) bool {
+	return maps.Equal(e, other)
+}
+
+func (e Enrichments) Value() (driver.Value, error) {
+	if e == nil {
+		e = Enrichments{}
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal enrichments: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (e *Enrichments) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*e = Enrichments{}
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unsupported type for enrichments")
+	}
+
+	if err := json.Unmarshal(b, e); err != nil {
+		return fmt.Errorf("failed to unmarshal enrichments: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package aggregator
+
+import (
+	"gopkg.in/guregu/null.v3"
+)
+
+type RemoteScope int
+
+const (
+	RemoteScopeNone RemoteScope = iota
+	RemoteScopeUnspecified
+	RemoteScopeCountry
+	RemoteScopeEU
+	RemoteScopeEurope
+	RemoteScopeWorldwide
+)
+
+func (s RemoteScope) String() string {
+	return [...]string{"none", "unspecified", "country", "eu", "europe", "worldwide"}[s]
+}
+
+func ParseRemoteScope(s string) (RemoteScope, bool) {
+	for _, v := range []RemoteScope{RemoteScopeNone, RemoteScopeUnspecified, RemoteScopeCountry, RemoteScopeEU, RemoteScopeEurope, RemoteScopeWorldwide} {
+		if v.String() == s {
+			return v, true
+		}
+	}
+
+	return -1, false
+}
+
+type Geo struct {
+	City        string      `db:"city"`
+	Region      string      `db:"region"`
+	CountryCode string      `db:"country_code"`
+	Latitude    null.Float  `db:"latitude"`
+	Longitude   null.Float  `db:"longitude"`
+	RemoteScope RemoteScope `db:"remote_scope"`
+}
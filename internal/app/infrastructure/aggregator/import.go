@@ -16,10 +16,12 @@ const (
 	ImportStatusPublishing
 	ImportStatusCompleted
 	ImportStatusFailed
+	ImportStatusNeedsReview
+	ImportStatusDiscarded
 )
 
 func (s ImportStatus) String() string {
-	return [...]string{"pending", "fetching", "processing", "publishing", "completed", "failed"}[s]
+	return [...]string{"pending", "fetching", "processing", "publishing", "completed", "failed", "needs_review", "discarded"}[s]
 }
 
 type ImportMetricType int
@@ -33,10 +35,16 @@ const (
 	ImportMetricTypePublish
 	ImportMetricTypeLatePublish
 	ImportMetricTypeMissingPublish
+	ImportMetricTypePendingMissing
+	ImportMetricTypeExpired
+	ImportMetricTypeFiltered
+	ImportMetricTypeBlocked
+	ImportMetricTypeDeadLink
+	ImportMetricTypeHeldPublish
 )
 
 func (s ImportMetricType) String() string {
-	return [...]string{"new", "updated", "no_change", "missing", "error", "publish", "late_publish", "missing_publish"}[s]
+	return [...]string{"new", "updated", "no_change", "missing", "error", "publish", "late_publish", "missing_publish", "pending_missing", "expired", "filtered", "blocked", "dead_link", "held_publish"}[s]
 }
 
 type ImportMetric struct {
@@ -56,17 +64,36 @@ type ImportMetadata struct {
 	Published        int `db:"published"`
 	LatePublished    int `db:"late_published"`
 	MissingPublished int `db:"missing_published"`
+	PendingMissing   int `db:"pending_missing_jobs"`
+	Expired          int `db:"expired_jobs"`
+	Filtered         int `db:"filtered_jobs"`
+	Blocked          int `db:"blocked_jobs"`
+	DeadLinks        int `db:"dead_link_jobs"`
+	HeldPublished    int `db:"held_published"`
+}
+
+type ImportCheckpoint struct {
+	UpdatedAt time.Time   `db:"updated_at"`
+	NextLink  null.String `db:"next_link"`
+	Pages     int         `db:"pages"`
+	Jobs      int         `db:"jobs"`
+	Completed bool        `db:"completed"`
+	ImportID  uuid.UUID   `db:"import_id"`
 }
 
 type Import struct {
-	StartedAt time.Time       `db:"started_at"`
-	Metadata  *ImportMetadata `db:"-"`
-	EndedAt   null.Time       `db:"ended_at"`
-	Error     null.String     `db:"error"`
-	Metrics   []*ImportMetric `db:"jobs"`
-	Status    ImportStatus    `db:"status"`
-	ID        uuid.UUID       `db:"id"`
-	ChannelID uuid.UUID       `db:"channel_id"`
+	StartedAt  time.Time         `db:"started_at"`
+	UpdatedAt  time.Time         `db:"updated_at"`
+	Metadata   *ImportMetadata   `db:"-"`
+	Checkpoint *ImportCheckpoint `db:"-"`
+	EndedAt    null.Time         `db:"ended_at"`
+	Error      null.String       `db:"error"`
+	Metrics    []*ImportMetric   `db:"jobs"`
+	Status     ImportStatus      `db:"status"`
+	ReplayOf   uuid.NullUUID     `db:"replay_of"`
+	Approved   bool              `db:"approved"`
+	ID         uuid.UUID         `db:"id"`
+	ChannelID  uuid.UUID         `db:"channel_id"`
 }
 
 func (i *Import) NewJobs() int {
@@ -109,6 +136,66 @@ func (i *Import) MissingJobs() int {
 	return 0
 }
 
+func (i *Import) PendingMissingJobs() int {
+	if len(i.Metrics) > 0 {
+		return i.jobCount(ImportMetricTypePendingMissing)
+	}
+	if i.Metadata != nil {
+		return i.Metadata.PendingMissing
+	}
+	return 0
+}
+
+func (i *Import) ExpiredJobs() int {
+	if len(i.Metrics) > 0 {
+		return i.jobCount(ImportMetricTypeExpired)
+	}
+	if i.Metadata != nil {
+		return i.Metadata.Expired
+	}
+	return 0
+}
+
+func (i *Import) FilteredJobs() int {
+	if len(i.Metrics) > 0 {
+		return i.jobCount(ImportMetricTypeFiltered)
+	}
+	if i.Metadata != nil {
+		return i.Metadata.Filtered
+	}
+	return 0
+}
+
+func (i *Import) BlockedJobs() int {
+	if len(i.Metrics) > 0 {
+		return i.jobCount(ImportMetricTypeBlocked)
+	}
+	if i.Metadata != nil {
+		return i.Metadata.Blocked
+	}
+	return 0
+}
+
+func (i *Import) DeadLinkJobs() int {
+	if len(i.Metrics) > 0 {
+		return i.jobCount(ImportMetricTypeDeadLink)
+	}
+	if i.Metadata != nil {
+		return i.Metadata.DeadLinks
+	}
+	return 0
+}
+
+func (i *Import) HeldPublished() int {
+	if len(i.Metrics) > 0 {
+		return i.jobCount(ImportMetricTypeHeldPublish)
+	}
+	if i.Metadata != nil {
+		return i.Metadata.HeldPublished
+	}
+	return 0
+}
+
 func (i *Import) TotalJobs() int {
 	if len(i.Metrics) > 0 {
 		return i.NewJobs() + i.UpdatedJobs() + i.NoChangeJobs()
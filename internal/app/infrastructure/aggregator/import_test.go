@@ -24,6 +24,7 @@ func (suite *ImportSuite) Test_ImportStatus_Success() {
 	suite.Equal("publishing", aggregator.ImportStatusPublishing.String())
 	suite.Equal("completed", aggregator.ImportStatusCompleted.String())
 	suite.Equal("failed", aggregator.ImportStatusFailed.String())
+	suite.Equal("needs_review", aggregator.ImportStatusNeedsReview.String())
 }
 
 func (suite *ImportSuite) Test_ImportMetric_Success() {
@@ -35,6 +36,8 @@ func (suite *ImportSuite) Test_ImportMetric_Success() {
 	suite.Equal("publish", aggregator.ImportMetricTypePublish.String())
 	suite.Equal("late_publish", aggregator.ImportMetricTypeLatePublish.String())
 	suite.Equal("missing_publish", aggregator.ImportMetricTypeMissingPublish.String())
+	suite.Equal("pending_missing", aggregator.ImportMetricTypePendingMissing.String())
+	suite.Equal("expired", aggregator.ImportMetricTypeExpired.String())
 }
 
 func (suite *ImportSuite) Test_Import_NoMetadata_Success() {
@@ -66,6 +69,9 @@ func (suite *ImportSuite) Test_Import_NoMetadata_Success() {
 			{ID: uuid.New(), JobID: uuid.New(), MetricType: aggregator.ImportMetricTypeMissingPublish},
 			{ID: uuid.New(), JobID: uuid.New(), MetricType: aggregator.ImportMetricTypeMissingPublish},
 			{ID: uuid.New(), JobID: uuid.New(), MetricType: aggregator.ImportMetricTypeMissingPublish},
+			{ID: uuid.New(), JobID: uuid.New(), MetricType: aggregator.ImportMetricTypePendingMissing},
+			{ID: uuid.New(), JobID: uuid.New(), MetricType: aggregator.ImportMetricTypePendingMissing},
+			{ID: uuid.New(), JobID: uuid.New(), MetricType: aggregator.ImportMetricTypeExpired},
 		},
 	}
 
@@ -79,6 +85,8 @@ func (suite *ImportSuite) Test_Import_NoMetadata_Success() {
 	suite.Equal(1, i.Published())
 	suite.Equal(2, i.LatePublished())
 	suite.Equal(3, i.MissingPublished())
+	suite.Equal(2, i.PendingMissingJobs())
+	suite.Equal(1, i.ExpiredJobs())
 }
 
 func (suite *ImportSuite) Test_Import_Metadata_Success() {
@@ -97,6 +105,8 @@ func (suite *ImportSuite) Test_Import_Metadata_Success() {
 			Published:        1,
 			LatePublished:    2,
 			MissingPublished: 3,
+			PendingMissing:   2,
+			Expired:          1,
 		},
 	}
 
@@ -110,4 +120,6 @@ func (suite *ImportSuite) Test_Import_Metadata_Success() {
 	suite.Equal(1, i.Published())
 	suite.Equal(2, i.LatePublished())
 	suite.Equal(3, i.MissingPublished())
+	suite.Equal(2, i.PendingMissingJobs())
+	suite.Equal(1, i.ExpiredJobs())
 }
@@ -10,10 +10,25 @@ var Integrations = map[Integration]string{
 	IntegrationArbeitnow: "arbeitnow",
 }
 
+type DescriptionFormat int
+
+const (
+	DescriptionFormatHTML DescriptionFormat = iota
+	DescriptionFormatText
+)
+
+var descriptionFormats = map[Integration]DescriptionFormat{
+	IntegrationArbeitnow: DescriptionFormatHTML,
+}
+
 func (i Integration) String() string {
 	return Integrations[i]
 }
 
+func (i Integration) DescriptionFormat() DescriptionFormat {
+	return descriptionFormats[i]
+}
+
 func ParseIntegration(s string) (Integration, bool) {
 	for _, i := range Integrations {
 		if i == s {
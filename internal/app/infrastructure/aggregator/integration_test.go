@@ -44,3 +44,7 @@ func (suite *IntegrationSuite) Test_ListIntegrations_Success() {
 func (suite *IntegrationSuite) Test_Integration_Success() {
 	suite.Equal("arbeitnow", aggregator.IntegrationArbeitnow.String())
 }
+
+func (suite *IntegrationSuite) Test_DescriptionFormat_Success() {
+	suite.Equal(aggregator.DescriptionFormatHTML, aggregator.IntegrationArbeitnow.DescriptionFormat())
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
 )
 
 type JobStatus int
@@ -22,24 +23,54 @@ type JobPublishStatus int
 const (
 	JobPublishStatusUnpublished JobPublishStatus = iota
 	JobPublishStatusPublished
+	JobPublishStatusHeld
 )
 
 func (s JobPublishStatus) String() string {
-	return [...]string{"unpublished", "published"}[s]
+	return [...]string{"unpublished", "published", "held"}[s]
 }
 
 type Job struct {
-	PostedAt      time.Time        `db:"posted_at"`
-	CreatedAt     time.Time        `db:"created_at"`
-	UpdatedAt     time.Time        `db:"updated_at"`
-	URL           string           `db:"url"`
-	Title         string           `db:"title"`
-	Description   string           `db:"description"`
-	Source        string           `db:"source"`
-	Location      string           `db:"location"`
-	ID            uuid.UUID        `db:"id"`
-	ChannelID     uuid.UUID        `db:"channel_id"`
-	Remote        bool             `db:"remote"`
-	Status        JobStatus        `db:"status"`
-	PublishStatus JobPublishStatus `db:"publish_status"`
+	PostedAt            time.Time        `db:"posted_at"`
+	CreatedAt           time.Time        `db:"created_at"`
+	UpdatedAt           time.Time        `db:"updated_at"`
+	MissingSince        null.Time        `db:"missing_since"`
+	ValidThrough        null.Time        `db:"valid_through"`
+	URL                 string           `db:"url"`
+	Title               string           `db:"title"`
+	Description         string           `db:"description"`
+	DescriptionText     string           `db:"description_text"`
+	DescriptionMarkdown string           `db:"description_markdown"`
+	Source              string           `db:"source"`
+	Company             string           `db:"company"`
+	Location            string           `db:"location"`
+	Language            string           `db:"language"`
+	Enrichments         Enrichments      `db:"enrichments"`
+	Salary              *Salary          `db:"salary"`
+	Quality             *Quality         `db:"quality"`
+	Skills              Skills           `db:"skills"`
+	Tags                []string         `db:"-"`
+	JobTypes            []string         `db:"-"`
+	ID                  uuid.UUID        `db:"id"`
+	ChannelID           uuid.UUID        `db:"channel_id"`
+	Remote              bool             `db:"remote"`
+	MissedImports       int              `db:"missed_imports"`
+	Status              JobStatus        `db:"status"`
+	PublishStatus       JobPublishStatus `db:"publish_status"`
+	Geo
+	Classification
+}
+
+func (j *Job) Enrich(key, value string) {
+	if j.Enrichments == nil {
+		j.Enrichments = make(Enrichments)
+	}
+	j.Enrichments[key] = value
+}
+
+type JobPage struct {
+	Next   null.String
+	Jobs   []*Job
+	Raw    []byte
+	Number int
 }
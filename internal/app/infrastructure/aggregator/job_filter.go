@@ -0,0 +1,20 @@
+package aggregator
+
+import (
+	"github.com/google/uuid"
+)
+
+type JobFilter struct {
+	ChannelID      uuid.NullUUID
+	Status         *JobStatus
+	CountryCode    string
+	Region         string
+	City           string
+	RemoteScope    *RemoteScope
+	Language       string
+	Seniority      *Seniority
+	EmploymentType *EmploymentType
+	Skills         []string
+	MinQuality     int
+	Limit          int
+}
@@ -0,0 +1,63 @@
+package aggregator
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobFieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+type JobChanges []*JobFieldChange
+
+func (c JobChanges) Value() (driver.Value, error) {
+	if c == nil {
+		c = JobChanges{}
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal job changes: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (c *JobChanges) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*c = JobChanges{}
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unsupported type for job changes")
+	}
+
+	if err := json.Unmarshal(b, c); err != nil {
+		return fmt.Errorf("failed to unmarshal job changes: %w", err)
+	}
+
+	return nil
+}
+
+type JobVersion struct {
+	CreatedAt time.Time        `db:"created_at"`
+	Changes   JobChanges       `db:"changes"`
+	Version   int              `db:"version"`
+	Reason    ImportMetricType `db:"reason"`
+	ID        uuid.UUID        `db:"id"`
+	JobID     uuid.UUID        `db:"job_id"`
+	ImportID  uuid.NullUUID    `db:"import_id"`
+}
@@ -0,0 +1,16 @@
+package aggregator
+
+import "time"
+
+type Lease struct {
+	AcquiredAt time.Time `db:"acquired_at"`
+	RenewedAt  time.Time `db:"renewed_at"`
+	ExpiresAt  time.Time `db:"expires_at"`
+	Name       string    `db:"name"`
+	Holder     string    `db:"holder"`
+	Token      int64     `db:"token"`
+}
+
+func (l *Lease) IsExpired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
@@ -0,0 +1,30 @@
+package aggregator
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LinkStatus int
+
+const (
+	LinkStatusUnknown LinkStatus = iota
+	LinkStatusAlive
+	LinkStatusBroken
+	LinkStatusDead
+)
+
+func (s LinkStatus) String() string {
+	return [...]string{"unknown", "alive", "broken", "dead"}[s]
+}
+
+type Link struct {
+	CheckedAt  time.Time  `db:"checked_at"`
+	URL        string     `db:"url"`
+	Error      string     `db:"error"`
+	JobID      uuid.UUID  `db:"job_id"`
+	StatusCode int        `db:"status_code"`
+	Failures   int        `db:"failures"`
+	Status     LinkStatus `db:"status"`
+}
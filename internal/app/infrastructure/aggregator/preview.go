@@ -0,0 +1,27 @@
+package aggregator
+
+import "github.com/google/uuid"
+
+type JobProblem struct {
+	Field   string
+	Message string
+	URL     string
+	JobID   uuid.UUID
+}
+
+type ImportPreview struct {
+	New       []*Job
+	Updated   []*Job
+	Missing   []*Job
+	Problems  []*JobProblem
+	Pages     int
+	Truncated bool
+	Total     int
+	Pending   int
+	Expired   int
+	Filtered  int
+	Blocked   int
+	DeadLinks int
+	NoChange  int
+	ChannelID uuid.UUID
+}
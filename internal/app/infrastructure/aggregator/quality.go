@@ -0,0 +1,70 @@
+package aggregator
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+type QualitySignal struct {
+	Name    string `json:"name"`
+	Reason  string `json:"reason"`
+	Penalty int    `json:"penalty"`
+}
+
+type Quality struct {
+	Signals []*QualitySignal `json:"signals"`
+	Score   int              `json:"score"`
+}
+
+func (q *Quality) String() string {
+	if q == nil {
+		return ""
+	}
+
+	return strconv.Itoa(q.Score)
+}
+
+func (q *Quality) Equal(other *Quality) bool {
+	if q == nil || other == nil {
+		return q == other
+	}
+
+	return q.Score == other.Score && slices.EqualFunc(q.Signals, other.Signals, func(a, b *QualitySignal) bool {
+		return *a == *b
+	})
+}
+
+func (q *Quality) Value() (driver.Value, error) {
+	if q == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal quality: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (q *Quality) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unsupported type for quality")
+	}
+
+	if err := json.Unmarshal(b, q); err != nil {
+		return fmt.Errorf("failed to unmarshal quality: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package aggregator
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
+)
+
+type QueueStatus int
+
+const (
+	QueueStatusQueued QueueStatus = iota
+	QueueStatusRunning
+)
+
+// QueuedImport is an import waiting for, or claimed by, a dispatcher worker.
+// Imports are claimed in order of virtual time, which grows slower for channels with a higher priority.
+// Every claim gets a new claim token, a worker whose claim was requeued can no longer beat or complete the import.
+type QueuedImport struct {
+	EnqueuedAt  time.Time     `db:"enqueued_at"`
+	StartedAt   null.Time     `db:"started_at"`
+	HeartbeatAt null.Time     `db:"heartbeat_at"`
+	VirtualTime float64       `db:"virtual_time"`
+	ImportID    uuid.UUID     `db:"import_id"`
+	ChannelID   uuid.UUID     `db:"channel_id"`
+	ClaimToken  uuid.NullUUID `db:"claim_token"`
+	Integration Integration   `db:"integration"`
+	Priority    int           `db:"priority"`
+	Status      QueueStatus   `db:"status"`
+}
@@ -0,0 +1,54 @@
+package aggregator
+
+import "fmt"
+
+type RuleAction int
+
+const (
+	RuleActionInclude RuleAction = iota
+	RuleActionExclude
+)
+
+func (a RuleAction) String() string {
+	return [...]string{"include", "exclude"}[a]
+}
+
+func ParseRuleAction(s string) (RuleAction, bool) {
+	for _, v := range []RuleAction{RuleActionInclude, RuleActionExclude} {
+		if v.String() == s {
+			return v, true
+		}
+	}
+
+	return -1, false
+}
+
+func (a RuleAction) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *RuleAction) UnmarshalText(b []byte) error {
+	v, ok := ParseRuleAction(string(b))
+	if !ok {
+		return fmt.Errorf("invalid rule action %s", b)
+	}
+	*a = v
+
+	return nil
+}
+
+type RuleCondition struct {
+	Field  string           `json:"field,omitempty"`
+	Op     string           `json:"op,omitempty"`
+	Value  string           `json:"value,omitempty"`
+	Values []string         `json:"values,omitempty"`
+	All    []*RuleCondition `json:"all,omitempty"`
+	Any    []*RuleCondition `json:"any,omitempty"`
+	Not    *RuleCondition   `json:"not,omitempty"`
+}
+
+type Rule struct {
+	Name      string         `json:"name"`
+	Action    RuleAction     `json:"action"`
+	Condition *RuleCondition `json:"condition"`
+}
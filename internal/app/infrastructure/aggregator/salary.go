@@ -0,0 +1,119 @@
+package aggregator
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+type SalaryPeriod int
+
+const (
+	SalaryPeriodYear SalaryPeriod = iota
+	SalaryPeriodMonth
+	SalaryPeriodWeek
+	SalaryPeriodDay
+	SalaryPeriodHour
+)
+
+func (p SalaryPeriod) String() string {
+	return [...]string{"year", "month", "week", "day", "hour"}[p]
+}
+
+func (p SalaryPeriod) PerYear() float64 {
+	// Based on a 40 hour week, 5 days a week
+	return [...]float64{1, 12, 52, 260, 2080}[p]
+}
+
+func (p SalaryPeriod) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *SalaryPeriod) UnmarshalText(b []byte) error {
+	for _, v := range []SalaryPeriod{SalaryPeriodYear, SalaryPeriodMonth, SalaryPeriodWeek, SalaryPeriodDay, SalaryPeriodHour} {
+		if v.String() == string(b) {
+			*p = v
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid salary period %s", b)
+}
+
+type Salary struct {
+	Currency  string       `json:"currency"`
+	Min       float64      `json:"min"`
+	Max       float64      `json:"max"`
+	AnnualMin float64      `json:"annual_min"`
+	AnnualMax float64      `json:"annual_max"`
+	Period    SalaryPeriod `json:"period"`
+	Net       bool         `json:"net"`
+}
+
+func (s *Salary) Annualize() {
+	s.AnnualMin = math.Round(s.Min * s.Period.PerYear())
+	s.AnnualMax = math.Round(s.Max * s.Period.PerYear())
+}
+
+func (s *Salary) Type() string {
+	if s.Net {
+		return "net"
+	}
+
+	return "gross"
+}
+
+func (s *Salary) String() string {
+	if s == nil {
+		return ""
+	}
+
+	amount := strconv.FormatFloat(s.Min, 'f', -1, 64)
+	if s.Max != s.Min {
+		amount += "-" + strconv.FormatFloat(s.Max, 'f', -1, 64)
+	}
+
+	return fmt.Sprintf("%s %s per %s (%s)", amount, s.Currency, s.Period, s.Type())
+}
+
+func (s *Salary) Equal(other *Salary) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return *s == *other
+}
+
+func (s *Salary) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal salary: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (s *Salary) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unsupported type for salary")
+	}
+
+	if err := json.Unmarshal(b, s); err != nil {
+		return fmt.Errorf("failed to unmarshal salary: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package aggregator_test
+
+import (
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestSalary(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SalarySuite))
+}
+
+type SalarySuite struct {
+	suite.Suite
+}
+
+func (suite *SalarySuite) Test_SalaryPeriod_Success() {
+	suite.Equal("year", aggregator.SalaryPeriodYear.String())
+	suite.Equal("month", aggregator.SalaryPeriodMonth.String())
+	suite.Equal("week", aggregator.SalaryPeriodWeek.String())
+	suite.Equal("day", aggregator.SalaryPeriodDay.String())
+	suite.Equal("hour", aggregator.SalaryPeriodHour.String())
+}
+
+func (suite *SalarySuite) Test_Annualize_Success() {
+	// Prepare
+	s := &aggregator.Salary{Currency: "EUR", Min: 3500, Max: 4000, Period: aggregator.SalaryPeriodMonth}
+
+	// Execute
+	s.Annualize()
+
+	// Assert
+	suite.Equal(42000.0, s.AnnualMin)
+	suite.Equal(48000.0, s.AnnualMax)
+	suite.Equal("3500-4000 EUR per month (gross)", s.String())
+}
+
+func (suite *SalarySuite) Test_ValueScan_Success() {
+	// Prepare
+	s := &aggregator.Salary{Currency: "USD", Min: 50, Max: 50, AnnualMin: 104000, AnnualMax: 104000, Period: aggregator.SalaryPeriodHour, Net: true}
+
+	// Execute
+	v, err := s.Value()
+	suite.NoError(err)
+
+	var scanned aggregator.Salary
+	err = scanned.Scan(v)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(`{"currency":"USD","min":50,"max":50,"annual_min":104000,"annual_max":104000,"period":"hour","net":true}`, v)
+	suite.True(s.Equal(&scanned))
+}
+
+func (suite *SalarySuite) Test_Value_Nil() {
+	var s *aggregator.Salary
+
+	v, err := s.Value()
+
+	suite.NoError(err)
+	suite.Nil(v)
+}
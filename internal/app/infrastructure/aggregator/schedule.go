@@ -0,0 +1,17 @@
+package aggregator
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
+)
+
+type ScheduleState struct {
+	NextRunAt    time.Time     `db:"next_run_at"`
+	LastRunAt    null.Time     `db:"last_run_at"`
+	Spec         string        `db:"spec"`
+	LastImportID uuid.NullUUID `db:"last_import_id"`
+	ChannelID    uuid.UUID     `db:"channel_id"`
+	Fence        int64         `db:"fence"`
+}
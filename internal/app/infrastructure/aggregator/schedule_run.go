@@ -0,0 +1,86 @@
+package aggregator
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScheduleRunStatus int
+
+const (
+	ScheduleRunStatusSucceeded ScheduleRunStatus = iota
+	ScheduleRunStatusPartiallyFailed
+	ScheduleRunStatusFailed
+)
+
+func (s ScheduleRunStatus) String() string {
+	return [...]string{"succeeded", "partially_failed", "failed"}[s]
+}
+
+type ScheduleRunResult struct {
+	Error       string        `json:"error,omitempty"`
+	ChannelName string        `json:"channel_name"`
+	ImportID    uuid.NullUUID `json:"import_id"`
+	ChannelID   uuid.UUID     `json:"channel_id"`
+}
+
+type ScheduleRunResults []*ScheduleRunResult
+
+func (r ScheduleRunResults) Value() (driver.Value, error) {
+	if r == nil {
+		r = ScheduleRunResults{}
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schedule run results: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func (r *ScheduleRunResults) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*r = ScheduleRunResults{}
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unsupported type for schedule run results")
+	}
+
+	if err := json.Unmarshal(b, r); err != nil {
+		return fmt.Errorf("failed to unmarshal schedule run results: %w", err)
+	}
+
+	return nil
+}
+
+type ScheduleRun struct {
+	StartedAt time.Time          `db:"started_at"`
+	EndedAt   time.Time          `db:"ended_at"`
+	Results   ScheduleRunResults `db:"results"`
+	Scheduled int                `db:"scheduled"`
+	Failed    int                `db:"failed"`
+	ID        uuid.UUID          `db:"id"`
+}
+
+func (r *ScheduleRun) Status() ScheduleRunStatus {
+	switch {
+	case r.Failed == 0:
+		return ScheduleRunStatusSucceeded
+	case r.Scheduled > 0:
+		return ScheduleRunStatusPartiallyFailed
+	default:
+		return ScheduleRunStatusFailed
+	}
+}
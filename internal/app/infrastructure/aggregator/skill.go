@@ -0,0 +1,63 @@
+package aggregator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type Skill struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type Skills []*Skill
+
+func (s Skills) Names() []string {
+	result := make([]string, 0, len(s))
+	for _, sk := range s {
+		result = append(result, sk.Name)
+	}
+
+	return result
+}
+
+func (s Skills) String() string {
+	return strings.Join(s.Names(), ", ")
+}
+
+func (s Skills) Equal(other Skills) bool {
+	return slices.EqualFunc(s, other, func(a, b *Skill) bool {
+		return *a == *b
+	})
+}
+
+func (s *Skills) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("unsupported type for skills")
+	}
+
+	var result Skills
+	if err := json.Unmarshal(b, &result); err != nil {
+		return fmt.Errorf("failed to unmarshal skills: %w", err)
+	}
+
+	// An empty aggregate is the same as no skills at all
+	if len(result) == 0 {
+		result = nil
+	}
+	*s = result
+
+	return nil
+}
@@ -38,10 +38,25 @@ func (c *client) JobBoard(endpoint string, ch *aggregator.Channel) (*jobBoardRes
 		return nil, fmt.Errorf("failed to get job board: %w", c.handleFailedResponse(resp))
 	}
 
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	jobsResponse, err := decodeJobBoard(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobsResponse, nil
+}
+
+func decodeJobBoard(content []byte) (*jobBoardResponse, error) {
 	var jobsResponse jobBoardResponse
-	if err := json.NewDecoder(resp.Body).Decode(&jobsResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode response body: %w: %s", err, resp.Body)
+	if err := json.Unmarshal(content, &jobsResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode response body: %w: %s", err, content)
 	}
+	jobsResponse.raw = content
 
 	return &jobsResponse, nil
 }
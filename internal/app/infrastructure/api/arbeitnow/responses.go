@@ -9,6 +9,7 @@ type jobBoardResponse struct {
 	Links struct {
 		Next null.String `json:"next"`
 	} `json:"links"`
+	raw []byte
 }
 
 type jobEntry struct {
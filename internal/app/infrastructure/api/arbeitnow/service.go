@@ -7,6 +7,7 @@ import (
 
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
 )
 
 const endpointJobBoard = "/api/job-board-api"
@@ -30,16 +31,33 @@ func NewService(c HTTPClient, cfg Config, ch *aggregator.Channel) *Service {
 }
 
 func (s *Service) GetJobs() ([]*aggregator.Job, error) {
-	jobs := make([]*jobEntry, 0)
-	page := 1
+	result := make([]*aggregator.Job, 0)
+	err := s.GetJobsFrom(null.NewString("", false), 1, func(p *aggregator.JobPage) error {
+		result = append(result, p.Jobs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetJobsFrom(next null.String, page int, fn func(p *aggregator.JobPage) error) error {
 	endpoint := s.baseURL + endpointJobBoard
+	if next.Valid {
+		endpoint = next.String
+	}
+
 	for {
 		resp, err := s.c.JobBoard(endpoint, s.ch)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get jobs page %d on channel %s: %w", page, s.ch.ID, err)
+			return fmt.Errorf("failed to get jobs page %d on channel %s: %w", page, s.ch.ID, err)
 		}
 
-		jobs = append(jobs, resp.Jobs...)
+		if err := fn(s.toPage(resp, page)); err != nil {
+			return fmt.Errorf("failed to handle jobs page %d on channel %s: %w", page, s.ch.ID, err)
+		}
 
 		if !resp.Links.Next.Valid {
 			break
@@ -49,6 +67,28 @@ func (s *Service) GetJobs() ([]*aggregator.Job, error) {
 		page++
 	}
 
+	return nil
+}
+
+func (s *Service) ParsePage(raw []byte, page int) (*aggregator.JobPage, error) {
+	resp, err := decodeJobBoard(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse jobs page %d on channel %s: %w", page, s.ch.ID, err)
+	}
+
+	return s.toPage(resp, page), nil
+}
+
+func (s *Service) toPage(resp *jobBoardResponse, page int) *aggregator.JobPage {
+	return &aggregator.JobPage{
+		Number: page,
+		Jobs:   s.convert(resp.Jobs),
+		Next:   resp.Links.Next,
+		Raw:    resp.raw,
+	}
+}
+
+func (s *Service) convert(jobs []*jobEntry) []*aggregator.Job {
 	result := make([]*aggregator.Job, 0, len(jobs))
 	for _, j := range jobs {
 		result = append(result, &aggregator.Job{
@@ -58,8 +98,11 @@ func (s *Service) GetJobs() ([]*aggregator.Job, error) {
 			URL:         j.URL,
 			Title:       j.Title,
 			Description: j.Description,
+			Company:     j.CompanyName,
 			Location:    j.Location,
 			Remote:      j.Remote,
+			Tags:        j.Tags,
+			JobTypes:    j.JobTypes,
 			PostedAt:    time.Unix(j.CreatedAt, 0),
 			Source:      aggregator.IntegrationArbeitnow.String(),
 			CreatedAt:   time.Now(),
@@ -67,5 +110,5 @@ func (s *Service) GetJobs() ([]*aggregator.Job, error) {
 		})
 	}
 
-	return result, nil
+	return result
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
 	"io"
 	"net/http"
 	"strings"
@@ -48,7 +49,9 @@ func (suite *ServiceSuite) Test_GetJobs_Success() {
 	suite.Equal(uuid.NewSHA1(ch.ID, []byte("bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288")), jobs[0].ID)
 	suite.Equal("Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)", jobs[0].Title)
 	suite.Equal("<p>Unser Kunde ist im Bereich Vermögensverwaltung und Fondmanagement ein führender Finanzdienstleister mit Sitz in München. Als zuverlässiger Partner unabhängiger Vermögensberater und ausgewählter institutioneller Kunden verfügt das Unternehmen über ein Verwaltungsvolumen mehrerer Mrd. EUR. Mit derzeit über 40 Mitarbeitern befasst sich das Unternehmen um alle Vermögensbelange seines Kunden. Nachhaltige Qualität und Kundenzufriedenheit stehen im Mittelpunkt des Unternehmens.</p>\n<p>Wir freuen uns auf Ihre Bewerbung als</p>\n<p><strong>Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Überprüfung und Dokumentation von Daueraufträgen sowie (Dauer)-Lastschriften.</li>\n<li>Abwicklung des Zahlungsverkehrs im In- und Ausland.</li>\n<li>Bearbeitung von Nachlasskonten im Zusammenhang mit der Kontolöschung.</li>\n<li>Erfassung interner Kostenrechnungen und Kundenbuchungen.</li>\n<li>Überprüfung und Erfassung von Kontolöschungen. </li>\n<li>Durchführung von Tests für bestehende und neu einzuführende Prozesse.</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung als Bankkaufmann (m/w/d) oder vergleichbare kaufmännische Qualifikation.</li>\n<li>Expertise im nationalen und internationalen Zahlungsverkehr.</li>\n<li>Kenntnisse in der Kundenstammdatenpflege.</li>\n<li>Fähigkeit zur selbstständigen Arbeit sowie analytische Herangehensweise</li>\n<li>Anwendungssicher in MS Office, insbesondere Excel von Vorteil.</li>\n<li>Hohes Maß an sorgfältiger und präziser Arbeitsweise</li>\n</ul>\n<h2>Benefits</h2>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen) </li>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching </li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen </li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen </li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers </li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Wir freuen uns darauf, Dich kennen zu lernen! Sende Deine aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Deinem Gehaltswunsch sowie Deinem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Dich ist und ob wir Dir außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>DEIN ANSPRECHPARTNER:</strong></p>\n<p>Frau Elwira Dabrowska | Tel.: 089/890 648 1039</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\">Jobs in Germany</a> on Arbeitnow</a>", jobs[0].Description)
+	suite.Equal("OPUS ONE Recruitment GmbH", jobs[0].Company)
 	suite.Equal("Munich", jobs[0].Location)
+	suite.Equal([]string{"Finance"}, jobs[0].Tags)
 	suite.True(jobs[0].PostedAt.Equal(time.Unix(1739357344, 0)))
 	suite.Equal("https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288", jobs[0].URL)
 	suite.True(jobs[0].Remote)
@@ -175,3 +178,95 @@ func (suite *ServiceSuite) Test_GetJobs_FailedResponseWithoutBody() {
 	suite.ErrorContains(err, "failed to get jobs page 1 on channel "+chID.String())
 	suite.ErrorContains(err, "failed to request with http code 500 and no body")
 }
+
+func (suite *ServiceSuite) Test_GetJobsFrom_Success() {
+	// Prepare
+	server := testutils.NewArbeitnowServer()
+	defer server.Close()
+	ch := &aggregator.Channel{
+		ID:          uuid.New(),
+		Name:        "arbeitnow integration",
+		Integration: aggregator.IntegrationArbeitnow,
+		Status:      aggregator.ChannelStatusActive,
+	}
+	c := testutils.NewRequestLogger(http.DefaultClient)
+	s := arbeitnow.NewService(c, arbeitnow.Config{URL: server.URL}, ch)
+	pages := make([]*aggregator.JobPage, 0)
+
+	// Execute
+	err := s.GetJobsFrom(null.StringFrom(server.URL+"/api/job-board-api?page=2"), 2, func(p *aggregator.JobPage) error {
+		pages = append(pages, p)
+		return nil
+	})
+
+	// Assert result
+	suite.NoError(err)
+	suite.Len(pages, 1)
+	suite.Equal(2, pages[0].Number)
+	suite.False(pages[0].Next.Valid)
+	suite.Len(pages[0].Jobs, 1)
+	suite.Equal(uuid.NewSHA1(ch.ID, []byte("fund-accountant-wertpapierfonds-munich-310570")), pages[0].Jobs[0].ID)
+
+	// Assert requests made
+	suite.Len(c.Logs, 1)
+	suite.Equal(server.URL+"/api/job-board-api?page=2", c.Logs[0].URL)
+}
+
+func (suite *ServiceSuite) Test_GetJobsFrom_HandlerFailed() {
+	// Prepare
+	server := testutils.NewArbeitnowServer()
+	defer server.Close()
+	ch := &aggregator.Channel{
+		ID:          uuid.New(),
+		Name:        "arbeitnow integration",
+		Integration: aggregator.IntegrationArbeitnow,
+		Status:      aggregator.ChannelStatusActive,
+	}
+	c := testutils.NewRequestLogger(http.DefaultClient)
+	s := arbeitnow.NewService(c, arbeitnow.Config{URL: server.URL}, ch)
+
+	// Execute
+	err := s.GetJobsFrom(null.NewString("", false), 1, func(p *aggregator.JobPage) error {
+		return errors.New("boom")
+	})
+
+	// Assert result
+	suite.Error(err)
+	suite.ErrorContains(err, "failed to handle jobs page 1 on channel "+ch.ID.String())
+	suite.ErrorContains(err, "boom")
+
+	// Assert requests made
+	suite.Len(c.Logs, 1)
+}
+
+func (suite *ServiceSuite) Test_ParsePage_Success() {
+	// Prepare
+	ch := &aggregator.Channel{ID: uuid.New(), Integration: aggregator.IntegrationArbeitnow}
+	s := arbeitnow.NewService(http.DefaultClient, arbeitnow.Config{}, ch)
+	raw := []byte(`{"data":[{"slug":"job-1","title":"Job 1","url":"https://example.com/1","location":"Berlin","remote":true,"created_at":1739357344}],"links":{"next":null}}`)
+
+	// Execute
+	p, err := s.ParsePage(raw, 3)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(3, p.Number)
+	suite.False(p.Next.Valid)
+	suite.Equal(raw, p.Raw)
+	suite.Len(p.Jobs, 1)
+	suite.Equal(uuid.NewSHA1(ch.ID, []byte("job-1")), p.Jobs[0].ID)
+	suite.Equal("Berlin", p.Jobs[0].Location)
+}
+
+func (suite *ServiceSuite) Test_ParsePage_InvalidPayload() {
+	// Prepare
+	ch := &aggregator.Channel{ID: uuid.New(), Integration: aggregator.IntegrationArbeitnow}
+	s := arbeitnow.NewService(http.DefaultClient, arbeitnow.Config{}, ch)
+
+	// Execute
+	p, err := s.ParsePage([]byte("not json"), 1)
+
+	// Assert
+	suite.Nil(p)
+	suite.ErrorContains(err, "failed to parse jobs page 1 on channel "+ch.ID.String())
+}
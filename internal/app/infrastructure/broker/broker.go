@@ -0,0 +1,69 @@
+package broker
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	KindPubSub = "pubsub"
+	KindNATS   = "nats"
+	KindKafka  = "kafka"
+	KindMemory = "memory"
+)
+
+const (
+	ReceiveModePush = "push"
+	ReceiveModePull = "pull"
+)
+
+// Message is what travels over a broker, the data holds a message of the jobs-protobuf contracts.
+// Type is the full name of that message when it is published, brokers only carrying bytes ignore it.
+type Message struct {
+	Attributes map[string]string
+	ID         string
+	Type       string
+	Data       []byte
+}
+
+// Handler processes a received message. Returning nil acknowledges it,
+// returning an error hands it back to the broker for redelivery.
+type Handler func(ctx context.Context, msg *Message) error
+
+type Publisher interface {
+	Publish(ctx context.Context, msg *Message) error
+}
+
+type Subscriber interface {
+	Receive(ctx context.Context, h Handler) error
+}
+
+// Broker hands out publishers for topics and subscribers for named subscriptions on a topic.
+// Every subscription receives every message published to its topic, within a subscription
+// a message is delivered to one receiver.
+type Broker interface {
+	Publisher(ctx context.Context, topic string) (Publisher, error)
+	Subscriber(ctx context.Context, topic, subscription string) (Subscriber, error)
+	Close() error
+}
+
+type SubscriberConfig struct {
+	MaxOutstandingMessages int           `env:"MAX_OUTSTANDING_MESSAGES" envDefault:"1"`
+	MaxExtension           time.Duration `env:"MAX_EXTENSION" envDefault:"2h"`
+	MaxExtensionPeriod     time.Duration `env:"MAX_EXTENSION_PERIOD" envDefault:"5m"`
+	ShutdownTimeout        time.Duration `env:"SHUTDOWN_TIMEOUT" envDefault:"10s"`
+}
+
+// HandlerContext returns the context to handle messages with. It outlives ctx by the shutdown timeout,
+// so handlers get the chance to finish after receiving stopped. The returned func releases it.
+func HandlerContext(ctx context.Context, shutdownTimeout time.Duration) (context.Context, func()) {
+	hctx, interrupt := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(ctx, func() {
+		time.AfterFunc(shutdownTimeout, interrupt)
+	})
+
+	return hctx, func() {
+		stop()
+		interrupt()
+	}
+}
@@ -0,0 +1,78 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/aviseu/jobs-protobuf/build/gen/go/commands/imports"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+type ImportService struct {
+	p Publisher
+}
+
+func NewImportService(p Publisher) *ImportService {
+	return &ImportService{
+		p: p,
+	}
+}
+
+func (s *ImportService) PublishImportCommand(ctx context.Context, importID uuid.UUID) error {
+	msg := imports.ExecuteImportChannel{
+		ImportId: importID.String(),
+	}
+
+	return publish(ctx, s.p, &msg, nil)
+}
+
+type Importer interface {
+	Import(ctx context.Context, importID uuid.UUID) error
+}
+
+// NewImportHandler runs the import of every import command it receives. Messages that can not be
+// processed and failed imports are acknowledged, only imports interrupted by a shutdown are redelivered.
+func NewImportHandler(im Importer, log *slog.Logger) Handler {
+	return func(ctx context.Context, msg *Message) error {
+		var data imports.ExecuteImportChannel
+		if err := proto.Unmarshal(msg.Data, &data); err != nil {
+			log.Error(fmt.Errorf("failed to unmarshal message %s: %w", msg.ID, err).Error())
+			return nil
+		}
+
+		importID, err := uuid.Parse(data.ImportId)
+		if err != nil {
+			log.Error(fmt.Errorf("failed to convert import id %s to uuid: %w", data.ImportId, err).Error())
+			return nil
+		}
+
+		log.Info("processing import " + importID.String())
+		if err := im.Import(ctx, importID); err != nil {
+			if ctx.Err() != nil {
+				log.Info(fmt.Sprintf("interrupted import %s, handing it back for redelivery", importID))
+				return fmt.Errorf("failed to finish import %s: %w", importID, err)
+			}
+
+			log.Error(fmt.Errorf("failed to execute import %s: %w", importID, err).Error())
+			return nil
+		}
+		log.Info("completed import " + importID.String())
+
+		return nil
+	}
+}
+
+func publish(ctx context.Context, p Publisher, msg proto.Message, attrs map[string]string) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	if err := p.Publish(ctx, &Message{Type: string(proto.MessageName(msg)), Data: data, Attributes: attrs}); err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package broker_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/aviseu/jobs-protobuf/build/gen/go/commands/imports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestImportHandler(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ImportHandlerSuite))
+}
+
+type ImportHandlerSuite struct {
+	suite.Suite
+}
+
+func (suite *ImportHandlerSuite) message(importID string) *broker.Message {
+	data, err := proto.Marshal(&imports.ExecuteImportChannel{ImportId: importID})
+	suite.NoError(err)
+
+	return &broker.Message{ID: "1", Data: data}
+}
+
+func (suite *ImportHandlerSuite) Test_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+		),
+	)
+	h := broker.NewImportHandler(dsl.ImportService, dsl.Logger)
+
+	// Execute
+	err := h(context.Background(), suite.message(iID.String()))
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(aggregator.ImportStatusCompleted, dsl.FirstImport().Status)
+	suite.Contains(dsl.LogLines()[0], "processing import "+iID.String())
+}
+
+func (suite *ImportHandlerSuite) Test_InvalidMessage_Acks() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	h := broker.NewImportHandler(dsl.ImportService, dsl.Logger)
+
+	// Execute
+	err := h(context.Background(), &broker.Message{ID: "1", Data: []byte("invalid")})
+
+	// Assert
+	suite.NoError(err)
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to unmarshal message 1")
+}
+
+func (suite *ImportHandlerSuite) Test_InvalidImportID_Acks() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	h := broker.NewImportHandler(dsl.ImportService, dsl.Logger)
+
+	// Execute
+	err := h(context.Background(), suite.message("not-a-uuid"))
+
+	// Assert
+	suite.NoError(err)
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to convert import id not-a-uuid to uuid")
+}
+
+func (suite *ImportHandlerSuite) Test_ImportFail_Acks() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	h := broker.NewImportHandler(dsl.ImportService, dsl.Logger)
+	iID := uuid.New()
+
+	// Execute
+	err := h(context.Background(), suite.message(iID.String()))
+
+	// Assert
+	suite.NoError(err)
+	lines := dsl.LogLines()
+	suite.Len(lines, 2)
+	suite.Contains(lines[1], "failed to execute import "+iID.String())
+	suite.Contains(lines[1], `"level":"ERROR"`)
+}
+
+func (suite *ImportHandlerSuite) Test_Interrupted_Nacks() {
+	// Prepare
+	dsl := testutils.NewDSL()
+	h := broker.NewImportHandler(dsl.ImportService, dsl.Logger)
+	iID := uuid.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Execute
+	err := h(ctx, suite.message(iID.String()))
+
+	// Assert
+	suite.ErrorContains(err, "failed to finish import "+iID.String())
+	suite.Contains(dsl.LogLines()[1], "interrupted import "+iID.String())
+}
@@ -0,0 +1,98 @@
+package broker
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-protobuf/build/gen/go/events/jobs"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type JobService struct {
+	p Publisher
+}
+
+func NewJobService(p Publisher) *JobService {
+	return &JobService{
+		p: p,
+	}
+}
+
+func (s *JobService) PublishJobInformation(ctx context.Context, job *aggregator.Job) error {
+	msg := jobs.JobInformation{
+		Id:          job.ID.String(),
+		ChannelId:   job.ChannelID.String(),
+		Title:       job.Title,
+		Description: job.Description,
+		Url:         job.URL,
+		Source:      job.Source,
+		Location:    job.Location,
+		PostedAt:    timestamppb.New(job.PostedAt),
+		Remote:      job.Remote,
+	}
+
+	// Derived data travels as attributes, the message itself follows the shared contract
+	attrs := make(map[string]string, len(job.Enrichments))
+	for k, v := range job.Enrichments {
+		attrs["enrichment."+k] = v
+	}
+	if job.Salary != nil {
+		attrs["salary.currency"] = job.Salary.Currency
+		attrs["salary.period"] = job.Salary.Period.String()
+		attrs["salary.type"] = job.Salary.Type()
+		attrs["salary.min"] = strconv.FormatFloat(job.Salary.Min, 'f', -1, 64)
+		attrs["salary.max"] = strconv.FormatFloat(job.Salary.Max, 'f', -1, 64)
+		attrs["salary.annual_min"] = strconv.FormatFloat(job.Salary.AnnualMin, 'f', -1, 64)
+		attrs["salary.annual_max"] = strconv.FormatFloat(job.Salary.AnnualMax, 'f', -1, 64)
+	}
+	if job.ValidThrough.Valid {
+		attrs["valid_through"] = job.ValidThrough.Time.UTC().Format(time.RFC3339)
+	}
+	if job.Language != "" {
+		attrs["language"] = job.Language
+	}
+	if job.Quality != nil {
+		attrs["quality.score"] = strconv.Itoa(job.Quality.Score)
+	}
+	if job.Seniority != aggregator.SeniorityUnknown {
+		attrs["seniority"] = job.Seniority.String()
+		attrs["seniority.confidence"] = strconv.FormatFloat(job.SeniorityConfidence, 'f', -1, 64)
+	}
+	if job.EmploymentType != aggregator.EmploymentTypeUnknown {
+		attrs["employment_type"] = job.EmploymentType.String()
+		attrs["employment_type.confidence"] = strconv.FormatFloat(job.EmploymentTypeConfidence, 'f', -1, 64)
+	}
+	if len(job.Skills) > 0 {
+		attrs["skills"] = strings.Join(job.Skills.Names(), ",")
+	}
+
+	return publish(ctx, s.p, &msg, attrs)
+}
+
+func (s *JobService) PublishJobMissing(ctx context.Context, job *aggregator.Job) error {
+	msg := jobs.JobMissing{
+		Id: job.ID.String(),
+	}
+
+	return publish(ctx, s.p, &msg, nil)
+}
+
+func (s *JobService) PublishJobBlocked(ctx context.Context, job *aggregator.Job) error {
+	msg := jobs.JobMissing{
+		Id: job.ID.String(),
+	}
+
+	// The contract has no blocked event, the reason tells a blocked job apart from one that vanished
+	return publish(ctx, s.p, &msg, map[string]string{"reason": "blocked"})
+}
+
+func (s *JobService) PublishJobHeld(ctx context.Context, job *aggregator.Job) error {
+	msg := jobs.JobMissing{
+		Id: job.ID.String(),
+	}
+
+	return publish(ctx, s.p, &msg, map[string]string{"reason": "low_quality"})
+}
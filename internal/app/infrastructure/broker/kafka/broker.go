@@ -0,0 +1,80 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+type Config struct {
+	Brokers      []string      `env:"BROKERS" envDefault:"localhost:9092" envSeparator:","`
+	BatchTimeout time.Duration `env:"BATCH_TIMEOUT" envDefault:"10ms"`
+}
+
+// Broker maps topics on kafka topics, created on first use, and a subscription on a consumer group
+type Broker struct {
+	cfg     Config
+	subCfg  broker.SubscriberConfig
+	closers []func() error
+	m       sync.Mutex
+}
+
+func NewBroker(cfg Config, subCfg broker.SubscriberConfig) *Broker {
+	return &Broker{
+		cfg:    cfg,
+		subCfg: subCfg,
+	}
+}
+
+func (b *Broker) Publisher(_ context.Context, topic string) (broker.Publisher, error) {
+	w := &kafkago.Writer{
+		Addr:                   kafkago.TCP(b.cfg.Brokers...),
+		Topic:                  topic,
+		Balancer:               &kafkago.LeastBytes{},
+		RequiredAcks:           kafkago.RequireAll,
+		BatchTimeout:           b.cfg.BatchTimeout,
+		AllowAutoTopicCreation: true,
+	}
+	b.onClose(w.Close)
+
+	return NewPublisher(w), nil
+}
+
+func (b *Broker) Subscriber(_ context.Context, topic, subscription string) (broker.Subscriber, error) {
+	r := kafkago.NewReader(kafkago.ReaderConfig{
+		Brokers: b.cfg.Brokers,
+		GroupID: subscription,
+		Topic:   topic,
+	})
+	b.onClose(r.Close)
+
+	return NewSubscriber(r, b.subCfg), nil
+}
+
+func (b *Broker) onClose(f func() error) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.closers = append(b.closers, f)
+}
+
+func (b *Broker) Close() error {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	var err error
+	for _, f := range b.closers {
+		if cerr := f(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to close kafka clients: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/aviseu/jobs-protobuf/build/gen/go/commands/imports"
+	"github.com/aviseu/jobs-protobuf/build/gen/go/events/jobs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBroker(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(BrokerSuite))
+}
+
+type BrokerSuite struct {
+	testutils.KafkaSuite
+}
+
+func (suite *BrokerSuite) config() broker.SubscriberConfig {
+	return broker.SubscriberConfig{
+		MaxOutstandingMessages: 2,
+		MaxExtension:           time.Minute,
+		ShutdownTimeout:        100 * time.Millisecond,
+	}
+}
+
+func (suite *BrokerSuite) Test_PublishImportCommand_Success() {
+	// Prepare
+	ctx := context.Background()
+	b := suite.Broker(suite.config())
+	p, err := b.Publisher(ctx, "imports.success")
+	suite.NoError(err)
+	sub, err := b.Subscriber(ctx, "imports.success", "worker")
+	suite.NoError(err)
+	importID := uuid.New()
+
+	// Execute
+	err = broker.NewImportService(p).PublishImportCommand(ctx, importID)
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert message received by the subscription
+	var resp imports.ExecuteImportChannel
+	receiveCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	suite.NoError(sub.Receive(receiveCtx, func(_ context.Context, msg *broker.Message) error {
+		suite.NoError(proto.Unmarshal(msg.Data, &resp))
+		suite.NotEmpty(msg.ID)
+		cancel()
+		return nil
+	}))
+	suite.Equal(importID.String(), resp.ImportId)
+}
+
+func (suite *BrokerSuite) Test_PublishJobInformation_Attributes_Success() {
+	// Prepare
+	ctx := context.Background()
+	b := suite.Broker(suite.config())
+	p, err := b.Publisher(ctx, "jobs.attributes")
+	suite.NoError(err)
+	sub, err := b.Subscriber(ctx, "jobs.attributes", "consumer")
+	suite.NoError(err)
+	job := &aggregator.Job{ID: uuid.New(), ChannelID: uuid.New(), Title: "Engineer", Language: "de"}
+
+	// Execute
+	err = broker.NewJobService(p).PublishJobInformation(ctx, job)
+
+	// Assert
+	suite.NoError(err)
+	var resp jobs.JobInformation
+	var attrs map[string]string
+	receiveCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	suite.NoError(sub.Receive(receiveCtx, func(_ context.Context, msg *broker.Message) error {
+		suite.NoError(proto.Unmarshal(msg.Data, &resp))
+		attrs = msg.Attributes
+		cancel()
+		return nil
+	}))
+	suite.Equal(job.ID.String(), resp.Id)
+	suite.Equal("Engineer", resp.Title)
+	suite.Equal("de", attrs["language"])
+}
+
+func (suite *BrokerSuite) Test_Receive_HandlerFail() {
+	// Prepare
+	ctx := context.Background()
+	b := suite.Broker(suite.config())
+	p, err := b.Publisher(ctx, "imports.fail")
+	suite.NoError(err)
+	sub, err := b.Subscriber(ctx, "imports.fail", "worker")
+	suite.NoError(err)
+	suite.NoError(broker.NewImportService(p).PublishImportCommand(ctx, uuid.New()))
+
+	// Execute
+	receiveCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	err = sub.Receive(receiveCtx, func(_ context.Context, _ *broker.Message) error {
+		return errors.New("boom!")
+	})
+
+	// Assert the message is left uncommitted and receiving stops
+	suite.ErrorContains(err, "boom!")
+}
+
+func (suite *BrokerSuite) Test_Receive_Shutdown_Interrupts() {
+	// Prepare
+	ctx := context.Background()
+	b := suite.Broker(suite.config())
+	p, err := b.Publisher(ctx, "imports.shutdown")
+	suite.NoError(err)
+	sub, err := b.Subscriber(ctx, "imports.shutdown", "worker")
+	suite.NoError(err)
+	suite.NoError(broker.NewImportService(p).PublishImportCommand(ctx, uuid.New()))
+
+	// Execute
+	receiveCtx, cancel := context.WithCancel(ctx)
+	var interrupted atomic.Bool
+	err = sub.Receive(receiveCtx, func(hctx context.Context, _ *broker.Message) error {
+		cancel()
+		<-hctx.Done()
+		interrupted.Store(true)
+		return hctx.Err()
+	})
+
+	// Assert the handler is interrupted after the shutdown timeout
+	suite.NoError(err)
+	suite.True(interrupted.Load())
+}
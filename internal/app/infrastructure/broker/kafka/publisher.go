@@ -0,0 +1,32 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+type Publisher struct {
+	w *kafkago.Writer
+}
+
+func NewPublisher(w *kafkago.Writer) *Publisher {
+	return &Publisher{
+		w: w,
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, msg *broker.Message) error {
+	m := kafkago.Message{Value: msg.Data}
+	for k, v := range msg.Attributes {
+		m.Headers = append(m.Headers, kafkago.Header{Key: k, Value: []byte(v)})
+	}
+
+	if err := p.w.WriteMessages(ctx, m); err != nil {
+		return fmt.Errorf("failed to publish kafka message on %s: %w", p.w.Topic, err)
+	}
+
+	return nil
+}
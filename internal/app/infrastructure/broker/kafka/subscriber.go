@@ -0,0 +1,64 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+// Subscriber reads a consumer group one message at a time, so the offsets of a partition are committed in order.
+// Handling more messages at once takes more partitions and more replicas.
+type Subscriber struct {
+	r   *kafkago.Reader
+	cfg broker.SubscriberConfig
+}
+
+func NewSubscriber(r *kafkago.Reader, cfg broker.SubscriberConfig) *Subscriber {
+	return &Subscriber{
+		r:   r,
+		cfg: cfg,
+	}
+}
+
+// Receive handles messages until ctx is done. The message in flight then gets ShutdownTimeout to finish,
+// when it is interrupted its offset is not committed, so the group reads it again.
+func (s *Subscriber) Receive(ctx context.Context, h broker.Handler) error {
+	hctx, release := broker.HandlerContext(ctx, s.cfg.ShutdownTimeout)
+	defer release()
+
+	for {
+		m, err := s.r.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return nil
+			}
+
+			return fmt.Errorf("failed to fetch kafka message: %w", err)
+		}
+
+		msg := &broker.Message{
+			ID:         fmt.Sprintf("%s/%d/%d", m.Topic, m.Partition, m.Offset),
+			Data:       m.Value,
+			Attributes: make(map[string]string, len(m.Headers)),
+		}
+		for _, hd := range m.Headers {
+			msg.Attributes[hd.Key] = string(hd.Value)
+		}
+
+		// kafka can not nack a single message, not committing it makes the group read it again after a restart
+		if err := h(hctx, msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to handle kafka message %s: %w", msg.ID, err)
+		}
+
+		if err := s.r.CommitMessages(context.WithoutCancel(ctx), m); err != nil {
+			return fmt.Errorf("failed to commit kafka message %s: %w", msg.ID, err)
+		}
+	}
+}
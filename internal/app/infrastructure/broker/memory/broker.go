@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+)
+
+// Broker keeps messages in memory, it is meant for tests and running everything in a single process.
+// Messages published to a topic without subscriptions are dropped, as with pubsub.
+type Broker struct {
+	subs   map[string]map[string]*queue
+	cfg    broker.SubscriberConfig
+	nextID int
+	m      sync.Mutex
+}
+
+func NewBroker(cfg broker.SubscriberConfig) *Broker {
+	return &Broker{
+		subs: make(map[string]map[string]*queue),
+		cfg:  cfg,
+	}
+}
+
+func (b *Broker) Publisher(_ context.Context, topic string) (broker.Publisher, error) {
+	return &Publisher{b: b, topic: topic}, nil
+}
+
+func (b *Broker) Subscriber(_ context.Context, topic, subscription string) (broker.Subscriber, error) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if b.subs[topic] == nil {
+		b.subs[topic] = make(map[string]*queue)
+	}
+	q, ok := b.subs[topic][subscription]
+	if !ok {
+		q = newQueue()
+		b.subs[topic][subscription] = q
+	}
+
+	return &Subscriber{q: q, cfg: b.cfg}, nil
+}
+
+func (b *Broker) Close() error {
+	return nil
+}
+
+func (b *Broker) publish(topic string, msg *broker.Message) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	b.nextID++
+	for _, q := range b.subs[topic] {
+		c := *msg
+		c.ID = strconv.Itoa(b.nextID)
+		q.push(&c)
+	}
+}
+
+type Publisher struct {
+	b     *Broker
+	topic string
+}
+
+func (p *Publisher) Publish(_ context.Context, msg *broker.Message) error {
+	p.b.publish(p.topic, msg)
+	return nil
+}
+
+type Subscriber struct {
+	q   *queue
+	cfg broker.SubscriberConfig
+}
+
+// Receive handles messages until ctx is done, at most MaxOutstandingMessages at once.
+// Messages a handler fails on are put back at the end of the queue.
+func (s *Subscriber) Receive(ctx context.Context, h broker.Handler) error {
+	hctx, release := broker.HandlerContext(ctx, s.cfg.ShutdownTimeout)
+	defer release()
+
+	sem := make(chan struct{}, max(s.cfg.MaxOutstandingMessages, 1))
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case sem <- struct{}{}:
+		}
+
+		msg, ok := s.q.pop(ctx)
+		if !ok {
+			return nil
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := h(hctx, msg); err != nil {
+				s.q.push(msg)
+			}
+		}()
+	}
+}
+
+type queue struct {
+	msgs   []*broker.Message
+	notify chan struct{}
+	m      sync.Mutex
+}
+
+func newQueue() *queue {
+	return &queue{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+func (q *queue) push(msg *broker.Message) {
+	q.m.Lock()
+	q.msgs = append(q.msgs, msg)
+	q.m.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (q *queue) pop(ctx context.Context) (*broker.Message, bool) {
+	for {
+		q.m.Lock()
+		if len(q.msgs) > 0 {
+			msg := q.msgs[0]
+			q.msgs = q.msgs[1:]
+			q.m.Unlock()
+			return msg, true
+		}
+		q.m.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-q.notify:
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/memory"
+	"github.com/aviseu/jobs-protobuf/build/gen/go/commands/imports"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBroker(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BrokerSuite))
+}
+
+type BrokerSuite struct {
+	suite.Suite
+}
+
+func (suite *BrokerSuite) config() broker.SubscriberConfig {
+	return broker.SubscriberConfig{
+		MaxOutstandingMessages: 2,
+		ShutdownTimeout:        10 * time.Millisecond,
+	}
+}
+
+func (suite *BrokerSuite) Test_PublishImportCommand_Success() {
+	// Prepare
+	ctx := context.Background()
+	b := memory.NewBroker(suite.config())
+	sub, err := b.Subscriber(ctx, "imports", "worker")
+	suite.NoError(err)
+	p, err := b.Publisher(ctx, "imports")
+	suite.NoError(err)
+	importID := uuid.New()
+
+	// Execute
+	err = broker.NewImportService(p).PublishImportCommand(ctx, importID)
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert message received by the subscription
+	var resp imports.ExecuteImportChannel
+	receiveCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	suite.NoError(sub.Receive(receiveCtx, func(_ context.Context, msg *broker.Message) error {
+		suite.NoError(proto.Unmarshal(msg.Data, &resp))
+		suite.Equal("1", msg.ID)
+		cancel()
+		return nil
+	}))
+	suite.Equal(importID.String(), resp.ImportId)
+}
+
+func (suite *BrokerSuite) Test_Publish_FanOut_Success() {
+	// Prepare
+	ctx := context.Background()
+	b := memory.NewBroker(suite.config())
+	first, err := b.Subscriber(ctx, "jobs", "first")
+	suite.NoError(err)
+	second, err := b.Subscriber(ctx, "jobs", "second")
+	suite.NoError(err)
+	p, err := b.Publisher(ctx, "jobs")
+	suite.NoError(err)
+
+	// Execute
+	err = p.Publish(ctx, &broker.Message{Data: []byte("job"), Attributes: map[string]string{"language": "de"}})
+
+	// Assert every subscription receives the message
+	suite.NoError(err)
+	for _, sub := range []broker.Subscriber{first, second} {
+		var received *broker.Message
+		receiveCtx, cancel := context.WithTimeout(ctx, time.Second)
+		suite.NoError(sub.Receive(receiveCtx, func(_ context.Context, msg *broker.Message) error {
+			received = msg
+			cancel()
+			return nil
+		}))
+		cancel()
+		suite.Equal("job", string(received.Data))
+		suite.Equal("de", received.Attributes["language"])
+	}
+}
+
+func (suite *BrokerSuite) Test_Publish_NoSubscription_Drops() {
+	// Prepare
+	ctx := context.Background()
+	b := memory.NewBroker(suite.config())
+	p, err := b.Publisher(ctx, "imports")
+	suite.NoError(err)
+	suite.NoError(p.Publish(ctx, &broker.Message{Data: []byte("dropped")}))
+	sub, err := b.Subscriber(ctx, "imports", "worker")
+	suite.NoError(err)
+
+	// Execute
+	var deliveries atomic.Int32
+	receiveCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	err = sub.Receive(receiveCtx, func(_ context.Context, _ *broker.Message) error {
+		deliveries.Add(1)
+		return nil
+	})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(int32(0), deliveries.Load())
+}
+
+func (suite *BrokerSuite) Test_Receive_Nack_Redelivers() {
+	// Prepare
+	ctx := context.Background()
+	b := memory.NewBroker(suite.config())
+	sub, err := b.Subscriber(ctx, "imports", "worker")
+	suite.NoError(err)
+	p, err := b.Publisher(ctx, "imports")
+	suite.NoError(err)
+	suite.NoError(p.Publish(ctx, &broker.Message{Data: []byte("retry")}))
+
+	// Execute
+	var deliveries atomic.Int32
+	receiveCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	err = sub.Receive(receiveCtx, func(_ context.Context, _ *broker.Message) error {
+		if deliveries.Add(1) == 1 {
+			return errors.New("boom!")
+		}
+		cancel()
+		return nil
+	})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(int32(2), deliveries.Load())
+}
+
+func (suite *BrokerSuite) Test_Receive_Shutdown_Requeues() {
+	// Prepare
+	ctx := context.Background()
+	b := memory.NewBroker(suite.config())
+	sub, err := b.Subscriber(ctx, "imports", "worker")
+	suite.NoError(err)
+	p, err := b.Publisher(ctx, "imports")
+	suite.NoError(err)
+	suite.NoError(p.Publish(ctx, &broker.Message{Data: []byte("interrupted")}))
+
+	// Execute
+	receiveCtx, cancel := context.WithCancel(ctx)
+	err = sub.Receive(receiveCtx, func(hctx context.Context, _ *broker.Message) error {
+		cancel()
+		<-hctx.Done()
+		return hctx.Err()
+	})
+
+	// Assert the interrupted message is received again by the next receiver
+	suite.NoError(err)
+	var received *broker.Message
+	receiveCtx, cancel = context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	suite.NoError(sub.Receive(receiveCtx, func(_ context.Context, msg *broker.Message) error {
+		received = msg
+		cancel()
+		return nil
+	}))
+	suite.Equal("interrupted", string(received.Data))
+}
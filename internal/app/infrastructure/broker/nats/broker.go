@@ -0,0 +1,96 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	gonats "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type Config struct {
+	URL        string        `env:"URL" envDefault:"nats://localhost:4222"`
+	AckWait    time.Duration `env:"ACK_WAIT" envDefault:"30s"`
+	MaxDeliver int           `env:"MAX_DELIVER" envDefault:"5"`
+}
+
+var streamNames = strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_", "/", "_", "\\", "_")
+
+// Broker maps every topic on a JetStream stream of the same name, a subscription is a durable consumer of that stream
+type Broker struct {
+	nc     *gonats.Conn
+	js     jetstream.JetStream
+	cfg    Config
+	subCfg broker.SubscriberConfig
+}
+
+func Connect(cfg Config, subCfg broker.SubscriberConfig) (*Broker, error) {
+	nc, err := gonats.Connect(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats at %s: %w", cfg.URL, err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	return &Broker{
+		nc:     nc,
+		js:     js,
+		cfg:    cfg,
+		subCfg: subCfg,
+	}, nil
+}
+
+func (b *Broker) stream(ctx context.Context, topic string) (jetstream.Stream, error) {
+	s, err := b.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     streamNames.Replace(topic),
+		Subjects: []string{topic},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stream for topic %s: %w", topic, err)
+	}
+
+	return s, nil
+}
+
+func (b *Broker) Publisher(ctx context.Context, topic string) (broker.Publisher, error) {
+	if _, err := b.stream(ctx, topic); err != nil {
+		return nil, err
+	}
+
+	return NewPublisher(b.js, topic), nil
+}
+
+func (b *Broker) Subscriber(ctx context.Context, topic, subscription string) (broker.Subscriber, error) {
+	s, err := b.stream(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:       streamNames.Replace(subscription),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       b.cfg.AckWait,
+		MaxDeliver:    b.cfg.MaxDeliver,
+		MaxAckPending: max(b.subCfg.MaxOutstandingMessages, 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer %s for topic %s: %w", subscription, topic, err)
+	}
+
+	return NewSubscriber(c, b.subCfg, b.cfg.AckWait), nil
+}
+
+func (b *Broker) Close() error {
+	if err := b.nc.Drain(); err != nil {
+		return fmt.Errorf("failed to drain nats connection: %w", err)
+	}
+
+	return nil
+}
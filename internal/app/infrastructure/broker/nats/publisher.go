@@ -0,0 +1,36 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	gonats "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type Publisher struct {
+	js      jetstream.JetStream
+	subject string
+}
+
+func NewPublisher(js jetstream.JetStream, subject string) *Publisher {
+	return &Publisher{
+		js:      js,
+		subject: subject,
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, msg *broker.Message) error {
+	m := gonats.NewMsg(p.subject)
+	m.Data = msg.Data
+	for k, v := range msg.Attributes {
+		m.Header.Set(k, v)
+	}
+
+	if _, err := p.js.PublishMsg(ctx, m); err != nil {
+		return fmt.Errorf("failed to publish nats message on %s: %w", p.subject, err)
+	}
+
+	return nil
+}
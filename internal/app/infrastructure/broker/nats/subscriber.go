@@ -0,0 +1,100 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Subscriber consumes a durable consumer. While a message is handled it is marked in progress every half
+// ack wait, so JetStream does not redeliver it, up to MaxExtension.
+type Subscriber struct {
+	c       jetstream.Consumer
+	cfg     broker.SubscriberConfig
+	ackWait time.Duration
+}
+
+func NewSubscriber(c jetstream.Consumer, cfg broker.SubscriberConfig, ackWait time.Duration) *Subscriber {
+	return &Subscriber{
+		c:       c,
+		cfg:     cfg,
+		ackWait: ackWait,
+	}
+}
+
+// Receive handles messages until ctx is done. It then stops consuming and gives messages in flight
+// ShutdownTimeout to finish, the ones still running after that are interrupted and nacked.
+func (s *Subscriber) Receive(ctx context.Context, h broker.Handler) error {
+	hctx, release := broker.HandlerContext(ctx, s.cfg.ShutdownTimeout)
+	defer release()
+
+	sem := make(chan struct{}, max(s.cfg.MaxOutstandingMessages, 1))
+	var wg sync.WaitGroup
+
+	cc, err := s.c.Consume(func(msg jetstream.Msg) {
+		select {
+		case <-ctx.Done():
+			_ = msg.Nak()
+			return
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			s.handle(hctx, msg, h)
+		}()
+	}, jetstream.PullMaxMessages(max(s.cfg.MaxOutstandingMessages, 1)))
+	if err != nil {
+		return fmt.Errorf("failed to consume: %w", err)
+	}
+
+	<-ctx.Done()
+	cc.Stop()
+	wg.Wait()
+
+	return nil
+}
+
+func (s *Subscriber) handle(ctx context.Context, msg jetstream.Msg, h broker.Handler) {
+	done := make(chan struct{})
+	defer close(done)
+	go s.extend(msg, done)
+
+	m := &broker.Message{Data: msg.Data(), Attributes: make(map[string]string, len(msg.Headers()))}
+	if meta, err := msg.Metadata(); err == nil {
+		m.ID = strconv.FormatUint(meta.Sequence.Stream, 10)
+	}
+	for k := range msg.Headers() {
+		m.Attributes[k] = msg.Headers().Get(k)
+	}
+
+	if err := h(ctx, m); err != nil {
+		_ = msg.Nak()
+		return
+	}
+	_ = msg.Ack()
+}
+
+func (s *Subscriber) extend(msg jetstream.Msg, done <-chan struct{}) {
+	ticker := time.NewTicker(s.ackWait / 2)
+	defer ticker.Stop()
+	deadline := time.After(s.cfg.MaxExtension)
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-deadline:
+			return
+		case <-ticker.C:
+			_ = msg.InProgress()
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type Config struct {
+	File string `env:"FILE"`
+}
+
+type entry struct {
+	Topic      string            `json:"topic"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Type       string            `json:"type,omitempty"`
+	Event      json.RawMessage   `json:"event,omitempty"`
+	Data       []byte            `json:"data,omitempty"`
+}
+
+// Publisher writes every message as a line of JSON instead of sending it anywhere,
+// so events can be followed locally without a broker. The event is the protobuf message written as JSON,
+// messages of an unknown type keep the raw protobuf message, base64 encoded, as data.
+type Publisher struct {
+	w     io.Writer
+	topic string
+	m     sync.Mutex
+}
+
+func NewPublisher(w io.Writer, topic string) *Publisher {
+	return &Publisher{w: w, topic: topic}
+}
+
+func (p *Publisher) Publish(_ context.Context, msg *broker.Message) error {
+	e := entry{Topic: p.topic, Attributes: msg.Attributes, Type: msg.Type, Data: msg.Data}
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(msg.Type)); err == nil {
+		event, err := encode(mt, msg.Data)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s for topic %s: %w", msg.Type, p.topic, err)
+		}
+		e.Event, e.Data = event, nil
+	}
+
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode message for topic %s: %w", p.topic, err)
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if _, err := p.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write message for topic %s: %w", p.topic, err)
+	}
+
+	return nil
+}
+
+// encode writes the protobuf message as JSON
+func encode(mt protoreflect.MessageType, data []byte) (json.RawMessage, error) {
+	m := mt.New().Interface()
+	if err := proto.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal message: %w", err)
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,138 @@
+package sink_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/sink"
+	"github.com/aviseu/jobs-protobuf/build/gen/go/events/jobs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPublisher(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(PublisherSuite))
+}
+
+type PublisherSuite struct {
+	suite.Suite
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("boom!")
+}
+
+func (suite *PublisherSuite) Test_PublishJobMissing_Success() {
+	// Prepare
+	var buf bytes.Buffer
+	s := broker.NewJobService(sink.NewPublisher(&buf, "jobs"))
+	id := uuid.New()
+
+	// Execute
+	err := s.PublishJobMissing(context.Background(), &aggregator.Job{ID: id})
+	suite.NoError(err)
+	err = s.PublishJobMissing(context.Background(), &aggregator.Job{ID: id})
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert one line per message
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	suite.Len(lines, 2)
+	var line struct {
+		Topic string          `json:"topic"`
+		Type  string          `json:"type"`
+		Event json.RawMessage `json:"event"`
+	}
+	suite.NoError(json.Unmarshal([]byte(lines[0]), &line))
+	suite.Equal("jobs", line.Topic)
+	suite.Equal(string(proto.MessageName(&jobs.JobMissing{})), line.Type)
+	var msg jobs.JobMissing
+	suite.NoError(protojson.Unmarshal(line.Event, &msg))
+	suite.Equal(id.String(), msg.Id)
+}
+
+func (suite *PublisherSuite) Test_PublishJobBlocked_Success() {
+	// Prepare
+	var buf bytes.Buffer
+	s := broker.NewJobService(sink.NewPublisher(&buf, "jobs"))
+	id := uuid.New()
+
+	// Execute
+	err := s.PublishJobBlocked(context.Background(), &aggregator.Job{ID: id})
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert blocked jobs go out as missing with a reason
+	var line struct {
+		Attributes map[string]string `json:"attributes"`
+		Event      json.RawMessage   `json:"event"`
+	}
+	suite.NoError(json.Unmarshal(buf.Bytes(), &line))
+	suite.Equal(map[string]string{"reason": "blocked"}, line.Attributes)
+	var msg jobs.JobMissing
+	suite.NoError(protojson.Unmarshal(line.Event, &msg))
+	suite.Equal(id.String(), msg.Id)
+}
+
+func (suite *PublisherSuite) Test_Publish_UnknownType_WritesData() {
+	// Prepare
+	var buf bytes.Buffer
+	p := sink.NewPublisher(&buf, "jobs")
+
+	// Execute
+	err := p.Publish(context.Background(), &broker.Message{Type: "jobs.Unknown", Data: []byte("job")})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(`{"topic":"jobs","type":"jobs.Unknown","data":"am9i"}`+"\n", buf.String())
+}
+
+func (suite *PublisherSuite) Test_Publish_BadData_Fail() {
+	// Prepare
+	var buf bytes.Buffer
+	p := sink.NewPublisher(&buf, "jobs")
+
+	// Execute
+	err := p.Publish(context.Background(), &broker.Message{Type: string(proto.MessageName(&jobs.JobMissing{})), Data: []byte("job")})
+
+	// Assert
+	suite.ErrorContains(err, "failed to encode")
+	suite.Empty(buf.String())
+}
+
+func (suite *PublisherSuite) Test_Publish_Attributes_Success() {
+	// Prepare
+	var buf bytes.Buffer
+	p := sink.NewPublisher(&buf, "jobs")
+
+	// Execute
+	err := p.Publish(context.Background(), &broker.Message{Data: []byte("job"), Attributes: map[string]string{"language": "de"}})
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(`{"topic":"jobs","attributes":{"language":"de"},"data":"am9i"}`+"\n", buf.String())
+}
+
+func (suite *PublisherSuite) Test_Publish_Fail() {
+	// Prepare
+	p := sink.NewPublisher(failingWriter{}, "jobs")
+
+	// Execute
+	err := p.Publish(context.Background(), &broker.Message{Data: []byte("job")})
+
+	// Assert
+	suite.ErrorContains(err, "failed to write message for topic jobs: boom!")
+}
@@ -3,6 +3,15 @@ package infrastructure
 import "errors"
 
 var (
-	ErrChannelNotFound = errors.New("channel not found")
-	ErrImportNotFound  = errors.New("import not found")
+	ErrChannelNotFound          = errors.New("channel not found")
+	ErrImportNotFound           = errors.New("import not found")
+	ErrImportCheckpointNotFound = errors.New("import checkpoint not found")
+	ErrBlobNotFound             = errors.New("blob not found")
+	ErrJobNotFound              = errors.New("job not found")
+	ErrBlocklistEntryNotFound   = errors.New("blocklist entry not found")
+	ErrLeaseNotFound            = errors.New("lease not found")
+	ErrLeaseHeld                = errors.New("lease is held by another holder")
+	ErrStaleFence               = errors.New("fencing token is stale")
+	ErrScheduleRunNotFound      = errors.New("schedule run not found")
+	ErrQueueEmpty               = errors.New("no claimable import in queue")
 )
@@ -0,0 +1,43 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	cpubsub "cloud.google.com/go/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/kafka"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/memory"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/nats"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/pubsub"
+)
+
+type Config struct {
+	Kind               string                  `env:"KIND" envDefault:"pubsub"`
+	ImportTopic        string                  `env:"IMPORT_TOPIC" envDefault:"imports"`
+	JobTopic           string                  `env:"JOB_TOPIC" envDefault:"jobs"`
+	ImportSubscription string                  `env:"IMPORT_SUBSCRIPTION" envDefault:"imports-worker"`
+	Subscriber         broker.SubscriberConfig `envPrefix:"SUBSCRIBER_"`
+	PubSub             pubsub.Config           `envPrefix:"PUBSUB_"`
+	NATS               nats.Config             `envPrefix:"NATS_"`
+	Kafka              kafka.Config            `envPrefix:"KAFKA_"`
+}
+
+func SetupBroker(ctx context.Context, cfg Config) (broker.Broker, error) {
+	switch cfg.Kind {
+	case broker.KindPubSub:
+		client, err := cpubsub.NewClient(ctx, cfg.PubSub.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build pubsub client for project %s: %w", cfg.PubSub.ProjectID, err)
+		}
+		return pubsub.NewBroker(client, cfg.PubSub, cfg.Subscriber), nil
+	case broker.KindNATS:
+		return nats.Connect(cfg.NATS, cfg.Subscriber)
+	case broker.KindKafka:
+		return kafka.NewBroker(cfg.Kafka, cfg.Subscriber), nil
+	case broker.KindMemory:
+		return memory.NewBroker(cfg.Subscriber), nil
+	default:
+		return nil, fmt.Errorf("unknown broker kind %s", cfg.Kind)
+	}
+}
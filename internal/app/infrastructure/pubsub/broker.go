@@ -0,0 +1,35 @@
+package pubsub
+
+import (
+	"context"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+)
+
+// Broker maps topics and subscriptions on the ids of existing pubsub topics and subscriptions
+type Broker struct {
+	client *pubsub.Client
+	cfg    Config
+	subCfg broker.SubscriberConfig
+}
+
+func NewBroker(client *pubsub.Client, cfg Config, subCfg broker.SubscriberConfig) *Broker {
+	return &Broker{
+		client: client,
+		cfg:    cfg,
+		subCfg: subCfg,
+	}
+}
+
+func (b *Broker) Publisher(_ context.Context, topic string) (broker.Publisher, error) {
+	return NewPublisher(b.client.Topic(topic), b.cfg), nil
+}
+
+func (b *Broker) Subscriber(_ context.Context, _, subscription string) (broker.Subscriber, error) {
+	return NewSubscriber(b.client.Subscription(subscription), b.subCfg), nil
+}
+
+func (b *Broker) Close() error {
+	return b.client.Close()
+}
@@ -3,5 +3,6 @@ package pubsub
 import "time"
 
 type Config struct {
-	Timeout time.Duration `env:"TIMEOUT" envDefault:"1s"`
+	ProjectID string        `env:"PROJECT_ID"`
+	Timeout   time.Duration `env:"TIMEOUT" envDefault:"1s"`
 }
@@ -4,6 +4,7 @@ import (
 	gpubsub "cloud.google.com/go/pubsub"
 	"context"
 	"fmt"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/pubsub"
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
 	"github.com/aviseu/jobs-protobuf/build/gen/go/commands/imports"
@@ -30,7 +31,7 @@ type ImportServiceSuite struct {
 func (suite *ImportServiceSuite) Test_PublishImport_Success() {
 	// Prepare
 	ctx := context.Background()
-	s := pubsub.NewImportService(suite.ImportTopic, pubsub.Config{Timeout: 1 * time.Second})
+	s := broker.NewImportService(pubsub.NewPublisher(suite.ImportTopic, pubsub.Config{Timeout: 1 * time.Second}))
 	importID := uuid.New()
 
 	var resp imports.ExecuteImportChannel
@@ -63,7 +64,7 @@ func (suite *ImportServiceSuite) Test_PublishImport_Success() {
 func (suite *ImportServiceSuite) Test_PublishImport_ConnectionFailed() {
 	// Prepare
 	ctx := context.Background()
-	s := pubsub.NewImportService(suite.BadImportTopic, pubsub.Config{Timeout: 1 * time.Second})
+	s := broker.NewImportService(pubsub.NewPublisher(suite.BadImportTopic, pubsub.Config{Timeout: 1 * time.Second}))
 	importID := uuid.New()
 
 	// Execute
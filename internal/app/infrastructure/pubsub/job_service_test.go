@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/pubsub"
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
 	"github.com/aviseu/jobs-protobuf/build/gen/go/events/jobs"
@@ -31,9 +32,10 @@ type JobServiceSuite struct {
 func (suite *JobServiceSuite) Test_PublishJobInformation_Success() {
 	// Prepare
 	ctx := context.Background()
-	s := pubsub.NewJobService(suite.JobTopic, pubsub.Config{Timeout: 1 * time.Second})
+	s := broker.NewJobService(pubsub.NewPublisher(suite.JobTopic, pubsub.Config{Timeout: 1 * time.Second}))
 
 	var resp jobs.JobInformation
+	var attrs map[string]string
 	subCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -42,6 +44,7 @@ func (suite *JobServiceSuite) Test_PublishJobInformation_Success() {
 			if err := proto.Unmarshal(msg.Data, &resp); err != nil {
 				suite.Fail(fmt.Errorf("failed to unmarshal message: %w", err).Error())
 			}
+			attrs = msg.Attributes
 			msg.Ack()
 			cancel()
 		})
@@ -59,6 +62,17 @@ func (suite *JobServiceSuite) Test_PublishJobInformation_Success() {
 		Location:    "Test Location",
 		PostedAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
 		Remote:      true,
+		Enrichments: aggregator.Enrichments{"seniority": "senior"},
+		Salary:      &aggregator.Salary{Currency: "EUR", Min: 3500, Max: 4000, AnnualMin: 42000, AnnualMax: 48000, Period: aggregator.SalaryPeriodMonth},
+		Language:    "en",
+		Quality:     &aggregator.Quality{Score: 85, Signals: []*aggregator.QualitySignal{{Name: "description_length", Penalty: 15, Reason: "description has only 300 characters"}}},
+		Skills:      aggregator.Skills{{Name: "Go", Count: 2}, {Name: "Kubernetes", Count: 1}},
+		Classification: aggregator.Classification{
+			Seniority:                aggregator.SenioritySenior,
+			SeniorityConfidence:      0.9,
+			EmploymentType:           aggregator.EmploymentTypeFullTime,
+			EmploymentTypeConfidence: 0.95,
+		},
 	}
 
 	// Execute
@@ -78,12 +92,29 @@ func (suite *JobServiceSuite) Test_PublishJobInformation_Success() {
 	suite.Equal("Test Location", resp.Location)
 	suite.True(resp.PostedAt.AsTime().Equal(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)))
 	suite.Equal(job.Remote, resp.Remote)
+	suite.Equal(map[string]string{
+		"enrichment.seniority":       "senior",
+		"salary.currency":            "EUR",
+		"salary.period":              "month",
+		"salary.type":                "gross",
+		"salary.min":                 "3500",
+		"salary.max":                 "4000",
+		"salary.annual_min":          "42000",
+		"salary.annual_max":          "48000",
+		"language":                   "en",
+		"quality.score":              "85",
+		"skills":                     "Go,Kubernetes",
+		"seniority":                  "senior",
+		"seniority.confidence":       "0.9",
+		"employment_type":            "full_time",
+		"employment_type.confidence": "0.95",
+	}, attrs)
 }
 
 func (suite *JobServiceSuite) Test_PublishJobInformation_ConnectionFailed() {
 	// Prepare
 	ctx := context.Background()
-	s := pubsub.NewJobService(suite.BadJobTopic, pubsub.Config{Timeout: 1 * time.Second})
+	s := broker.NewJobService(pubsub.NewPublisher(suite.BadJobTopic, pubsub.Config{Timeout: 1 * time.Second}))
 
 	job := &aggregator.Job{
 		ID:          uuid.New(),
@@ -108,7 +139,7 @@ func (suite *JobServiceSuite) Test_PublishJobInformation_ConnectionFailed() {
 func (suite *JobServiceSuite) Test_PublishJobMissing_Success() {
 	// Prepare
 	ctx := context.Background()
-	s := pubsub.NewJobService(suite.JobTopic, pubsub.Config{Timeout: 1 * time.Second})
+	s := broker.NewJobService(pubsub.NewPublisher(suite.JobTopic, pubsub.Config{Timeout: 1 * time.Second}))
 
 	var resp jobs.JobMissing
 	subCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
@@ -152,7 +183,7 @@ func (suite *JobServiceSuite) Test_PublishJobMissing_Success() {
 func (suite *JobServiceSuite) Test_PublishJobMissing_ConnectionFailed() {
 	// Prepare
 	ctx := context.Background()
-	s := pubsub.NewJobService(suite.BadJobTopic, pubsub.Config{Timeout: 1 * time.Second})
+	s := broker.NewJobService(pubsub.NewPublisher(suite.BadJobTopic, pubsub.Config{Timeout: 1 * time.Second}))
 
 	job := &aggregator.Job{
 		ID:          uuid.New(),
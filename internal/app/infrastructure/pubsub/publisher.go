@@ -0,0 +1,38 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+)
+
+type Publisher struct {
+	topic *pubsub.Topic
+	cfg   Config
+}
+
+func NewPublisher(topic *pubsub.Topic, cfg Config) *Publisher {
+	return &Publisher{
+		topic: topic,
+		cfg:   cfg,
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, msg *broker.Message) error {
+	result := p.topic.Publish(ctx, &pubsub.Message{
+		Data:       msg.Data,
+		Attributes: msg.Attributes,
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	_, err := result.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to publish pubsub message: %w", err)
+	}
+
+	return nil
+}
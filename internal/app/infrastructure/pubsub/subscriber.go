@@ -0,0 +1,47 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+)
+
+// Subscriber pulls messages from a subscription. Flow control caps how many messages are handled at once,
+// and the ack deadline of a message is extended for as long as it is handled, up to MaxExtension.
+type Subscriber struct {
+	sub *pubsub.Subscription
+	cfg broker.SubscriberConfig
+}
+
+func NewSubscriber(sub *pubsub.Subscription, cfg broker.SubscriberConfig) *Subscriber {
+	sub.ReceiveSettings.MaxOutstandingMessages = cfg.MaxOutstandingMessages
+	sub.ReceiveSettings.MaxExtension = cfg.MaxExtension
+	sub.ReceiveSettings.MaxExtensionPeriod = cfg.MaxExtensionPeriod
+
+	return &Subscriber{
+		sub: sub,
+		cfg: cfg,
+	}
+}
+
+// Receive handles messages until ctx is done. It then stops pulling and gives messages in flight
+// ShutdownTimeout to finish, the ones still running after that are interrupted and nacked.
+func (s *Subscriber) Receive(ctx context.Context, h broker.Handler) error {
+	hctx, release := broker.HandlerContext(ctx, s.cfg.ShutdownTimeout)
+	defer release()
+
+	err := s.sub.Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		if err := h(hctx, &broker.Message{ID: msg.ID, Data: msg.Data, Attributes: msg.Attributes}); err != nil {
+			msg.Nack()
+			return
+		}
+		msg.Ack()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to receive from subscription %s: %w", s.sub.ID(), err)
+	}
+
+	return nil
+}
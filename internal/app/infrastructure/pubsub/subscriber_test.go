@@ -0,0 +1,87 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpubsub "cloud.google.com/go/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/pubsub"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSubscriber(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(SubscriberSuite))
+}
+
+type SubscriberSuite struct {
+	testutils.PubSubSuite
+}
+
+func (suite *SubscriberSuite) config() broker.SubscriberConfig {
+	return broker.SubscriberConfig{
+		MaxOutstandingMessages: 1,
+		MaxExtension:           time.Minute,
+		MaxExtensionPeriod:     10 * time.Second,
+		ShutdownTimeout:        100 * time.Millisecond,
+	}
+}
+
+func (suite *SubscriberSuite) Test_Receive_Success() {
+	// Prepare
+	ctx := context.Background()
+	chID := uuid.New()
+	iID := uuid.New()
+	dsl := testutils.NewDSL(
+		testutils.WithArbeitnowEnabled(),
+		testutils.WithChannel(
+			testutils.WithChannelID(chID),
+			testutils.WithChannelIntegration(aggregator.IntegrationArbeitnow),
+		),
+		testutils.WithImport(
+			testutils.WithImportID(iID),
+			testutils.WithImportChannelID(chID),
+		),
+	)
+	s := pubsub.NewSubscriber(suite.ImportSubscription, suite.config())
+	suite.NoError(broker.NewImportService(pubsub.NewPublisher(suite.ImportTopic, pubsub.Config{Timeout: time.Second})).PublishImportCommand(ctx, iID))
+
+	// Execute
+	receiveCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	err := s.Receive(receiveCtx, broker.NewImportHandler(dsl.ImportService, dsl.Logger))
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(aggregator.ImportStatusCompleted, dsl.FirstImport().Status)
+	suite.Contains(dsl.LogLines()[0], "processing import "+iID.String())
+}
+
+func (suite *SubscriberSuite) Test_Receive_InvalidMessage_Acks() {
+	// Prepare
+	ctx := context.Background()
+	dsl := testutils.NewDSL()
+	s := pubsub.NewSubscriber(suite.ImportSubscription, suite.config())
+	_, err := suite.ImportTopic.Publish(ctx, &gpubsub.Message{Data: []byte("invalid")}).Get(ctx)
+	suite.NoError(err)
+
+	// Execute
+	receiveCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	err = s.Receive(receiveCtx, broker.NewImportHandler(dsl.ImportService, dsl.Logger))
+
+	// Assert the message is skipped once instead of being redelivered
+	suite.NoError(err)
+	lines := dsl.LogLines()
+	suite.Len(lines, 1)
+	suite.Contains(lines[0], "failed to unmarshal message")
+	suite.Contains(lines[0], `"level":"ERROR"`)
+}
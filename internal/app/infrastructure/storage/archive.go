@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	gstorage "cloud.google.com/go/storage"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/filesystem"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/gcs"
+)
+
+const (
+	ArchiveKindFilesystem = "filesystem"
+	ArchiveKindGCS        = "gcs"
+)
+
+// ArchiveConfig picks where archived pages are kept. A directory is local to the instance,
+// services on more than one instance share a bucket instead.
+type ArchiveConfig struct {
+	Kind       string `env:"KIND" envDefault:"filesystem"`
+	Filesystem filesystem.Config
+	GCS        gcs.Config `envPrefix:"GCS_"`
+}
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	List(ctx context.Context, prefix string) ([]*aggregator.Blob, error)
+	Delete(ctx context.Context, key string) error
+	Close() error
+}
+
+func SetupBlobStore(ctx context.Context, cfg ArchiveConfig) (BlobStore, error) {
+	switch cfg.Kind {
+	case ArchiveKindFilesystem:
+		return filesystem.NewBlobStore(cfg.Filesystem), nil
+	case ArchiveKindGCS:
+		if cfg.GCS.Bucket == "" {
+			return nil, fmt.Errorf("failed to setup %s archive: bucket is required", cfg.Kind)
+		}
+		client, err := gstorage.NewClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build storage client: %w", err)
+		}
+		return gcs.NewBlobStore(client, cfg.GCS), nil
+	default:
+		return nil, fmt.Errorf("unknown archive kind %s", cfg.Kind)
+	}
+}
@@ -0,0 +1,146 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type BlobStore struct {
+	dir string
+}
+
+func NewBlobStore(cfg Config) *BlobStore {
+	return &BlobStore{dir: filepath.Clean(cfg.Dir)}
+}
+
+func (s *BlobStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for blob %s: %w", key, err)
+	}
+
+	// Write to a temporary file first so readers never see a partial blob
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for blob %s: %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close blob %s: %w", key, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to move blob %s into place: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *BlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read blob %s: %w", key, infrastructure.ErrBlobNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to read blob %s: %w", key, err)
+	}
+
+	return data, nil
+}
+
+func (s *BlobStore) List(_ context.Context, prefix string) ([]*aggregator.Blob, error) {
+	root, err := s.path(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := make([]*aggregator.Blob, 0)
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".blob-") {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+
+		blobs = append(blobs, &aggregator.Blob{Key: filepath.ToSlash(rel), ModifiedAt: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blobs with prefix %s: %w", prefix, err)
+	}
+
+	slices.SortFunc(blobs, func(a, b *aggregator.Blob) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return blobs, nil
+}
+
+func (s *BlobStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to delete blob %s: %w", key, infrastructure.ErrBlobNotFound)
+		}
+
+		return fmt.Errorf("failed to delete blob %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Close has nothing to release, blobs are written through to the directory
+func (s *BlobStore) Close() error {
+	return nil
+}
+
+func (s *BlobStore) path(key string) (string, error) {
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if path != s.dir && !strings.HasPrefix(path, s.dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %s", key)
+	}
+
+	return path, nil
+}
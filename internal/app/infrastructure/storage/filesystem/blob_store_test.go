@@ -0,0 +1,106 @@
+package filesystem_test
+
+import (
+	"context"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/filesystem"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBlobStore(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BlobStoreSuite))
+}
+
+type BlobStoreSuite struct {
+	suite.Suite
+}
+
+func (suite *BlobStoreSuite) Test_PutGet_Success() {
+	// Prepare
+	dir := suite.T().TempDir()
+	s := filesystem.NewBlobStore(filesystem.Config{Dir: dir})
+
+	// Execute
+	err := s.Put(context.Background(), "imports/a/b/00001.json.gz", []byte("payload"))
+
+	// Assert
+	suite.NoError(err)
+	data, err := s.Get(context.Background(), "imports/a/b/00001.json.gz")
+	suite.NoError(err)
+	suite.Equal("payload", string(data))
+	_, err = os.Stat(filepath.Join(dir, "imports", "a", "b", "00001.json.gz"))
+	suite.NoError(err)
+}
+
+func (suite *BlobStoreSuite) Test_Get_NotFound() {
+	// Prepare
+	s := filesystem.NewBlobStore(filesystem.Config{Dir: suite.T().TempDir()})
+
+	// Execute
+	data, err := s.Get(context.Background(), "imports/missing")
+
+	// Assert
+	suite.Nil(data)
+	suite.ErrorIs(err, infrastructure.ErrBlobNotFound)
+}
+
+func (suite *BlobStoreSuite) Test_List_Success() {
+	// Prepare
+	s := filesystem.NewBlobStore(filesystem.Config{Dir: suite.T().TempDir()})
+	suite.NoError(s.Put(context.Background(), "imports/a/2", []byte("2")))
+	suite.NoError(s.Put(context.Background(), "imports/a/1", []byte("1")))
+	suite.NoError(s.Put(context.Background(), "imports/b/1", []byte("1")))
+
+	// Execute
+	blobs, err := s.List(context.Background(), "imports/a/")
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(blobs, 2)
+	suite.Equal("imports/a/1", blobs[0].Key)
+	suite.Equal("imports/a/2", blobs[1].Key)
+	suite.True(blobs[0].ModifiedAt.After(time.Now().Add(-time.Minute)))
+}
+
+func (suite *BlobStoreSuite) Test_List_MissingPrefix() {
+	// Prepare
+	s := filesystem.NewBlobStore(filesystem.Config{Dir: suite.T().TempDir()})
+
+	// Execute
+	blobs, err := s.List(context.Background(), "imports/unknown/")
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(blobs)
+}
+
+func (suite *BlobStoreSuite) Test_Delete_Success() {
+	// Prepare
+	s := filesystem.NewBlobStore(filesystem.Config{Dir: suite.T().TempDir()})
+	suite.NoError(s.Put(context.Background(), "imports/a/1", []byte("1")))
+
+	// Execute
+	err := s.Delete(context.Background(), "imports/a/1")
+
+	// Assert
+	suite.NoError(err)
+	_, err = s.Get(context.Background(), "imports/a/1")
+	suite.ErrorIs(err, infrastructure.ErrBlobNotFound)
+	suite.ErrorIs(s.Delete(context.Background(), "imports/a/1"), infrastructure.ErrBlobNotFound)
+}
+
+func (suite *BlobStoreSuite) Test_InvalidKey_Fail() {
+	// Prepare
+	s := filesystem.NewBlobStore(filesystem.Config{Dir: suite.T().TempDir()})
+
+	// Execute
+	err := s.Put(context.Background(), "../escape", []byte("1"))
+
+	// Assert
+	suite.ErrorContains(err, "invalid blob key ../escape")
+}
@@ -0,0 +1,5 @@
+package filesystem
+
+type Config struct {
+	Dir string `env:"DIR" envDefault:"./var/archive"`
+}
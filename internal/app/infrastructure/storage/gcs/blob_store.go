@@ -0,0 +1,93 @@
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"google.golang.org/api/iterator"
+)
+
+// BlobStore keeps blobs as objects in a bucket, so every instance sees the same blobs and they survive restarts
+type BlobStore struct {
+	client *storage.Client
+	bucket *storage.BucketHandle
+}
+
+func NewBlobStore(client *storage.Client, cfg Config) *BlobStore {
+	return &BlobStore{client: client, bucket: client.Bucket(cfg.Bucket)}
+}
+
+func (s *BlobStore) Put(ctx context.Context, key string, data []byte) error {
+	// An object only becomes visible once the writer is closed, readers never see a partial blob
+	w := s.bucket.Object(key).NewWriter(ctx)
+	if _, err := w.Write(data); err != nil {
+		_ = w.Close()
+		return fmt.Errorf("failed to write blob %s: %w", key, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to close blob %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	r, err := s.bucket.Object(key).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, fmt.Errorf("failed to read blob %s: %w", key, infrastructure.ErrBlobNotFound)
+		}
+		return nil, fmt.Errorf("failed to read blob %s: %w", key, err)
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob %s: %w", key, err)
+	}
+
+	return data, nil
+}
+
+// List returns the blobs with the prefix, the bucket lists them ordered by key
+func (s *BlobStore) List(ctx context.Context, prefix string) ([]*aggregator.Blob, error) {
+	blobs := make([]*aggregator.Blob, 0)
+	it := s.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list blobs with prefix %s: %w", prefix, err)
+		}
+
+		blobs = append(blobs, &aggregator.Blob{Key: attrs.Name, ModifiedAt: attrs.Updated})
+	}
+
+	return blobs, nil
+}
+
+func (s *BlobStore) Delete(ctx context.Context, key string) error {
+	if err := s.bucket.Object(key).Delete(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return fmt.Errorf("failed to delete blob %s: %w", key, infrastructure.ErrBlobNotFound)
+		}
+		return fmt.Errorf("failed to delete blob %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *BlobStore) Close() error {
+	if err := s.client.Close(); err != nil {
+		return fmt.Errorf("failed to close storage client: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package gcs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/gcs"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBlobStore(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(BlobStoreSuite))
+}
+
+type BlobStoreSuite struct {
+	testutils.GCSSuite
+}
+
+func (suite *BlobStoreSuite) store() *gcs.BlobStore {
+	return gcs.NewBlobStore(suite.Client, gcs.Config{Bucket: suite.Bucket})
+}
+
+func (suite *BlobStoreSuite) Test_PutGet_Success() {
+	// Prepare
+	s := suite.store()
+	key := "imports/" + uuid.NewString() + "/00001.json.gz"
+
+	// Execute
+	err := s.Put(context.Background(), key, []byte("payload"))
+
+	// Assert
+	suite.NoError(err)
+	data, err := s.Get(context.Background(), key)
+	suite.NoError(err)
+	suite.Equal("payload", string(data))
+}
+
+func (suite *BlobStoreSuite) Test_Get_NotFound() {
+	// Prepare
+	s := suite.store()
+
+	// Execute
+	data, err := s.Get(context.Background(), "imports/missing")
+
+	// Assert
+	suite.Nil(data)
+	suite.ErrorIs(err, infrastructure.ErrBlobNotFound)
+}
+
+func (suite *BlobStoreSuite) Test_List_Success() {
+	// Prepare
+	s := suite.store()
+	prefix := "imports/" + uuid.NewString() + "/"
+	suite.NoError(s.Put(context.Background(), prefix+"a/2", []byte("2")))
+	suite.NoError(s.Put(context.Background(), prefix+"a/1", []byte("1")))
+	suite.NoError(s.Put(context.Background(), prefix+"b/1", []byte("1")))
+
+	// Execute
+	blobs, err := s.List(context.Background(), prefix+"a/")
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(blobs, 2)
+	suite.Equal(prefix+"a/1", blobs[0].Key)
+	suite.Equal(prefix+"a/2", blobs[1].Key)
+	suite.True(blobs[0].ModifiedAt.After(time.Now().Add(-time.Minute)))
+}
+
+func (suite *BlobStoreSuite) Test_List_MissingPrefix() {
+	// Prepare
+	s := suite.store()
+
+	// Execute
+	blobs, err := s.List(context.Background(), "imports/"+uuid.NewString()+"/")
+
+	// Assert
+	suite.NoError(err)
+	suite.Empty(blobs)
+}
+
+func (suite *BlobStoreSuite) Test_Delete_Success() {
+	// Prepare
+	s := suite.store()
+	key := "imports/" + uuid.NewString() + "/1"
+	suite.NoError(s.Put(context.Background(), key, []byte("1")))
+
+	// Execute
+	err := s.Delete(context.Background(), key)
+
+	// Assert
+	suite.NoError(err)
+	_, err = s.Get(context.Background(), key)
+	suite.ErrorIs(err, infrastructure.ErrBlobNotFound)
+	suite.ErrorIs(s.Delete(context.Background(), key), infrastructure.ErrBlobNotFound)
+}
@@ -0,0 +1,5 @@
+package gcs
+
+type Config struct {
+	Bucket string `env:"BUCKET"`
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type BlocklistRepository struct {
+	db *sqlx.DB
+}
+
+func NewBlocklistRepository(db *sqlx.DB) *BlocklistRepository {
+	return &BlocklistRepository{db: db}
+}
+
+func (r *BlocklistRepository) Save(ctx context.Context, e *aggregator.BlocklistEntry) error {
+	_, err := r.db.NamedExecContext(
+		ctx,
+		`INSERT INTO blocklist_entries (id, kind, value, reason, created_at)
+				VALUES (:id, :kind, :value, :reason, :created_at)
+				ON CONFLICT (id) DO UPDATE SET
+					kind = EXCLUDED.kind,
+					value = EXCLUDED.value,
+					reason = EXCLUDED.reason`,
+		e,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save blocklist entry %s: %w", e.ID, err)
+	}
+
+	return nil
+}
+
+func (r *BlocklistRepository) All(ctx context.Context) ([]*aggregator.BlocklistEntry, error) {
+	var result []*aggregator.BlocklistEntry
+	err := r.db.SelectContext(ctx, &result, "SELECT * FROM blocklist_entries ORDER BY created_at, value")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocklist entries: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *BlocklistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM blocklist_entries WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete blocklist entry %s: %w", id, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete blocklist entry %s: %w", id, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("failed to delete blocklist entry %s: %w", id, infrastructure.ErrBlocklistEntryNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package postgres_test
+
+import (
+	"context"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+func TestBlocklistRepository(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(BlocklistRepositorySuite))
+}
+
+type BlocklistRepositorySuite struct {
+	testutils.PostgresSuite
+}
+
+func (suite *BlocklistRepositorySuite) Test_Save_Success() {
+	// Prepare
+	id := uuid.New()
+	e := &aggregator.BlocklistEntry{
+		ID:        id,
+		Kind:      aggregator.BlocklistKindCompany,
+		Value:     "Shady MLM Ltd.",
+		Reason:    "pyramid scheme",
+		CreatedAt: time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+	}
+	r := postgres.NewBlocklistRepository(suite.DB)
+
+	// Execute
+	err := r.Save(context.Background(), e)
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert state change
+	var dbEntry aggregator.BlocklistEntry
+	err = suite.DB.Get(&dbEntry, "SELECT * FROM blocklist_entries WHERE id = $1", id)
+	suite.NoError(err)
+	suite.Equal(id, dbEntry.ID)
+	suite.Equal(aggregator.BlocklistKindCompany, dbEntry.Kind)
+	suite.Equal("Shady MLM Ltd.", dbEntry.Value)
+	suite.Equal("pyramid scheme", dbEntry.Reason)
+	suite.True(dbEntry.CreatedAt.Equal(time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)))
+}
+
+func (suite *BlocklistRepositorySuite) Test_Save_DuplicateValue_Error() {
+	// Prepare
+	_, err := suite.DB.Exec("INSERT INTO blocklist_entries (id, kind, value) VALUES ($1, $2, $3)", uuid.New(), aggregator.BlocklistKindKeyword, "Crypto")
+	suite.NoError(err)
+
+	id := uuid.New()
+	r := postgres.NewBlocklistRepository(suite.DB)
+
+	// Execute
+	err = r.Save(context.Background(), &aggregator.BlocklistEntry{ID: id, Kind: aggregator.BlocklistKindKeyword, Value: "crypto", CreatedAt: time.Now()})
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, id.String())
+	suite.ErrorContains(err, "idx_blocklist_entries_kind_value")
+}
+
+func (suite *BlocklistRepositorySuite) Test_Save_Error() {
+	// Prepare
+	id := uuid.New()
+	r := postgres.NewBlocklistRepository(suite.BadDB)
+
+	// Execute
+	err := r.Save(context.Background(), &aggregator.BlocklistEntry{ID: id, Kind: aggregator.BlocklistKindKeyword, Value: "crypto"})
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, id.String())
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *BlocklistRepositorySuite) Test_All_Success() {
+	// Prepare
+	id1 := uuid.New()
+	_, err := suite.DB.Exec("INSERT INTO blocklist_entries (id, kind, value, reason, created_at) VALUES ($1, $2, $3, $4, $5)",
+		id1,
+		aggregator.BlocklistKindCompany,
+		"Shady MLM Ltd.",
+		"pyramid scheme",
+		time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+	)
+	suite.NoError(err)
+
+	id2 := uuid.New()
+	_, err = suite.DB.Exec("INSERT INTO blocklist_entries (id, kind, value, created_at) VALUES ($1, $2, $3, $4)",
+		id2,
+		aggregator.BlocklistKindKeyword,
+		"crypto",
+		time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC),
+	)
+	suite.NoError(err)
+
+	r := postgres.NewBlocklistRepository(suite.DB)
+
+	// Execute
+	entries, err := r.All(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(entries, 2)
+
+	suite.Equal(id1, entries[0].ID)
+	suite.Equal(aggregator.BlocklistKindCompany, entries[0].Kind)
+	suite.Equal("Shady MLM Ltd.", entries[0].Value)
+	suite.Equal("pyramid scheme", entries[0].Reason)
+
+	suite.Equal(id2, entries[1].ID)
+	suite.Equal(aggregator.BlocklistKindKeyword, entries[1].Kind)
+	suite.Equal("crypto", entries[1].Value)
+	suite.Equal("", entries[1].Reason)
+}
+
+func (suite *BlocklistRepositorySuite) Test_All_Error() {
+	// Prepare
+	r := postgres.NewBlocklistRepository(suite.BadDB)
+
+	// Execute
+	entries, err := r.All(context.Background())
+
+	// Assert
+	suite.Nil(entries)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *BlocklistRepositorySuite) Test_Delete_Success() {
+	// Prepare
+	id := uuid.New()
+	_, err := suite.DB.Exec("INSERT INTO blocklist_entries (id, kind, value) VALUES ($1, $2, $3)", id, aggregator.BlocklistKindKeyword, "crypto")
+	suite.NoError(err)
+
+	r := postgres.NewBlocklistRepository(suite.DB)
+
+	// Execute
+	err = r.Delete(context.Background(), id)
+
+	// Assert
+	suite.NoError(err)
+
+	var count int
+	suite.NoError(suite.DB.Get(&count, "SELECT COUNT(*) FROM blocklist_entries"))
+	suite.Equal(0, count)
+}
+
+func (suite *BlocklistRepositorySuite) Test_Delete_NotFound() {
+	// Prepare
+	r := postgres.NewBlocklistRepository(suite.DB)
+
+	// Execute
+	err := r.Delete(context.Background(), uuid.New())
+
+	// Assert
+	suite.ErrorIs(err, infrastructure.ErrBlocklistEntryNotFound)
+}
+
+func (suite *BlocklistRepositorySuite) Test_Delete_Error() {
+	// Prepare
+	r := postgres.NewBlocklistRepository(suite.BadDB)
+
+	// Execute
+	err := r.Delete(context.Background(), uuid.New())
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
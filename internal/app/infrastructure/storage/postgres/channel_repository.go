@@ -23,12 +23,13 @@ func NewChannelRepository(db *sqlx.DB) *ChannelRepository {
 func (r *ChannelRepository) Save(ctx context.Context, ch *aggregator.Channel) error {
 	_, err := r.db.NamedExecContext(
 		ctx,
-		`INSERT INTO channels (id, name, integration, status, created_at, updated_at)
-				VALUES (:id, :name, :integration, :status, :created_at, :updated_at)
+		`INSERT INTO channels (id, name, integration, status, settings, created_at, updated_at)
+				VALUES (:id, :name, :integration, :status, :settings, :created_at, :updated_at)
 				ON CONFLICT (id) DO UPDATE SET
 					name = EXCLUDED.name,
 					integration = EXCLUDED.integration,
 					status = EXCLUDED.status,
+					settings = EXCLUDED.settings,
 					updated_at = EXCLUDED.updated_at`,
 		ch,
 	)
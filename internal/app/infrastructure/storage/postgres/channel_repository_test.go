@@ -290,3 +290,30 @@ func (suite *ChannelRepositorySuite) Test_Find_Error() {
 	suite.ErrorContains(err, id.String())
 	suite.ErrorContains(err, "sql: database is closed")
 }
+
+func (suite *ChannelRepositorySuite) Test_Save_Settings_Success() {
+	// Prepare
+	id := uuid.New()
+	ch := &aggregator.Channel{
+		ID:          id,
+		Name:        "Channel Name",
+		Integration: aggregator.IntegrationArbeitnow,
+		Status:      aggregator.ChannelStatusActive,
+		Settings:    aggregator.ChannelSettings{MissingAfterImports: 3, MissingAfter: 48 * time.Hour},
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	r := postgres.NewChannelRepository(suite.DB)
+
+	// Execute
+	err := r.Save(context.Background(), ch)
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert state change
+	dbChannel, err := r.Find(context.Background(), id)
+	suite.NoError(err)
+	suite.Equal(3, dbChannel.Settings.MissingAfterImports)
+	suite.Equal(48*time.Hour, dbChannel.Settings.MissingAfter)
+}
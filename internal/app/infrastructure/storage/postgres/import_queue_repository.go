@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// importQueueLock serializes enqueues, so virtual times are computed on a stable queue.
+// Claims only take it together with their integration, so claims of other integrations do not wait on each other.
+const importQueueLock = 7_301_004
+
+type ImportQueueRepository struct {
+	db *sqlx.DB
+}
+
+func NewImportQueueRepository(db *sqlx.DB) *ImportQueueRepository {
+	return &ImportQueueRepository{db: db}
+}
+
+// Enqueue adds the import with a virtual time of max(last virtual time of its channel, system virtual time) + 1/priority.
+// The system virtual time is the lowest virtual time still queued, so a channel that was idle does not get to catch up
+// on the turns it skipped. Enqueueing an import that is already queued is a no-op.
+func (r *ImportQueueRepository) Enqueue(ctx context.Context, qi *aggregator.QueuedImport) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction for enqueueing import %s: %w", qi.ImportID, err)
+	}
+	defer func(tx *sqlx.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", importQueueLock); err != nil {
+		return fmt.Errorf("failed to lock queue for enqueueing import %s: %w", qi.ImportID, err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO import_queue (import_id, channel_id, integration, priority, status, virtual_time, enqueued_at)
+				SELECT $1, $2, $3, $4, $5, GREATEST(
+					COALESCE((SELECT max(virtual_time) FROM import_queue WHERE channel_id = $2), 0),
+					COALESCE(
+						(SELECT min(virtual_time) FROM import_queue WHERE status = $5),
+						(SELECT max(virtual_time) FROM import_queue),
+						0
+					)
+				) + 1.0 / $4, $6
+				ON CONFLICT (import_id) DO NOTHING`,
+		qi.ImportID,
+		qi.ChannelID,
+		qi.Integration,
+		qi.Priority,
+		aggregator.QueueStatusQueued,
+		qi.EnqueuedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue import %s: %w", qi.ImportID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit enqueueing import %s: %w", qi.ImportID, err)
+	}
+
+	return nil
+}
+
+// Claim marks the queued import with the lowest virtual time as running, skipping integrations that already run
+// their cap of imports. Integrations without a cap of their own use the default cap, where 0 means no cap.
+// When another worker takes the last free slot of an integration first, the next integration in line is tried.
+// The claimed import gets a new claim token, which the worker needs to beat, complete or requeue it.
+func (r *ImportQueueRepository) Claim(ctx context.Context, caps map[aggregator.Integration]int, defaultCap int) (*aggregator.QueuedImport, error) {
+	integrations := make([]int64, 0, len(caps))
+	limits := make([]int64, 0, len(caps))
+	for i, c := range caps {
+		integrations = append(integrations, int64(i))
+		limits = append(limits, int64(c))
+	}
+	if defaultCap <= 0 {
+		defaultCap = math.MaxInt32
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction for claiming import: %w", err)
+	}
+	defer func(tx *sqlx.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	full := make([]int64, 0)
+	for {
+		var next struct {
+			ImportID    uuid.UUID              `db:"import_id"`
+			Integration aggregator.Integration `db:"integration"`
+			Cap         int                    `db:"cap"`
+		}
+		err = tx.GetContext(
+			ctx,
+			&next,
+			`WITH running AS (
+						SELECT integration, count(*) AS total FROM import_queue WHERE status = $4 GROUP BY integration
+					), caps AS (
+						SELECT unnest(CAST($1 AS int[])) AS integration, unnest(CAST($2 AS int[])) AS cap
+					)
+					SELECT q.import_id, q.integration, COALESCE(NULLIF(c.cap, 0), $3) AS cap FROM import_queue q
+					LEFT JOIN running r ON r.integration = q.integration
+					LEFT JOIN caps c ON c.integration = q.integration
+					WHERE q.status = $5 AND COALESCE(r.total, 0) < COALESCE(NULLIF(c.cap, 0), $3)
+						AND q.integration <> ALL(CAST($6 AS int[]))
+					ORDER BY q.virtual_time, q.enqueued_at
+					LIMIT 1
+					FOR UPDATE OF q SKIP LOCKED`,
+			pq.Array(integrations),
+			pq.Array(limits),
+			defaultCap,
+			aggregator.QueueStatusRunning,
+			aggregator.QueueStatusQueued,
+			pq.Array(full),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, fmt.Errorf("failed to claim import: %w", infrastructure.ErrQueueEmpty)
+			}
+
+			return nil, fmt.Errorf("failed to claim import: %w", err)
+		}
+
+		// Claims of the same integration are serialized, so two workers can not both take its last free slot
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(CAST($1 AS int), CAST($2 AS int))", importQueueLock, next.Integration); err != nil {
+			return nil, fmt.Errorf("failed to lock integration %s for claiming import %s: %w", next.Integration, next.ImportID, err)
+		}
+
+		var running int
+		if err := tx.GetContext(ctx, &running, "SELECT count(*) FROM import_queue WHERE integration = $1 AND status = $2", next.Integration, aggregator.QueueStatusRunning); err != nil {
+			return nil, fmt.Errorf("failed to count running imports of integration %s: %w", next.Integration, err)
+		}
+		if running >= next.Cap {
+			full = append(full, int64(next.Integration))
+			continue
+		}
+
+		var qi aggregator.QueuedImport
+		err = tx.GetContext(
+			ctx,
+			&qi,
+			"UPDATE import_queue SET status = $1, started_at = now(), heartbeat_at = now(), claim_token = $2 WHERE import_id = $3 RETURNING *",
+			aggregator.QueueStatusRunning,
+			uuid.New(),
+			next.ImportID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim import %s: %w", next.ImportID, err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit claiming import %s: %w", qi.ImportID, err)
+		}
+
+		return &qi, nil
+	}
+}
+
+// Complete removes the import from the queue, as long as the claim token is still the one of the import
+func (r *ImportQueueRepository) Complete(ctx context.Context, importID, claimToken uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM import_queue WHERE import_id = $1 AND claim_token = $2", importID, claimToken)
+	if err != nil {
+		return fmt.Errorf("failed to complete queued import %s: %w", importID, err)
+	}
+
+	return r.checkClaim(res, importID, claimToken)
+}
+
+// Requeue puts a claimed import back in the queue, it keeps its virtual time so it is claimed again first
+func (r *ImportQueueRepository) Requeue(ctx context.Context, importID, claimToken uuid.UUID) error {
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE import_queue SET status = $1, started_at = NULL, heartbeat_at = NULL, claim_token = NULL WHERE import_id = $2 AND claim_token = $3",
+		aggregator.QueueStatusQueued,
+		importID,
+		claimToken,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to requeue import %s: %w", importID, err)
+	}
+
+	return r.checkClaim(res, importID, claimToken)
+}
+
+// Heartbeat tells the import is still running, as long as it beats it is not requeued however long it takes
+func (r *ImportQueueRepository) Heartbeat(ctx context.Context, importID, claimToken uuid.UUID) error {
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE import_queue SET heartbeat_at = now() WHERE import_id = $1 AND status = $2 AND claim_token = $3",
+		importID,
+		aggregator.QueueStatusRunning,
+		claimToken,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to beat heartbeat of import %s: %w", importID, err)
+	}
+
+	return r.checkClaim(res, importID, claimToken)
+}
+
+// checkClaim reports a stale fence when nothing was touched, the import was requeued or claimed by another worker
+func (r *ImportQueueRepository) checkClaim(res sql.Result, importID, claimToken uuid.UUID) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count queued imports %s touched with claim token %s: %w", importID, claimToken, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("failed to touch queued import %s with claim token %s: %w", importID, claimToken, infrastructure.ErrStaleFence)
+	}
+
+	return nil
+}
+
+// RequeueStale puts imports back in the queue whose last heartbeat is before the given time, their worker is assumed gone
+func (r *ImportQueueRepository) RequeueStale(ctx context.Context, heartbeatBefore time.Time) (int, error) {
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE import_queue SET status = $1, started_at = NULL, heartbeat_at = NULL, claim_token = NULL WHERE status = $2 AND heartbeat_at < $3",
+		aggregator.QueueStatusQueued,
+		aggregator.QueueStatusRunning,
+		heartbeatBefore,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue imports without heartbeat since %s: %w", heartbeatBefore, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count requeued imports without heartbeat since %s: %w", heartbeatBefore, err)
+	}
+
+	return int(n), nil
+}
+
+func (r *ImportQueueRepository) GetAll(ctx context.Context) ([]*aggregator.QueuedImport, error) {
+	var results []*aggregator.QueuedImport
+	err := r.db.SelectContext(ctx, &results, "SELECT * FROM import_queue ORDER BY virtual_time, enqueued_at")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queued imports: %w", err)
+	}
+
+	return results, nil
+}
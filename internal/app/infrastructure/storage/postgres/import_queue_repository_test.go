@@ -0,0 +1,328 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestImportQueueRepository(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(ImportQueueRepositorySuite))
+}
+
+type ImportQueueRepositorySuite struct {
+	testutils.PostgresSuite
+}
+
+func (suite *ImportQueueRepositorySuite) enqueue(r *postgres.ImportQueueRepository, chID uuid.UUID, priority int) uuid.UUID {
+	id := uuid.New()
+	suite.NoError(r.Enqueue(context.Background(), &aggregator.QueuedImport{
+		ImportID:    id,
+		ChannelID:   chID,
+		Integration: aggregator.IntegrationArbeitnow,
+		Priority:    priority,
+		EnqueuedAt:  time.Now(),
+	}))
+
+	return id
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Enqueue_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	chID := uuid.New()
+
+	// Execute
+	first := suite.enqueue(r, chID, 2)
+	second := suite.enqueue(r, chID, 2)
+
+	// Assert state change
+	var dbQueue []*aggregator.QueuedImport
+	err := suite.DB.Select(&dbQueue, "SELECT * FROM import_queue ORDER BY virtual_time")
+	suite.NoError(err)
+	suite.Len(dbQueue, 2)
+	suite.Equal(first, dbQueue[0].ImportID)
+	suite.Equal(aggregator.QueueStatusQueued, dbQueue[0].Status)
+	suite.InDelta(0.5, dbQueue[0].VirtualTime, 0.0001)
+	suite.Equal(second, dbQueue[1].ImportID)
+	suite.InDelta(1.0, dbQueue[1].VirtualTime, 0.0001)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Enqueue_Duplicate_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	qi := &aggregator.QueuedImport{ImportID: uuid.New(), ChannelID: uuid.New(), Priority: 1, EnqueuedAt: time.Now()}
+	suite.NoError(r.Enqueue(context.Background(), qi))
+
+	// Execute
+	err := r.Enqueue(context.Background(), qi)
+
+	// Assert
+	suite.NoError(err)
+	var count int
+	suite.NoError(suite.DB.Get(&count, "SELECT count(*) FROM import_queue"))
+	suite.Equal(1, count)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Enqueue_Error() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.BadDB)
+
+	// Execute
+	err := r.Enqueue(context.Background(), &aggregator.QueuedImport{ImportID: uuid.New(), Priority: 1})
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Claim_WeightedFair_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	high := uuid.New()
+	low := uuid.New()
+	for range 3 {
+		suite.enqueue(r, low, 1)
+		suite.enqueue(r, high, 4)
+	}
+
+	// Execute
+	var order []uuid.UUID
+	for range 6 {
+		qi, err := r.Claim(context.Background(), nil, 0)
+		suite.NoError(err)
+		order = append(order, qi.ChannelID)
+	}
+
+	// Assert the high priority channel catches up on the low priority channel that was queued first
+	suite.Equal([]uuid.UUID{low, high, high, high, low, low}, order)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Claim_IntegrationCap_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	first := suite.enqueue(r, uuid.New(), 1)
+	suite.enqueue(r, uuid.New(), 1)
+	caps := map[aggregator.Integration]int{aggregator.IntegrationArbeitnow: 1}
+
+	// Execute
+	qi, err := r.Claim(context.Background(), caps, 5)
+	suite.NoError(err)
+	_, capErr := r.Claim(context.Background(), caps, 5)
+
+	// Assert
+	suite.Equal(first, qi.ImportID)
+	suite.Equal(aggregator.QueueStatusRunning, qi.Status)
+	suite.True(qi.StartedAt.Valid)
+	suite.ErrorIs(capErr, infrastructure.ErrQueueEmpty)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Claim_IntegrationCap_ClaimsNextIntegration_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	suite.enqueue(r, uuid.New(), 1)
+	suite.enqueue(r, uuid.New(), 1)
+	other := uuid.New()
+	suite.NoError(r.Enqueue(context.Background(), &aggregator.QueuedImport{
+		ImportID:    other,
+		ChannelID:   uuid.New(),
+		Integration: aggregator.Integration(1),
+		Priority:    1,
+		EnqueuedAt:  time.Now(),
+	}))
+	caps := map[aggregator.Integration]int{aggregator.IntegrationArbeitnow: 1}
+	_, err := r.Claim(context.Background(), caps, 0)
+	suite.NoError(err)
+
+	// Execute
+	qi, err := r.Claim(context.Background(), caps, 0)
+
+	// Assert the integration at its cap does not hold up the one behind it
+	suite.NoError(err)
+	suite.Equal(other, qi.ImportID)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Claim_Empty_Fail() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+
+	// Execute
+	qi, err := r.Claim(context.Background(), nil, 0)
+
+	// Assert
+	suite.Nil(qi)
+	suite.ErrorIs(err, infrastructure.ErrQueueEmpty)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Claim_Error() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.BadDB)
+
+	// Execute
+	qi, err := r.Claim(context.Background(), nil, 0)
+
+	// Assert
+	suite.Nil(qi)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Complete_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	suite.enqueue(r, uuid.New(), 1)
+	qi, err := r.Claim(context.Background(), nil, 0)
+	suite.NoError(err)
+
+	// Execute
+	err = r.Complete(context.Background(), qi.ImportID, qi.ClaimToken.UUID)
+
+	// Assert
+	suite.NoError(err)
+	var count int
+	suite.NoError(suite.DB.Get(&count, "SELECT count(*) FROM import_queue"))
+	suite.Equal(0, count)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Complete_StaleClaim_Fail() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	suite.enqueue(r, uuid.New(), 1)
+	lost, err := r.Claim(context.Background(), nil, 0)
+	suite.NoError(err)
+	_, err = r.RequeueStale(context.Background(), time.Now().Add(time.Minute))
+	suite.NoError(err)
+	claimed, err := r.Claim(context.Background(), nil, 0)
+	suite.NoError(err)
+
+	// Execute
+	err = r.Complete(context.Background(), lost.ImportID, lost.ClaimToken.UUID)
+
+	// Assert result
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+
+	// Assert the import stays claimed by the worker that claimed it again
+	var dbQueued aggregator.QueuedImport
+	suite.NoError(suite.DB.Get(&dbQueued, "SELECT * FROM import_queue WHERE import_id = $1", claimed.ImportID))
+	suite.Equal(aggregator.QueueStatusRunning, dbQueued.Status)
+	suite.Equal(claimed.ClaimToken, dbQueued.ClaimToken)
+	suite.NotEqual(lost.ClaimToken, claimed.ClaimToken)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Requeue_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	id := suite.enqueue(r, uuid.New(), 1)
+	claimed, err := r.Claim(context.Background(), nil, 0)
+	suite.NoError(err)
+
+	// Execute
+	err = r.Requeue(context.Background(), id, claimed.ClaimToken.UUID)
+
+	// Assert
+	suite.NoError(err)
+	qi, err := r.Claim(context.Background(), nil, 0)
+	suite.NoError(err)
+	suite.Equal(id, qi.ImportID)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_RequeueStale_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	id := suite.enqueue(r, uuid.New(), 1)
+	_, err := r.Claim(context.Background(), nil, 0)
+	suite.NoError(err)
+
+	// Execute
+	n, err := r.RequeueStale(context.Background(), time.Now().Add(time.Minute))
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(1, n)
+	var dbQueued aggregator.QueuedImport
+	suite.NoError(suite.DB.Get(&dbQueued, "SELECT * FROM import_queue WHERE import_id = $1", id))
+	suite.Equal(aggregator.QueueStatusQueued, dbQueued.Status)
+	suite.False(dbQueued.StartedAt.Valid)
+	suite.False(dbQueued.HeartbeatAt.Valid)
+	suite.False(dbQueued.ClaimToken.Valid)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Heartbeat_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	id := suite.enqueue(r, uuid.New(), 1)
+	claimed, err := r.Claim(context.Background(), nil, 0)
+	suite.NoError(err)
+	_, err = suite.DB.Exec("UPDATE import_queue SET started_at = $1, heartbeat_at = $1 WHERE import_id = $2", time.Now().Add(-2*time.Hour), id)
+	suite.NoError(err)
+
+	// Execute
+	err = r.Heartbeat(context.Background(), id, claimed.ClaimToken.UUID)
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert imports that beat are not stale, however long ago they started
+	n, err := r.RequeueStale(context.Background(), time.Now().Add(-time.Hour))
+	suite.NoError(err)
+	suite.Equal(0, n)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Heartbeat_Error() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.BadDB)
+
+	// Execute
+	err := r.Heartbeat(context.Background(), uuid.New(), uuid.New())
+
+	// Assert
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *ImportQueueRepositorySuite) Test_Heartbeat_StaleClaim_Fail() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	id := suite.enqueue(r, uuid.New(), 1)
+	lost, err := r.Claim(context.Background(), nil, 0)
+	suite.NoError(err)
+	_, err = r.RequeueStale(context.Background(), time.Now().Add(time.Minute))
+	suite.NoError(err)
+
+	// Execute
+	err = r.Heartbeat(context.Background(), id, lost.ClaimToken.UUID)
+
+	// Assert result
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+
+	// Assert the requeued import does not get a heartbeat
+	var dbQueued aggregator.QueuedImport
+	suite.NoError(suite.DB.Get(&dbQueued, "SELECT * FROM import_queue WHERE import_id = $1", id))
+	suite.Equal(aggregator.QueueStatusQueued, dbQueued.Status)
+	suite.False(dbQueued.HeartbeatAt.Valid)
+}
+
+func (suite *ImportQueueRepositorySuite) Test_GetAll_Success() {
+	// Prepare
+	r := postgres.NewImportQueueRepository(suite.DB)
+	first := suite.enqueue(r, uuid.New(), 1)
+	second := suite.enqueue(r, uuid.New(), 1)
+
+	// Execute
+	qq, err := r.GetAll(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(qq, 2)
+	suite.Equal(first, qq[0].ImportID)
+	suite.Equal(second, qq[1].ImportID)
+}
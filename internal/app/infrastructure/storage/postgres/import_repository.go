@@ -3,8 +3,10 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
@@ -30,6 +32,8 @@ func (i *importEntry) toImport() *aggregator.Import {
 		Error:     i.Import.Error,
 		Metrics:   i.Import.Metrics,
 		Status:    i.Import.Status,
+		ReplayOf:  i.Import.ReplayOf,
+		Approved:  i.Import.Approved,
 		ID:        i.Import.ID,
 		ChannelID: i.Import.ChannelID,
 		Metadata:  i.toImportMetadata(),
@@ -46,6 +50,11 @@ func (i *importEntry) toImportMetadata() *aggregator.ImportMetadata {
 		Published:        i.ImportMetadata.Published,
 		LatePublished:    i.ImportMetadata.LatePublished,
 		MissingPublished: i.ImportMetadata.MissingPublished,
+		PendingMissing:   i.ImportMetadata.PendingMissing,
+		Expired:          i.ImportMetadata.Expired,
+		Filtered:         i.ImportMetadata.Filtered,
+		Blocked:          i.ImportMetadata.Blocked,
+		DeadLinks:        i.ImportMetadata.DeadLinks,
 	}
 }
 
@@ -60,14 +69,17 @@ func NewImportRepository(db *sqlx.DB) *ImportRepository {
 func (r *ImportRepository) SaveImport(ctx context.Context, i *aggregator.Import) error {
 	_, err := r.db.NamedExecContext(
 		ctx,
-		`INSERT INTO imports (id, channel_id, status, started_at, ended_at, error)
-				VALUES (:id, :channel_id, :status, :started_at, :ended_at, :error)
+		`INSERT INTO imports (id, channel_id, status, started_at, ended_at, error, replay_of, approved)
+				VALUES (:id, :channel_id, :status, :started_at, :ended_at, :error, :replay_of, :approved)
 				ON CONFLICT (id) DO UPDATE SET
 					channel_id = EXCLUDED.channel_id,
 					status = EXCLUDED.status,
 					started_at = EXCLUDED.started_at,
 					ended_at = EXCLUDED.ended_at,
-					error = EXCLUDED.error`,
+					error = EXCLUDED.error,
+					replay_of = EXCLUDED.replay_of,
+					approved = EXCLUDED.approved,
+					updated_at = now()`,
 		i,
 	)
 	if err != nil {
@@ -116,6 +128,18 @@ func (r *ImportRepository) SaveImport(ctx context.Context, i *aggregator.Import)
 			metadata.LatePublished = group.Count
 		case aggregator.ImportMetricTypeMissingPublish:
 			metadata.MissingPublished = group.Count
+		case aggregator.ImportMetricTypePendingMissing:
+			metadata.PendingMissing = group.Count
+		case aggregator.ImportMetricTypeExpired:
+			metadata.Expired = group.Count
+		case aggregator.ImportMetricTypeFiltered:
+			metadata.Filtered = group.Count
+		case aggregator.ImportMetricTypeBlocked:
+			metadata.Blocked = group.Count
+		case aggregator.ImportMetricTypeDeadLink:
+			metadata.DeadLinks = group.Count
+		case aggregator.ImportMetricTypeHeldPublish:
+			metadata.HeldPublished = group.Count
 		default:
 			return fmt.Errorf("unknown metric type %s", group.MetricType)
 		}
@@ -123,8 +147,8 @@ func (r *ImportRepository) SaveImport(ctx context.Context, i *aggregator.Import)
 	// Save import metadata
 	_, err = r.db.NamedExecContext(
 		ctx,
-		`INSERT INTO import_metadata (import_id, new_jobs, updated_jobs, no_change_jobs, missing_jobs, errors, published, late_published, missing_published)
-				VALUES (:import_id, :new_jobs, :updated_jobs, :no_change_jobs, :missing_jobs, :errors, :published, :late_published, :missing_published)
+		`INSERT INTO import_metadata (import_id, new_jobs, updated_jobs, no_change_jobs, missing_jobs, errors, published, late_published, missing_published, pending_missing_jobs, expired_jobs, filtered_jobs, blocked_jobs, dead_link_jobs, held_published)
+				VALUES (:import_id, :new_jobs, :updated_jobs, :no_change_jobs, :missing_jobs, :errors, :published, :late_published, :missing_published, :pending_missing_jobs, :expired_jobs, :filtered_jobs, :blocked_jobs, :dead_link_jobs, :held_published)
 				ON CONFLICT (import_id) DO UPDATE SET
 				   new_jobs = EXCLUDED.new_jobs,
 				   updated_jobs = EXCLUDED.updated_jobs,
@@ -133,7 +157,13 @@ func (r *ImportRepository) SaveImport(ctx context.Context, i *aggregator.Import)
 				   errors = EXCLUDED.errors,
 				   published = EXCLUDED.published,
 				   late_published = EXCLUDED.late_published,
-				   missing_published = EXCLUDED.missing_published`,
+				   missing_published = EXCLUDED.missing_published,
+				   pending_missing_jobs = EXCLUDED.pending_missing_jobs,
+				   expired_jobs = EXCLUDED.expired_jobs,
+				   filtered_jobs = EXCLUDED.filtered_jobs,
+				   blocked_jobs = EXCLUDED.blocked_jobs,
+				   dead_link_jobs = EXCLUDED.dead_link_jobs,
+				   held_published = EXCLUDED.held_published`,
 		metadata,
 	)
 	if err != nil {
@@ -173,6 +203,12 @@ func (r *ImportRepository) FindImport(ctx context.Context, id uuid.UUID) (*aggre
 	}
 	i.Metrics = metrics
 
+	cp, err := r.FindImportCheckpoint(ctx, id)
+	if err != nil && !errors.Is(err, infrastructure.ErrImportCheckpointNotFound) {
+		return nil, err
+	}
+	i.Checkpoint = cp
+
 	return &i, nil
 }
 
@@ -187,7 +223,13 @@ func (r *ImportRepository) GetImports(ctx context.Context) ([]*aggregator.Import
        		COALESCE(im.errors, 0) as errors,
        		COALESCE(im.published, 0) as published,
        		COALESCE(im.late_published, 0) as late_published,
-       		COALESCE(im.missing_published, 0) as missing_published
+       		COALESCE(im.missing_published, 0) as missing_published,
+       		COALESCE(im.pending_missing_jobs, 0) as pending_missing_jobs,
+       		COALESCE(im.expired_jobs, 0) as expired_jobs,
+       		COALESCE(im.filtered_jobs, 0) as filtered_jobs,
+       		COALESCE(im.blocked_jobs, 0) as blocked_jobs,
+       		COALESCE(im.dead_link_jobs, 0) as dead_link_jobs,
+       		COALESCE(im.held_published, 0) as held_published
        	FROM imports LEFT OUTER JOIN import_metadata AS im ON id = import_id order by started_at desc
    `)
 	if err != nil {
@@ -202,6 +244,40 @@ func (r *ImportRepository) GetImports(ctx context.Context) ([]*aggregator.Import
 	return agImports, nil
 }
 
+// HasRunningImport tells if the channel has an import that is pending or running,
+// imports not updated since are stuck and no longer count
+func (r *ImportRepository) HasRunningImport(ctx context.Context, channelID uuid.UUID, since time.Time) (bool, error) {
+	var running bool
+	err := r.db.GetContext(ctx, &running, "SELECT EXISTS(SELECT 1 FROM imports WHERE channel_id = $1 AND updated_at >= $2 AND status IN ($3, $4, $5, $6))",
+		channelID,
+		since,
+		aggregator.ImportStatusPending,
+		aggregator.ImportStatusFetching,
+		aggregator.ImportStatusProcessing,
+		aggregator.ImportStatusPublishing,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to check running imports of channel %s: %w", channelID, err)
+	}
+
+	return running, nil
+}
+
+// HasCompletedImportSince tells if the channel has an import started after since that completed
+func (r *ImportRepository) HasCompletedImportSince(ctx context.Context, channelID uuid.UUID, since time.Time) (bool, error) {
+	var completed bool
+	err := r.db.GetContext(ctx, &completed, "SELECT EXISTS(SELECT 1 FROM imports WHERE channel_id = $1 AND started_at > $2 AND status = $3)",
+		channelID,
+		since,
+		aggregator.ImportStatusCompleted,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to check completed imports of channel %s: %w", channelID, err)
+	}
+
+	return completed, nil
+}
+
 func (r *ImportRepository) SaveImportMetric(ctx context.Context, importID uuid.UUID, m *aggregator.ImportMetric) error {
 	_, err := r.db.ExecContext(
 		ctx,
@@ -223,3 +299,100 @@ func (r *ImportRepository) SaveImportMetric(ctx context.Context, importID uuid.U
 
 	return nil
 }
+
+func (r *ImportRepository) SaveImportCheckpoint(ctx context.Context, cp *aggregator.ImportCheckpoint, jobs []*aggregator.Job) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction for checkpoint of import %s: %w", cp.ImportID, err)
+	}
+	defer func(tx *sqlx.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	for _, j := range jobs {
+		data, err := json.Marshal(j)
+		if err != nil {
+			return fmt.Errorf("failed to marshal staged job %s of import %s: %w", j.ID, cp.ImportID, err)
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO import_staged_jobs (import_id, job_id, page, job)
+					VALUES ($1, $2, $3, $4)
+					ON CONFLICT (import_id, job_id) DO UPDATE SET
+						page = EXCLUDED.page,
+						job = EXCLUDED.job`,
+			cp.ImportID,
+			j.ID,
+			cp.Pages,
+			string(data),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save staged job %s of import %s: %w", j.ID, cp.ImportID, err)
+		}
+	}
+
+	_, err = tx.NamedExecContext(
+		ctx,
+		`INSERT INTO import_checkpoints (import_id, pages, jobs, next_link, completed, updated_at)
+				VALUES (:import_id, :pages, :jobs, :next_link, :completed, :updated_at)
+				ON CONFLICT (import_id) DO UPDATE SET
+					pages = EXCLUDED.pages,
+					jobs = EXCLUDED.jobs,
+					next_link = EXCLUDED.next_link,
+					completed = EXCLUDED.completed,
+					updated_at = EXCLUDED.updated_at`,
+		cp,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save checkpoint of import %s: %w", cp.ImportID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit checkpoint of import %s: %w", cp.ImportID, err)
+	}
+
+	return nil
+}
+
+func (r *ImportRepository) FindImportCheckpoint(ctx context.Context, importID uuid.UUID) (*aggregator.ImportCheckpoint, error) {
+	var cp aggregator.ImportCheckpoint
+	err := r.db.GetContext(ctx, &cp, "SELECT * FROM import_checkpoints WHERE import_id = $1", importID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to find checkpoint of import %s: %w", importID, infrastructure.ErrImportCheckpointNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to find checkpoint of import %s: %w", importID, err)
+	}
+
+	return &cp, nil
+}
+
+func (r *ImportRepository) GetStagedJobs(ctx context.Context, importID uuid.UUID) ([]*aggregator.Job, error) {
+	var rows [][]byte
+	err := r.db.SelectContext(ctx, &rows, "SELECT job FROM import_staged_jobs WHERE import_id = $1 ORDER BY page", importID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staged jobs of import %s: %w", importID, err)
+	}
+
+	jobs := make([]*aggregator.Job, 0, len(rows))
+	for _, row := range rows {
+		var j aggregator.Job
+		if err := json.Unmarshal(row, &j); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal staged job of import %s: %w", importID, err)
+		}
+		jobs = append(jobs, &j)
+	}
+
+	return jobs, nil
+}
+
+func (r *ImportRepository) ClearStagedJobs(ctx context.Context, importID uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM import_staged_jobs WHERE import_id = $1", importID)
+	if err != nil {
+		return fmt.Errorf("failed to clear staged jobs of import %s: %w", importID, err)
+	}
+
+	return nil
+}
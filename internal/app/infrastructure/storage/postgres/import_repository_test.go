@@ -83,6 +83,7 @@ func (suite *ImportRepositorySuite) Test_SaveImport_Success() {
 	suite.True(i.StartedAt.Equal(dbImport.StartedAt))
 	suite.True(i.EndedAt.Time.Equal(dbImport.EndedAt.Time))
 	suite.Equal(i.Error.String, dbImport.Error.String)
+	suite.WithinDuration(time.Now(), dbImport.UpdatedAt, time.Minute)
 
 	var dbImportMetrics []*aggregator.ImportMetric
 	err = suite.DB.Select(&dbImportMetrics, "SELECT id, job_id, metric_type, error, created_at FROM import_metrics WHERE import_id = $1", i.ID)
@@ -314,6 +315,122 @@ func (suite *ImportRepositorySuite) Test_GetImports_Fail() {
 	suite.ErrorContains(err, "sql: database is closed")
 }
 
+func (suite *ImportRepositorySuite) Test_HasRunningImport_Success() {
+	// Prepare
+	r := postgres.NewImportRepository(suite.DB)
+
+	runningID := uuid.New()
+	idleID := uuid.New()
+	stuckID := uuid.New()
+	for _, chID := range []uuid.UUID{runningID, idleID, stuckID} {
+		_, err := suite.DB.Exec("INSERT INTO channels (id, name, integration, status) VALUES ($1, $2, $3, $4)",
+			chID,
+			"Channel Name",
+			aggregator.IntegrationArbeitnow,
+			aggregator.ChannelStatusActive,
+		)
+		suite.NoError(err)
+	}
+	_, err := suite.DB.Exec("INSERT INTO imports (id, channel_id, status, started_at) VALUES ($1, $2, $3, $4)",
+		uuid.New(),
+		runningID,
+		aggregator.ImportStatusFetching,
+		time.Now(),
+	)
+	suite.NoError(err)
+	_, err = suite.DB.Exec("INSERT INTO imports (id, channel_id, status, started_at) VALUES ($1, $2, $3, $4)",
+		uuid.New(),
+		idleID,
+		aggregator.ImportStatusCompleted,
+		time.Now(),
+	)
+	suite.NoError(err)
+	_, err = suite.DB.Exec("INSERT INTO imports (id, channel_id, status, started_at, updated_at) VALUES ($1, $2, $3, $4, $5)",
+		uuid.New(),
+		stuckID,
+		aggregator.ImportStatusPending,
+		time.Now().Add(-2*time.Hour),
+		time.Now().Add(-2*time.Hour),
+	)
+	suite.NoError(err)
+	since := time.Now().Add(-time.Hour)
+
+	// Execute
+	running, err := r.HasRunningImport(context.Background(), runningID, since)
+	suite.NoError(err)
+	idle, err := r.HasRunningImport(context.Background(), idleID, since)
+	suite.NoError(err)
+	stuck, err := r.HasRunningImport(context.Background(), stuckID, since)
+
+	// Assert
+	suite.NoError(err)
+	suite.True(running)
+	suite.False(idle)
+	suite.False(stuck)
+}
+
+func (suite *ImportRepositorySuite) Test_HasRunningImport_Fail() {
+	// Prepare
+	r := postgres.NewImportRepository(suite.BadDB)
+
+	// Execute
+	running, err := r.HasRunningImport(context.Background(), uuid.New(), time.Now())
+
+	// Assert
+	suite.False(running)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *ImportRepositorySuite) Test_HasCompletedImportSince_Success() {
+	// Prepare
+	r := postgres.NewImportRepository(suite.DB)
+	chID := uuid.New()
+	_, err := suite.DB.Exec("INSERT INTO channels (id, name, integration, status) VALUES ($1, $2, $3, $4)",
+		chID,
+		"Channel Name",
+		aggregator.IntegrationArbeitnow,
+		aggregator.ChannelStatusActive,
+	)
+	suite.NoError(err)
+	for _, i := range []struct {
+		status    aggregator.ImportStatus
+		startedAt time.Time
+	}{
+		{aggregator.ImportStatusCompleted, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{aggregator.ImportStatusFailed, time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)},
+	} {
+		_, err := suite.DB.Exec("INSERT INTO imports (id, channel_id, status, started_at) VALUES ($1, $2, $3, $4)",
+			uuid.New(),
+			chID,
+			i.status,
+			i.startedAt,
+		)
+		suite.NoError(err)
+	}
+
+	// Execute
+	before, err := r.HasCompletedImportSince(context.Background(), chID, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.NoError(err)
+	after, err := r.HasCompletedImportSince(context.Background(), chID, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	// Assert
+	suite.NoError(err)
+	suite.True(before)
+	suite.False(after)
+}
+
+func (suite *ImportRepositorySuite) Test_HasCompletedImportSince_Fail() {
+	// Prepare
+	r := postgres.NewImportRepository(suite.BadDB)
+
+	// Execute
+	completed, err := r.HasCompletedImportSince(context.Background(), uuid.New(), time.Now())
+
+	// Assert
+	suite.False(completed)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
 func (suite *ImportRepositorySuite) Test_SaveImportMetric_New_Success() {
 	// Prepare
 	chID := uuid.New()
@@ -432,3 +549,154 @@ func (suite *ImportRepositorySuite) Test_SaveImportMetric_Fail() {
 	suite.ErrorContains(err, m.ID.String())
 	suite.ErrorContains(err, "sql: database is closed")
 }
+
+func (suite *ImportRepositorySuite) Test_SaveImportCheckpoint_Success() {
+	// Prepare
+	chID := uuid.New()
+	_, err := suite.DB.Exec("INSERT INTO channels (id, name, integration, status) VALUES ($1, $2, $3, $4)",
+		chID,
+		"Channel Name",
+		aggregator.IntegrationArbeitnow,
+		aggregator.ChannelStatusActive,
+	)
+	suite.NoError(err)
+
+	r := postgres.NewImportRepository(suite.DB)
+	i := &aggregator.Import{
+		ID:        uuid.New(),
+		ChannelID: chID,
+		Status:    aggregator.ImportStatusFetching,
+		StartedAt: time.Date(2020, 1, 1, 0, 0, 1, 0, time.UTC),
+	}
+	suite.NoError(r.SaveImport(context.Background(), i))
+
+	j1 := &aggregator.Job{ID: uuid.New(), ChannelID: chID, Title: "Job 1", PostedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	j2 := &aggregator.Job{ID: uuid.New(), ChannelID: chID, Title: "Job 2", PostedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	uAt := time.Date(2020, 1, 1, 0, 0, 2, 0, time.UTC)
+
+	// Execute
+	err = r.SaveImportCheckpoint(context.Background(), &aggregator.ImportCheckpoint{
+		ImportID:  i.ID,
+		NextLink:  null.StringFrom("https://www.arbeitnow.com/api/job-board-api?page=2"),
+		Pages:     1,
+		Jobs:      1,
+		UpdatedAt: uAt,
+	}, []*aggregator.Job{j1})
+	suite.NoError(err)
+	err = r.SaveImportCheckpoint(context.Background(), &aggregator.ImportCheckpoint{
+		ImportID:  i.ID,
+		NextLink:  null.NewString("", false),
+		Pages:     2,
+		Jobs:      2,
+		Completed: true,
+		UpdatedAt: uAt,
+	}, []*aggregator.Job{j2})
+	suite.NoError(err)
+
+	// Assert checkpoint
+	cp, err := r.FindImportCheckpoint(context.Background(), i.ID)
+	suite.NoError(err)
+	suite.Equal(i.ID, cp.ImportID)
+	suite.Equal(2, cp.Pages)
+	suite.Equal(2, cp.Jobs)
+	suite.True(cp.Completed)
+	suite.False(cp.NextLink.Valid)
+	suite.True(uAt.Equal(cp.UpdatedAt))
+
+	// Assert staged jobs in page order
+	jobs, err := r.GetStagedJobs(context.Background(), i.ID)
+	suite.NoError(err)
+	suite.Len(jobs, 2)
+	suite.Equal(j1.ID, jobs[0].ID)
+	suite.Equal("Job 1", jobs[0].Title)
+	suite.Equal(j2.ID, jobs[1].ID)
+
+	// Assert import detail
+	i2, err := r.FindImport(context.Background(), i.ID)
+	suite.NoError(err)
+	suite.NotNil(i2.Checkpoint)
+	suite.Equal(2, i2.Checkpoint.Pages)
+
+	// Assert clear
+	suite.NoError(r.ClearStagedJobs(context.Background(), i.ID))
+	jobs, err = r.GetStagedJobs(context.Background(), i.ID)
+	suite.NoError(err)
+	suite.Empty(jobs)
+}
+
+func (suite *ImportRepositorySuite) Test_FindImportCheckpoint_NotFound() {
+	// Prepare
+	r := postgres.NewImportRepository(suite.DB)
+
+	// Execute
+	cp, err := r.FindImportCheckpoint(context.Background(), uuid.New())
+
+	// Assert
+	suite.ErrorIs(err, infrastructure.ErrImportCheckpointNotFound)
+	suite.Nil(cp)
+}
+
+func (suite *ImportRepositorySuite) Test_SaveImport_ReplayOf_Success() {
+	// Prepare
+	chID := uuid.New()
+	_, err := suite.DB.Exec("INSERT INTO channels (id, name, integration, status) VALUES ($1, $2, $3, $4)",
+		chID,
+		"Channel Name",
+		aggregator.IntegrationArbeitnow,
+		aggregator.ChannelStatusActive,
+	)
+	suite.NoError(err)
+
+	r := postgres.NewImportRepository(suite.DB)
+	origin := &aggregator.Import{ID: uuid.New(), ChannelID: chID, Status: aggregator.ImportStatusCompleted, StartedAt: time.Now()}
+	suite.NoError(r.SaveImport(context.Background(), origin))
+	replay := &aggregator.Import{
+		ID:        uuid.New(),
+		ChannelID: chID,
+		Status:    aggregator.ImportStatusPending,
+		StartedAt: time.Now(),
+		ReplayOf:  uuid.NullUUID{UUID: origin.ID, Valid: true},
+	}
+
+	// Execute
+	err = r.SaveImport(context.Background(), replay)
+
+	// Assert
+	suite.NoError(err)
+	i, err := r.FindImport(context.Background(), replay.ID)
+	suite.NoError(err)
+	suite.True(i.ReplayOf.Valid)
+	suite.Equal(origin.ID, i.ReplayOf.UUID)
+
+	i, err = r.FindImport(context.Background(), origin.ID)
+	suite.NoError(err)
+	suite.False(i.ReplayOf.Valid)
+}
+
+func (suite *ImportRepositorySuite) Test_SaveImport_Approved_Success() {
+	// Prepare
+	chID := uuid.New()
+	_, err := suite.DB.Exec("INSERT INTO channels (id, name, integration, status) VALUES ($1, $2, $3, $4)",
+		chID,
+		"Channel Name",
+		aggregator.IntegrationArbeitnow,
+		aggregator.ChannelStatusActive,
+	)
+	suite.NoError(err)
+
+	r := postgres.NewImportRepository(suite.DB)
+	i := &aggregator.Import{ID: uuid.New(), ChannelID: chID, Status: aggregator.ImportStatusNeedsReview, StartedAt: time.Now()}
+	suite.NoError(r.SaveImport(context.Background(), i))
+	i.Status = aggregator.ImportStatusPending
+	i.Approved = true
+
+	// Execute
+	err = r.SaveImport(context.Background(), i)
+
+	// Assert
+	suite.NoError(err)
+	dbImport, err := r.FindImport(context.Background(), i.ID)
+	suite.NoError(err)
+	suite.Equal(aggregator.ImportStatusPending, dbImport.Status)
+	suite.True(dbImport.Approved)
+}
@@ -2,13 +2,23 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
+// Skills live in their own table for analytics and are aggregated back onto the job when reading
+const selectJobs = `SELECT jobs.*, (
+		SELECT jsonb_agg(jsonb_build_object('name', s.skill, 'count', s.count) ORDER BY s.count DESC, s.skill COLLATE "C")
+		FROM job_skills s WHERE s.job_id = jobs.id
+	) AS skills FROM jobs`
+
 type JobRepository struct {
 	db *sqlx.DB
 }
@@ -18,10 +28,18 @@ func NewJobRepository(db *sqlx.DB) *JobRepository {
 }
 
 func (r *JobRepository) Save(ctx context.Context, j *aggregator.Job) error {
-	_, err := r.db.NamedExecContext(
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction for job %s: %w", j.ID, err)
+	}
+	defer func(tx *sqlx.Tx) {
+		_ = tx.Rollback()
+	}(tx)
+
+	_, err = tx.NamedExecContext(
 		ctx,
-		`INSERT INTO jobs (id, channel_id, status, publish_status, url, title, description, source, location, remote, posted_at, created_at, updated_at)
-				VALUES (:id, :channel_id, :status, :publish_status, :url, :title, :description, :source, :location, :remote, :posted_at, :created_at, :updated_at)
+		`INSERT INTO jobs (id, channel_id, status, publish_status, url, title, description, description_text, description_markdown, source, company, location, language, city, region, country_code, latitude, longitude, remote_scope, seniority, seniority_confidence, employment_type, employment_type_confidence, enrichments, salary, quality, remote, posted_at, created_at, updated_at, missed_imports, missing_since, valid_through)
+				VALUES (:id, :channel_id, :status, :publish_status, :url, :title, :description, :description_text, :description_markdown, :source, :company, :location, :language, :city, :region, :country_code, :latitude, :longitude, :remote_scope, :seniority, :seniority_confidence, :employment_type, :employment_type_confidence, :enrichments, :salary, :quality, :remote, :posted_at, :created_at, :updated_at, :missed_imports, :missing_since, :valid_through)
 				ON CONFLICT (id) DO UPDATE SET
 					channel_id = EXCLUDED.channel_id,
 					status = EXCLUDED.status,
@@ -29,23 +47,125 @@ func (r *JobRepository) Save(ctx context.Context, j *aggregator.Job) error {
 					url = EXCLUDED.url,
 					title = EXCLUDED.title,
 					description = EXCLUDED.description,
+					description_text = EXCLUDED.description_text,
+					description_markdown = EXCLUDED.description_markdown,
 					source = EXCLUDED.source,
+					company = EXCLUDED.company,
 					location = EXCLUDED.location,
+					language = EXCLUDED.language,
+					city = EXCLUDED.city,
+					region = EXCLUDED.region,
+					country_code = EXCLUDED.country_code,
+					latitude = EXCLUDED.latitude,
+					longitude = EXCLUDED.longitude,
+					remote_scope = EXCLUDED.remote_scope,
+					seniority = EXCLUDED.seniority,
+					seniority_confidence = EXCLUDED.seniority_confidence,
+					employment_type = EXCLUDED.employment_type,
+					employment_type_confidence = EXCLUDED.employment_type_confidence,
+					enrichments = EXCLUDED.enrichments,
+					salary = EXCLUDED.salary,
+					quality = EXCLUDED.quality,
 					remote = EXCLUDED.remote,
 					posted_at = EXCLUDED.posted_at,
-					updated_at = EXCLUDED.updated_at`,
+					updated_at = EXCLUDED.updated_at,
+					missed_imports = EXCLUDED.missed_imports,
+					missing_since = EXCLUDED.missing_since,
+					valid_through = EXCLUDED.valid_through`,
 		j,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to save job %s: %w", j.ID, err)
 	}
 
+	if _, err := tx.ExecContext(ctx, "DELETE FROM job_skills WHERE job_id = $1", j.ID); err != nil {
+		return fmt.Errorf("failed to clear skills of job %s: %w", j.ID, err)
+	}
+	for _, sk := range j.Skills {
+		_, err := tx.ExecContext(ctx, "INSERT INTO job_skills (job_id, skill, count) VALUES ($1, $2, $3)", j.ID, sk.Name, sk.Count)
+		if err != nil {
+			return fmt.Errorf("failed to save skill %s of job %s: %w", sk.Name, j.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit job %s: %w", j.ID, err)
+	}
+
 	return nil
 }
 
+func (r *JobRepository) GetJobs(ctx context.Context, f *aggregator.JobFilter) ([]*aggregator.Job, error) {
+	where := make([]string, 0)
+	args := map[string]any{"limit": f.Limit}
+	if f.ChannelID.Valid {
+		where = append(where, "channel_id = :channel_id")
+		args["channel_id"] = f.ChannelID.UUID
+	}
+	if f.Status != nil {
+		where = append(where, "status = :status")
+		args["status"] = *f.Status
+	}
+	if f.CountryCode != "" {
+		where = append(where, "country_code = :country_code")
+		args["country_code"] = f.CountryCode
+	}
+	if f.Region != "" {
+		where = append(where, "lower(region) = lower(:region)")
+		args["region"] = f.Region
+	}
+	if f.City != "" {
+		where = append(where, "lower(city) = lower(:city)")
+		args["city"] = f.City
+	}
+	if f.RemoteScope != nil {
+		where = append(where, "remote_scope = :remote_scope")
+		args["remote_scope"] = *f.RemoteScope
+	}
+	if f.Language != "" {
+		where = append(where, "language = :language")
+		args["language"] = f.Language
+	}
+	if f.Seniority != nil {
+		where = append(where, "seniority = :seniority")
+		args["seniority"] = *f.Seniority
+	}
+	if f.EmploymentType != nil {
+		where = append(where, "employment_type = :employment_type")
+		args["employment_type"] = *f.EmploymentType
+	}
+	for i, sk := range f.Skills {
+		name := fmt.Sprintf("skill_%d", i)
+		where = append(where, "EXISTS (SELECT 1 FROM job_skills s WHERE s.job_id = jobs.id AND lower(s.skill) = lower(:"+name+"))")
+		args[name] = sk
+	}
+	if f.MinQuality > 0 {
+		where = append(where, "CAST(quality->>'score' AS int) >= :min_quality")
+		args["min_quality"] = f.MinQuality
+	}
+
+	query := selectJobs
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY posted_at DESC LIMIT :limit"
+
+	query, params, err := sqlx.Named(query, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind job filter: %w", err)
+	}
+
+	var results []*aggregator.Job
+	if err := r.db.SelectContext(ctx, &results, r.db.Rebind(query), params...); err != nil {
+		return nil, fmt.Errorf("failed to get jobs: %w", err)
+	}
+
+	return results, nil
+}
+
 func (r *JobRepository) GetByChannelID(ctx context.Context, chID uuid.UUID) ([]*aggregator.Job, error) {
 	var results []*aggregator.Job
-	err := r.db.SelectContext(ctx, &results, "SELECT * FROM jobs WHERE channel_id = $1 ORDER BY posted_at DESC", chID)
+	err := r.db.SelectContext(ctx, &results, selectJobs+" WHERE channel_id = $1 ORDER BY posted_at DESC", chID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get jobs by channel id %s: %w", chID, err)
 	}
@@ -53,12 +173,61 @@ func (r *JobRepository) GetByChannelID(ctx context.Context, chID uuid.UUID) ([]*
 	return results, nil
 }
 
+func (r *JobRepository) GetActive(ctx context.Context) ([]*aggregator.Job, error) {
+	var results []*aggregator.Job
+	err := r.db.SelectContext(ctx, &results, selectJobs+" WHERE status = $1 ORDER BY posted_at DESC", aggregator.JobStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active jobs: %w", err)
+	}
+
+	return results, nil
+}
+
 func (r *JobRepository) GetActiveUnpublishedByChannelID(ctx context.Context, chID uuid.UUID) ([]*aggregator.Job, error) {
 	var results []*aggregator.Job
-	err := r.db.SelectContext(ctx, &results, "SELECT * FROM jobs WHERE channel_id = $1 AND publish_status = 0 AND status = 1 ORDER BY posted_at DESC", chID)
+	err := r.db.SelectContext(ctx, &results, selectJobs+" WHERE channel_id = $1 AND publish_status IN (0, 2) AND status = 1 ORDER BY posted_at DESC", chID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get jobs by channel id %s: %w", chID, err)
 	}
 
 	return results, nil
 }
+
+func (r *JobRepository) Find(ctx context.Context, id uuid.UUID) (*aggregator.Job, error) {
+	var j aggregator.Job
+	err := r.db.GetContext(ctx, &j, selectJobs+" WHERE id = $1", id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, infrastructure.ErrJobNotFound
+		}
+
+		return nil, fmt.Errorf("failed to find job %s: %w", id, err)
+	}
+
+	return &j, nil
+}
+
+func (r *JobRepository) SaveVersion(ctx context.Context, v *aggregator.JobVersion) error {
+	_, err := r.db.NamedExecContext(
+		ctx,
+		`INSERT INTO job_versions (id, job_id, import_id, version, reason, changes, created_at)
+				SELECT CAST(:id AS uuid), CAST(:job_id AS uuid), CAST(:import_id AS uuid), COALESCE(MAX(version), 0) + 1, CAST(:reason AS int), CAST(:changes AS jsonb), CAST(:created_at AS timestamptz)
+				FROM job_versions WHERE job_id = :job_id`,
+		v,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save version of job %s: %w", v.JobID, err)
+	}
+
+	return nil
+}
+
+func (r *JobRepository) GetVersions(ctx context.Context, jobID uuid.UUID) ([]*aggregator.JobVersion, error) {
+	var results []*aggregator.JobVersion
+	err := r.db.SelectContext(ctx, &results, "SELECT * FROM job_versions WHERE job_id = $1 ORDER BY version", jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get versions of job %s: %w", jobID, err)
+	}
+
+	return results, nil
+}
@@ -2,11 +2,13 @@ package postgres_test
 
 import (
 	"context"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
 	"github.com/aviseu/jobs-backoffice/internal/testutils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/guregu/null.v3"
 	"testing"
 	"time"
 )
@@ -29,19 +31,25 @@ func (suite *JobRepositorySuite) Test_Save_New_Success() {
 	chID := uuid.New()
 	pAt := time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)
 	j := &aggregator.Job{
-		ID:            id,
-		ChannelID:     chID,
-		URL:           "https://example.com/job/id",
-		Title:         "Software Engineer",
-		Description:   "Job Description",
-		Source:        "Indeed",
-		Location:      "Amsterdam",
-		Remote:        true,
-		PostedAt:      pAt,
-		Status:        aggregator.JobStatusActive,
-		PublishStatus: aggregator.JobPublishStatusPublished,
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+		ID:                  id,
+		ChannelID:           chID,
+		URL:                 "https://example.com/job/id",
+		Title:               "Software Engineer",
+		Description:         "<p>Job Description</p>",
+		DescriptionText:     "Job Description",
+		DescriptionMarkdown: "Job Description",
+		Source:              "Indeed",
+		Location:            "Amsterdam",
+		Salary:              &aggregator.Salary{Currency: "EUR", Min: 60000, Max: 75000, AnnualMin: 60000, AnnualMax: 75000, Period: aggregator.SalaryPeriodYear},
+		Geo:                 aggregator.Geo{City: "Amsterdam", Region: "North Holland", CountryCode: "NL", Latitude: null.FloatFrom(52.37403), Longitude: null.FloatFrom(4.88969), RemoteScope: aggregator.RemoteScopeCountry},
+		Classification:      aggregator.Classification{Seniority: aggregator.SenioritySenior, SeniorityConfidence: 0.9, EmploymentType: aggregator.EmploymentTypeFullTime, EmploymentTypeConfidence: 0.95},
+		Quality:             &aggregator.Quality{Score: 85, Signals: []*aggregator.QualitySignal{{Name: "description_length", Reason: "description has only 320 characters", Penalty: 15}}},
+		Remote:              true,
+		PostedAt:            pAt,
+		Status:              aggregator.JobStatusActive,
+		PublishStatus:       aggregator.JobPublishStatusPublished,
+		CreatedAt:           time.Now(),
+		UpdatedAt:           time.Now(),
 	}
 	r := postgres.NewJobRepository(suite.DB)
 
@@ -61,9 +69,15 @@ func (suite *JobRepositorySuite) Test_Save_New_Success() {
 	suite.Equal(aggregator.JobPublishStatusPublished, dbJob.PublishStatus)
 	suite.Equal("https://example.com/job/id", dbJob.URL)
 	suite.Equal("Software Engineer", dbJob.Title)
-	suite.Equal("Job Description", dbJob.Description)
+	suite.Equal("<p>Job Description</p>", dbJob.Description)
+	suite.Equal("Job Description", dbJob.DescriptionText)
+	suite.Equal("Job Description", dbJob.DescriptionMarkdown)
 	suite.Equal("Indeed", dbJob.Source)
 	suite.Equal("Amsterdam", dbJob.Location)
+	suite.Equal(j.Salary, dbJob.Salary)
+	suite.Equal(j.Geo, dbJob.Geo)
+	suite.Equal(j.Quality, dbJob.Quality)
+	suite.Equal(j.Classification, dbJob.Classification)
 	suite.True(dbJob.Remote)
 	suite.True(dbJob.PostedAt.Equal(pAt))
 	suite.True(dbJob.CreatedAt.After(time.Now().Add(-2 * time.Second)))
@@ -142,6 +156,48 @@ func (suite *JobRepositorySuite) Test_Save_Existing_Success() {
 	suite.True(dbJob.UpdatedAt.After(time.Now().Add(-2 * time.Second)))
 }
 
+func (suite *JobRepositorySuite) Test_Save_Skills_Success() {
+	// Prepare
+	id := uuid.New()
+	j := &aggregator.Job{
+		ID:        id,
+		ChannelID: uuid.New(),
+		URL:       "https://example.com/job/id",
+		Title:     "Go Engineer",
+		Source:    "Indeed",
+		Skills:    aggregator.Skills{{Name: "Go", Count: 2}, {Name: "Docker", Count: 1}},
+		PostedAt:  time.Now(),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	r := postgres.NewJobRepository(suite.DB)
+	suite.NoError(r.Save(context.Background(), j))
+	j.Skills = aggregator.Skills{{Name: "Go", Count: 3}, {Name: ".NET", Count: 1}, {Name: "C#", Count: 1}}
+
+	// Execute
+	err := r.Save(context.Background(), j)
+
+	// Assert return
+	suite.NoError(err)
+
+	// Assert skills are replaced
+	var count int
+	err = suite.DB.Get(&count, "SELECT COUNT(*) FROM job_skills WHERE job_id = $1", id)
+	suite.NoError(err)
+	suite.Equal(3, count)
+
+	dbJob, err := r.Find(context.Background(), id)
+	suite.NoError(err)
+	suite.Equal(j.Skills, dbJob.Skills)
+
+	// Assert jobs without skills read as nil
+	j.Skills = nil
+	suite.NoError(r.Save(context.Background(), j))
+	dbJob, err = r.Find(context.Background(), id)
+	suite.NoError(err)
+	suite.Nil(dbJob.Skills)
+}
+
 func (suite *JobRepositorySuite) Test_Save_Error() {
 	// Prepare
 	id := uuid.New()
@@ -240,6 +296,87 @@ func (suite *JobRepositorySuite) Test_GetByChannelID_Success() {
 	suite.Equal(jID1, jobs[1].ID)
 }
 
+func (suite *JobRepositorySuite) Test_GetJobs_Success() {
+	// Prepare
+	chID := uuid.New()
+	r := postgres.NewJobRepository(suite.DB)
+	save := func(id uuid.UUID, status aggregator.JobStatus, g aggregator.Geo, pAt time.Time) {
+		suite.NoError(r.Save(context.Background(), &aggregator.Job{
+			ID:        id,
+			ChannelID: chID,
+			URL:       "https://example.com/job/id",
+			Title:     "Software Engineer",
+			Source:    "Indeed",
+			Geo:       g,
+			PostedAt:  pAt,
+			Status:    status,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}))
+	}
+	jID1 := uuid.New()
+	save(jID1, aggregator.JobStatusActive, aggregator.Geo{City: "Berlin", Region: "Berlin", CountryCode: "DE"}, time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC))
+	jID2 := uuid.New()
+	save(jID2, aggregator.JobStatusActive, aggregator.Geo{CountryCode: "DE", RemoteScope: aggregator.RemoteScopeCountry}, time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC))
+	_, err := suite.DB.Exec("INSERT INTO job_skills (job_id, skill, count) VALUES ($1, 'Go', 1), ($1, 'Kubernetes', 1), ($2, 'Go', 1)", jID2, jID1)
+	suite.NoError(err)
+	jID3 := uuid.New()
+	save(jID3, aggregator.JobStatusInactive, aggregator.Geo{City: "Paris", Region: "Île-de-France", CountryCode: "FR"}, time.Date(2025, 1, 1, 0, 3, 0, 0, time.UTC))
+	active := aggregator.JobStatusActive
+	scope := aggregator.RemoteScopeCountry
+
+	// Execute
+	all, err := r.GetJobs(context.Background(), &aggregator.JobFilter{ChannelID: uuid.NullUUID{UUID: chID, Valid: true}, Limit: 10})
+	suite.NoError(err)
+	german, err := r.GetJobs(context.Background(), &aggregator.JobFilter{ChannelID: uuid.NullUUID{UUID: chID, Valid: true}, CountryCode: "DE", Status: &active, Limit: 10})
+	suite.NoError(err)
+	city, err := r.GetJobs(context.Background(), &aggregator.JobFilter{ChannelID: uuid.NullUUID{UUID: chID, Valid: true}, City: "berlin", Region: "BERLIN", Limit: 10})
+	suite.NoError(err)
+	remote, err := r.GetJobs(context.Background(), &aggregator.JobFilter{ChannelID: uuid.NullUUID{UUID: chID, Valid: true}, RemoteScope: &scope, Limit: 10})
+	suite.NoError(err)
+	limited, err := r.GetJobs(context.Background(), &aggregator.JobFilter{ChannelID: uuid.NullUUID{UUID: chID, Valid: true}, Limit: 1})
+	suite.NoError(err)
+	seniority := aggregator.SeniorityUnknown
+	unclassified, err := r.GetJobs(context.Background(), &aggregator.JobFilter{ChannelID: uuid.NullUUID{UUID: chID, Valid: true}, Seniority: &seniority, Limit: 10})
+	suite.NoError(err)
+	employment := aggregator.EmploymentTypeFullTime
+	fullTime, err := r.GetJobs(context.Background(), &aggregator.JobFilter{ChannelID: uuid.NullUUID{UUID: chID, Valid: true}, EmploymentType: &employment, Limit: 10})
+	suite.NoError(err)
+	skilled, err := r.GetJobs(context.Background(), &aggregator.JobFilter{ChannelID: uuid.NullUUID{UUID: chID, Valid: true}, Skills: []string{"go", "Kubernetes"}, Limit: 10})
+	suite.NoError(err)
+
+	// Assert
+	suite.Len(all, 3)
+	suite.Equal(jID3, all[0].ID)
+	suite.Len(german, 2)
+	suite.Equal(jID2, german[0].ID)
+	suite.Equal(jID1, german[1].ID)
+	suite.Len(city, 1)
+	suite.Equal(jID1, city[0].ID)
+	suite.Len(remote, 1)
+	suite.Equal(jID2, remote[0].ID)
+	suite.Len(limited, 1)
+	suite.Equal(jID3, limited[0].ID)
+	suite.Len(unclassified, 3)
+	suite.Empty(fullTime)
+	suite.Len(skilled, 1)
+	suite.Equal(jID2, skilled[0].ID)
+	suite.Equal(aggregator.Skills{{Name: "Go", Count: 1}, {Name: "Kubernetes", Count: 1}}, skilled[0].Skills)
+}
+
+func (suite *JobRepositorySuite) Test_GetJobs_Error() {
+	// Prepare
+	r := postgres.NewJobRepository(suite.BadDB)
+
+	// Execute
+	jobs, err := r.GetJobs(context.Background(), &aggregator.JobFilter{Limit: 10})
+
+	// Assert return
+	suite.Nil(jobs)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
 func (suite *JobRepositorySuite) Test_GetByChannelID_Error() {
 	// Prepare
 	r := postgres.NewJobRepository(suite.BadDB)
@@ -253,6 +390,53 @@ func (suite *JobRepositorySuite) Test_GetByChannelID_Error() {
 	suite.ErrorContains(err, "sql: database is closed")
 }
 
+func (suite *JobRepositorySuite) Test_GetActive_Success() {
+	// Prepare
+	active := uuid.New()
+	inactive := uuid.New()
+	for id, status := range map[uuid.UUID]aggregator.JobStatus{active: aggregator.JobStatusActive, inactive: aggregator.JobStatusInactive} {
+		_, err := suite.DB.Exec("INSERT INTO jobs (id, channel_id, status, publish_status, url, title, description, source, location, remote, posted_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+			id,
+			uuid.New(),
+			status,
+			aggregator.JobPublishStatusPublished,
+			"https://example.com/job/id",
+			"Software Engineer",
+			"Job Description",
+			"Indeed",
+			"Amsterdam",
+			true,
+			time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+			time.Now(),
+			time.Now(),
+		)
+		suite.NoError(err)
+	}
+
+	r := postgres.NewJobRepository(suite.DB)
+
+	// Execute
+	jobs, err := r.GetActive(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(jobs, 1)
+	suite.Equal(active, jobs[0].ID)
+}
+
+func (suite *JobRepositorySuite) Test_GetActive_Error() {
+	// Prepare
+	r := postgres.NewJobRepository(suite.BadDB)
+
+	// Execute
+	jobs, err := r.GetActive(context.Background())
+
+	// Assert
+	suite.Nil(jobs)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
 func (suite *JobRepositorySuite) Test_GetActiveUnpublishedByChannelID_Success() {
 	// Prepare
 	chID1 := uuid.New()
@@ -342,6 +526,23 @@ func (suite *JobRepositorySuite) Test_GetActiveUnpublishedByChannelID_Success()
 		time.Now(),
 	)
 	suite.NoError(err)
+	activeHeld := uuid.New()
+	_, err = suite.DB.Exec("INSERT INTO jobs (id, channel_id, status, publish_status, url, title, description, source, location, remote, posted_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+		activeHeld,
+		chID1,
+		aggregator.JobStatusActive,
+		aggregator.JobPublishStatusHeld,
+		"https://example.com/job/id",
+		"Software Engineer",
+		"Job Description",
+		"Indeed",
+		"Amsterdam",
+		true,
+		time.Date(2025, 1, 1, 0, 1, 30, 0, time.UTC),
+		time.Now(),
+		time.Now(),
+	)
+	suite.NoError(err)
 
 	r := postgres.NewJobRepository(suite.DB)
 
@@ -350,8 +551,10 @@ func (suite *JobRepositorySuite) Test_GetActiveUnpublishedByChannelID_Success()
 
 	// Assert return
 	suite.NoError(err)
-	suite.Len(jobs, 1)
+	suite.Len(jobs, 2)
 	suite.Equal(activeUnpublished, jobs[0].ID)
+	suite.Equal(activeHeld, jobs[1].ID)
+	suite.Equal(aggregator.JobPublishStatusHeld, jobs[1].PublishStatus)
 }
 
 func (suite *JobRepositorySuite) Test_GetActiveUnpublishedByChannelID_Error() {
@@ -366,3 +569,201 @@ func (suite *JobRepositorySuite) Test_GetActiveUnpublishedByChannelID_Error() {
 	suite.Error(err)
 	suite.ErrorContains(err, "sql: database is closed")
 }
+
+func (suite *JobRepositorySuite) Test_Save_Missed_Success() {
+	// Prepare
+	id := uuid.New()
+	mAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	j := &aggregator.Job{
+		ID:            id,
+		ChannelID:     uuid.New(),
+		URL:           "https://example.com/job/id",
+		Title:         "Software Engineer",
+		Description:   "Job Description",
+		Source:        "Indeed",
+		PostedAt:      time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+		Status:        aggregator.JobStatusActive,
+		PublishStatus: aggregator.JobPublishStatusPublished,
+		MissedImports: 2,
+		MissingSince:  null.TimeFrom(mAt),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	r := postgres.NewJobRepository(suite.DB)
+	suite.NoError(r.Save(context.Background(), j))
+	j.MissedImports = 0
+	j.MissingSince = null.NewTime(time.Time{}, false)
+	j.ValidThrough = null.TimeFrom(mAt)
+
+	// Execute
+	err := r.Save(context.Background(), j)
+
+	// Assert return
+	suite.NoError(err)
+
+	// Assert state change
+	var dbJob aggregator.Job
+	err = suite.DB.Get(&dbJob, "SELECT * FROM jobs WHERE id = $1", id)
+	suite.NoError(err)
+	suite.Equal(0, dbJob.MissedImports)
+	suite.False(dbJob.MissingSince.Valid)
+	suite.True(dbJob.ValidThrough.Time.Equal(mAt))
+}
+
+func (suite *JobRepositorySuite) Test_Find_Success() {
+	// Prepare
+	id := uuid.New()
+	r := postgres.NewJobRepository(suite.DB)
+	suite.NoError(r.Save(context.Background(), &aggregator.Job{
+		ID:          id,
+		ChannelID:   uuid.New(),
+		URL:         "https://example.com/job/id",
+		Title:       "Software Engineer",
+		Description: "Job Description",
+		Source:      "Indeed",
+		PostedAt:    time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+		Status:      aggregator.JobStatusActive,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}))
+
+	// Execute
+	j, err := r.Find(context.Background(), id)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(id, j.ID)
+	suite.Equal("Software Engineer", j.Title)
+	suite.Nil(j.Salary)
+}
+
+func (suite *JobRepositorySuite) Test_Find_NotFound() {
+	// Prepare
+	r := postgres.NewJobRepository(suite.DB)
+
+	// Execute
+	j, err := r.Find(context.Background(), uuid.New())
+
+	// Assert
+	suite.Nil(j)
+	suite.ErrorIs(err, infrastructure.ErrJobNotFound)
+}
+
+func (suite *JobRepositorySuite) Test_SaveVersion_Success() {
+	// Prepare
+	chID := uuid.New()
+	_, err := suite.DB.Exec("INSERT INTO channels (id, name, integration, status) VALUES ($1, $2, $3, $4)",
+		chID,
+		"Channel Name",
+		aggregator.IntegrationArbeitnow,
+		aggregator.ChannelStatusActive,
+	)
+	suite.NoError(err)
+
+	iID := uuid.New()
+	_, err = suite.DB.Exec("INSERT INTO imports (id, channel_id, status, started_at) VALUES ($1, $2, $3, $4)",
+		iID,
+		chID,
+		aggregator.ImportStatusProcessing,
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
+	suite.NoError(err)
+
+	jID := uuid.New()
+	r := postgres.NewJobRepository(suite.DB)
+	suite.NoError(r.Save(context.Background(), &aggregator.Job{
+		ID:          jID,
+		ChannelID:   chID,
+		URL:         "https://example.com/job/id",
+		Title:       "Software Engineer",
+		Description: "Job Description",
+		Source:      "Indeed",
+		PostedAt:    time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+		Status:      aggregator.JobStatusActive,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}))
+
+	cAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// Execute
+	err1 := r.SaveVersion(context.Background(), &aggregator.JobVersion{
+		ID:        uuid.New(),
+		JobID:     jID,
+		ImportID:  uuid.NullUUID{UUID: iID, Valid: true},
+		Reason:    aggregator.ImportMetricTypeNew,
+		Changes:   aggregator.JobChanges{},
+		CreatedAt: cAt,
+	})
+	err2 := r.SaveVersion(context.Background(), &aggregator.JobVersion{
+		ID:        uuid.New(),
+		JobID:     jID,
+		ImportID:  uuid.NullUUID{UUID: iID, Valid: true},
+		Reason:    aggregator.ImportMetricTypeUpdated,
+		Changes:   aggregator.JobChanges{{Field: "title", Old: "Engineer", New: "Software Engineer"}},
+		CreatedAt: cAt,
+	})
+
+	// Assert return
+	suite.NoError(err1)
+	suite.NoError(err2)
+
+	// Assert state change
+	vv, err := r.GetVersions(context.Background(), jID)
+	suite.NoError(err)
+	suite.Len(vv, 2)
+	suite.Equal(1, vv[0].Version)
+	suite.Equal(aggregator.ImportMetricTypeNew, vv[0].Reason)
+	suite.Empty(vv[0].Changes)
+	suite.Equal(2, vv[1].Version)
+	suite.Equal(uuid.NullUUID{UUID: iID, Valid: true}, vv[1].ImportID)
+	suite.Equal(aggregator.ImportMetricTypeUpdated, vv[1].Reason)
+	suite.Equal(aggregator.JobChanges{{Field: "title", Old: "Engineer", New: "Software Engineer"}}, vv[1].Changes)
+	suite.True(vv[1].CreatedAt.Equal(cAt))
+}
+
+func (suite *JobRepositorySuite) Test_SaveVersion_WithoutImport_Success() {
+	// Prepare
+	chID := uuid.New()
+	_, err := suite.DB.Exec("INSERT INTO channels (id, name, integration, status) VALUES ($1, $2, $3, $4)",
+		chID,
+		"Channel Name",
+		aggregator.IntegrationArbeitnow,
+		aggregator.ChannelStatusActive,
+	)
+	suite.NoError(err)
+
+	jID := uuid.New()
+	r := postgres.NewJobRepository(suite.DB)
+	suite.NoError(r.Save(context.Background(), &aggregator.Job{
+		ID:          jID,
+		ChannelID:   chID,
+		URL:         "https://example.com/job/id",
+		Title:       "Software Engineer",
+		Description: "Job Description",
+		Source:      "Indeed",
+		PostedAt:    time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+		Status:      aggregator.JobStatusInactive,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}))
+
+	// Execute
+	err = r.SaveVersion(context.Background(), &aggregator.JobVersion{
+		ID:        uuid.New(),
+		JobID:     jID,
+		Reason:    aggregator.ImportMetricTypeExpired,
+		Changes:   aggregator.JobChanges{{Field: "status", Old: "active", New: "inactive"}},
+		CreatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Assert return
+	suite.NoError(err)
+
+	// Assert state change
+	vv, err := r.GetVersions(context.Background(), jID)
+	suite.NoError(err)
+	suite.Len(vv, 1)
+	suite.False(vv[0].ImportID.Valid)
+	suite.Equal(aggregator.ImportMetricTypeExpired, vv[0].Reason)
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/jmoiron/sqlx"
+)
+
+type LeaseRepository struct {
+	db *sqlx.DB
+}
+
+func NewLeaseRepository(db *sqlx.DB) *LeaseRepository {
+	return &LeaseRepository{db: db}
+}
+
+// Acquire takes the lease when it is free or expired and renews it when the holder already has it.
+// Every change of holder increments the fencing token. Time is taken from the database, so the
+// clocks of the holders do not need to agree.
+func (r *LeaseRepository) Acquire(ctx context.Context, name, holder string, ttl time.Duration) (*aggregator.Lease, error) {
+	var l aggregator.Lease
+	err := r.db.GetContext(
+		ctx,
+		&l,
+		`INSERT INTO leases (name, holder, token, acquired_at, renewed_at, expires_at)
+				VALUES ($1, $2, 1, now(), now(), now() + $3 * interval '1 millisecond')
+				ON CONFLICT (name) DO UPDATE SET
+					holder = EXCLUDED.holder,
+					token = CASE WHEN leases.holder = EXCLUDED.holder AND leases.expires_at > now() THEN leases.token ELSE leases.token + 1 END,
+					acquired_at = CASE WHEN leases.holder = EXCLUDED.holder AND leases.expires_at > now() THEN leases.acquired_at ELSE EXCLUDED.acquired_at END,
+					renewed_at = EXCLUDED.renewed_at,
+					expires_at = EXCLUDED.expires_at
+				WHERE leases.holder = EXCLUDED.holder OR leases.expires_at <= now()
+				RETURNING *`,
+		name,
+		holder,
+		ttl.Milliseconds(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to acquire lease %s for %s: %w", name, holder, infrastructure.ErrLeaseHeld)
+		}
+
+		return nil, fmt.Errorf("failed to acquire lease %s for %s: %w", name, holder, err)
+	}
+
+	return &l, nil
+}
+
+// Release expires the lease right away so another holder can take over, as long as it was not taken over already
+func (r *LeaseRepository) Release(ctx context.Context, l *aggregator.Lease) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE leases SET expires_at = now() WHERE name = $1 AND holder = $2 AND token = $3", l.Name, l.Holder, l.Token)
+	if err != nil {
+		return fmt.Errorf("failed to release lease %s of %s: %w", l.Name, l.Holder, err)
+	}
+
+	return nil
+}
+
+func (r *LeaseRepository) Find(ctx context.Context, name string) (*aggregator.Lease, error) {
+	var l aggregator.Lease
+	err := r.db.GetContext(ctx, &l, "SELECT * FROM leases WHERE name = $1", name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to find lease %s: %w", name, infrastructure.ErrLeaseNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to find lease %s: %w", name, err)
+	}
+
+	return &l, nil
+}
@@ -0,0 +1,191 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLeaseRepository(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(LeaseRepositorySuite))
+}
+
+type LeaseRepositorySuite struct {
+	testutils.PostgresSuite
+}
+
+func (suite *LeaseRepositorySuite) Test_Acquire_Success() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.DB)
+
+	// Execute
+	l, err := r.Acquire(context.Background(), "acquire", "replica-1", time.Minute)
+
+	// Assert result
+	suite.NoError(err)
+	suite.Equal("acquire", l.Name)
+	suite.Equal("replica-1", l.Holder)
+	suite.Equal(int64(1), l.Token)
+	suite.WithinDuration(l.RenewedAt.Add(time.Minute), l.ExpiresAt, time.Millisecond)
+
+	// Assert state change
+	var dbLease aggregator.Lease
+	err = suite.DB.Get(&dbLease, "SELECT * FROM leases WHERE name = $1", "acquire")
+	suite.NoError(err)
+	suite.Equal("replica-1", dbLease.Holder)
+}
+
+func (suite *LeaseRepositorySuite) Test_Acquire_Renew_Success() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.DB)
+	first, err := r.Acquire(context.Background(), "renew", "replica-1", time.Minute)
+	suite.NoError(err)
+
+	// Execute
+	l, err := r.Acquire(context.Background(), "renew", "replica-1", time.Minute)
+
+	// Assert the holder keeps its token
+	suite.NoError(err)
+	suite.Equal(first.Token, l.Token)
+	suite.True(l.AcquiredAt.Equal(first.AcquiredAt))
+	suite.False(l.ExpiresAt.Before(first.ExpiresAt))
+}
+
+func (suite *LeaseRepositorySuite) Test_Acquire_Held_Fail() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.DB)
+	_, err := r.Acquire(context.Background(), "held", "replica-1", time.Minute)
+	suite.NoError(err)
+
+	// Execute
+	l, err := r.Acquire(context.Background(), "held", "replica-2", time.Minute)
+
+	// Assert
+	suite.Nil(l)
+	suite.ErrorIs(err, infrastructure.ErrLeaseHeld)
+}
+
+func (suite *LeaseRepositorySuite) Test_Acquire_Expired_Success() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.DB)
+	_, err := r.Acquire(context.Background(), "expired", "replica-1", time.Minute)
+	suite.NoError(err)
+	_, err = suite.DB.Exec("UPDATE leases SET expires_at = now() - interval '1 second' WHERE name = $1", "expired")
+	suite.NoError(err)
+
+	// Execute
+	l, err := r.Acquire(context.Background(), "expired", "replica-2", time.Minute)
+
+	// Assert the new holder gets a newer token
+	suite.NoError(err)
+	suite.Equal("replica-2", l.Holder)
+	suite.Equal(int64(2), l.Token)
+}
+
+func (suite *LeaseRepositorySuite) Test_Acquire_Error() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.BadDB)
+
+	// Execute
+	l, err := r.Acquire(context.Background(), "error", "replica-1", time.Minute)
+
+	// Assert
+	suite.Nil(l)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *LeaseRepositorySuite) Test_Release_Success() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.DB)
+	l, err := r.Acquire(context.Background(), "release", "replica-1", time.Minute)
+	suite.NoError(err)
+
+	// Execute
+	err = r.Release(context.Background(), l)
+
+	// Assert another holder can take over right away
+	suite.NoError(err)
+	l2, err := r.Acquire(context.Background(), "release", "replica-2", time.Minute)
+	suite.NoError(err)
+	suite.Equal(int64(2), l2.Token)
+}
+
+func (suite *LeaseRepositorySuite) Test_Release_TakenOver_Success() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.DB)
+	stale := &aggregator.Lease{Name: "taken-over", Holder: "replica-1", Token: 1}
+	_, err := r.Acquire(context.Background(), "taken-over", "replica-2", time.Minute)
+	suite.NoError(err)
+
+	// Execute
+	err = r.Release(context.Background(), stale)
+
+	// Assert the lease of the new holder is left alone
+	suite.NoError(err)
+	l, err := r.Find(context.Background(), "taken-over")
+	suite.NoError(err)
+	suite.False(l.IsExpired(time.Now()))
+}
+
+func (suite *LeaseRepositorySuite) Test_Release_Error() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.BadDB)
+
+	// Execute
+	err := r.Release(context.Background(), &aggregator.Lease{Name: "error"})
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *LeaseRepositorySuite) Test_Find_Success() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.DB)
+	_, err := r.Acquire(context.Background(), "find", "replica-1", time.Minute)
+	suite.NoError(err)
+
+	// Execute
+	l, err := r.Find(context.Background(), "find")
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("replica-1", l.Holder)
+	suite.Equal(int64(1), l.Token)
+}
+
+func (suite *LeaseRepositorySuite) Test_Find_NotFound() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.DB)
+
+	// Execute
+	l, err := r.Find(context.Background(), "missing")
+
+	// Assert
+	suite.Nil(l)
+	suite.ErrorIs(err, infrastructure.ErrLeaseNotFound)
+}
+
+func (suite *LeaseRepositorySuite) Test_Find_Error() {
+	// Prepare
+	r := postgres.NewLeaseRepository(suite.BadDB)
+
+	// Execute
+	l, err := r.Find(context.Background(), "error")
+
+	// Assert
+	suite.Nil(l)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
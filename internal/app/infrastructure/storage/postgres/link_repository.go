@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type LinkRepository struct {
+	db *sqlx.DB
+}
+
+func NewLinkRepository(db *sqlx.DB) *LinkRepository {
+	return &LinkRepository{db: db}
+}
+
+func (r *LinkRepository) Save(ctx context.Context, l *aggregator.Link) error {
+	_, err := r.db.NamedExecContext(
+		ctx,
+		`INSERT INTO job_links (job_id, url, status, status_code, error, failures, checked_at)
+				VALUES (:job_id, :url, :status, :status_code, :error, :failures, :checked_at)
+				ON CONFLICT (job_id) DO UPDATE SET
+					url = EXCLUDED.url,
+					status = EXCLUDED.status,
+					status_code = EXCLUDED.status_code,
+					error = EXCLUDED.error,
+					failures = EXCLUDED.failures,
+					checked_at = EXCLUDED.checked_at`,
+		l,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save link of job %s: %w", l.JobID, err)
+	}
+
+	return nil
+}
+
+func (r *LinkRepository) GetActive(ctx context.Context) ([]*aggregator.Link, error) {
+	var results []*aggregator.Link
+	err := r.db.SelectContext(ctx, &results, "SELECT l.* FROM job_links l JOIN jobs j ON j.id = l.job_id WHERE j.status = $1", aggregator.JobStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get links of active jobs: %w", err)
+	}
+
+	return results, nil
+}
+
+func (r *LinkRepository) GetDeadByChannelID(ctx context.Context, chID uuid.UUID) ([]*aggregator.Link, error) {
+	var results []*aggregator.Link
+	err := r.db.SelectContext(ctx, &results, "SELECT l.* FROM job_links l JOIN jobs j ON j.id = l.job_id WHERE j.channel_id = $1 AND l.status = $2", chID, aggregator.LinkStatusDead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead links by channel id %s: %w", chID, err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,165 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLinkRepository(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(LinkRepositorySuite))
+}
+
+type LinkRepositorySuite struct {
+	testutils.PostgresSuite
+}
+
+func (suite *LinkRepositorySuite) insertJob(id, chID uuid.UUID, status aggregator.JobStatus) {
+	_, err := suite.DB.Exec("INSERT INTO jobs (id, channel_id, status, publish_status, url, title, description, source, location, remote, posted_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+		id,
+		chID,
+		status,
+		aggregator.JobPublishStatusPublished,
+		"https://example.com/job/id",
+		"Software Engineer",
+		"Job Description",
+		"Indeed",
+		"Amsterdam",
+		true,
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+	)
+	suite.NoError(err)
+}
+
+func (suite *LinkRepositorySuite) Test_Save_Success() {
+	// Prepare
+	id := uuid.New()
+	suite.insertJob(id, uuid.New(), aggregator.JobStatusActive)
+	r := postgres.NewLinkRepository(suite.DB)
+
+	// Execute
+	err := r.Save(context.Background(), &aggregator.Link{
+		JobID:      id,
+		URL:        "https://example.com/job/id",
+		Status:     aggregator.LinkStatusBroken,
+		StatusCode: 404,
+		Failures:   1,
+		CheckedAt:  time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	suite.NoError(err)
+	err = r.Save(context.Background(), &aggregator.Link{
+		JobID:      id,
+		URL:        "https://example.com/job/id",
+		Status:     aggregator.LinkStatusDead,
+		StatusCode: 410,
+		Failures:   2,
+		CheckedAt:  time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert state change
+	var dbLink aggregator.Link
+	err = suite.DB.Get(&dbLink, "SELECT * FROM job_links WHERE job_id = $1", id)
+	suite.NoError(err)
+	suite.Equal("https://example.com/job/id", dbLink.URL)
+	suite.Equal(aggregator.LinkStatusDead, dbLink.Status)
+	suite.Equal(410, dbLink.StatusCode)
+	suite.Equal(2, dbLink.Failures)
+	suite.Empty(dbLink.Error)
+	suite.True(dbLink.CheckedAt.Equal(time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)))
+}
+
+func (suite *LinkRepositorySuite) Test_Save_Error() {
+	// Prepare
+	r := postgres.NewLinkRepository(suite.BadDB)
+
+	// Execute
+	err := r.Save(context.Background(), &aggregator.Link{JobID: uuid.New()})
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *LinkRepositorySuite) Test_GetActive_Success() {
+	// Prepare
+	activeID := uuid.New()
+	inactiveID := uuid.New()
+	suite.insertJob(activeID, uuid.New(), aggregator.JobStatusActive)
+	suite.insertJob(inactiveID, uuid.New(), aggregator.JobStatusInactive)
+	r := postgres.NewLinkRepository(suite.DB)
+	for _, id := range []uuid.UUID{activeID, inactiveID} {
+		suite.NoError(r.Save(context.Background(), &aggregator.Link{JobID: id, URL: "https://example.com/job/id", Status: aggregator.LinkStatusAlive, StatusCode: 200, CheckedAt: time.Now()}))
+	}
+
+	// Execute
+	links, err := r.GetActive(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(links, 1)
+	suite.Equal(activeID, links[0].JobID)
+}
+
+func (suite *LinkRepositorySuite) Test_GetActive_Error() {
+	// Prepare
+	r := postgres.NewLinkRepository(suite.BadDB)
+
+	// Execute
+	links, err := r.GetActive(context.Background())
+
+	// Assert
+	suite.Nil(links)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *LinkRepositorySuite) Test_GetDeadByChannelID_Success() {
+	// Prepare
+	chID := uuid.New()
+	deadID := uuid.New()
+	brokenID := uuid.New()
+	otherID := uuid.New()
+	suite.insertJob(deadID, chID, aggregator.JobStatusInactive)
+	suite.insertJob(brokenID, chID, aggregator.JobStatusActive)
+	suite.insertJob(otherID, uuid.New(), aggregator.JobStatusInactive)
+	r := postgres.NewLinkRepository(suite.DB)
+	suite.NoError(r.Save(context.Background(), &aggregator.Link{JobID: deadID, URL: "https://example.com/job/id", Status: aggregator.LinkStatusDead, CheckedAt: time.Now()}))
+	suite.NoError(r.Save(context.Background(), &aggregator.Link{JobID: brokenID, URL: "https://example.com/job/id", Status: aggregator.LinkStatusBroken, CheckedAt: time.Now()}))
+	suite.NoError(r.Save(context.Background(), &aggregator.Link{JobID: otherID, URL: "https://example.com/job/id", Status: aggregator.LinkStatusDead, CheckedAt: time.Now()}))
+
+	// Execute
+	links, err := r.GetDeadByChannelID(context.Background(), chID)
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(links, 1)
+	suite.Equal(deadID, links[0].JobID)
+}
+
+func (suite *LinkRepositorySuite) Test_GetDeadByChannelID_Error() {
+	// Prepare
+	r := postgres.NewLinkRepository(suite.BadDB)
+
+	// Execute
+	links, err := r.GetDeadByChannelID(context.Background(), uuid.New())
+
+	// Assert
+	suite.Nil(links)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
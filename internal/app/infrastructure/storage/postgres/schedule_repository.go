@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/jmoiron/sqlx"
+)
+
+type ScheduleRepository struct {
+	db    *sqlx.DB
+	lease string
+}
+
+// NewScheduleRepository fences schedules with the tokens of the lease
+func NewScheduleRepository(db *sqlx.DB, lease string) *ScheduleRepository {
+	return &ScheduleRepository{db: db, lease: lease}
+}
+
+// Save rejects writes with a fencing token other than the current one of the lease,
+// or older than the one that wrote the schedule last
+func (r *ScheduleRepository) Save(ctx context.Context, s *aggregator.ScheduleState) error {
+	res, err := r.db.NamedExecContext(
+		ctx,
+		`INSERT INTO channel_schedules (channel_id, spec, next_run_at, last_run_at, last_import_id, fence)
+				SELECT CAST(:channel_id AS uuid), CAST(:spec AS text), CAST(:next_run_at AS timestamptz), CAST(:last_run_at AS timestamptz), CAST(:last_import_id AS uuid), leases.token
+				FROM leases
+				WHERE leases.name = :lease AND leases.token = :fence
+				ON CONFLICT (channel_id) DO UPDATE SET
+					spec = EXCLUDED.spec,
+					next_run_at = EXCLUDED.next_run_at,
+					last_run_at = EXCLUDED.last_run_at,
+					last_import_id = EXCLUDED.last_import_id,
+					fence = EXCLUDED.fence
+				WHERE channel_schedules.fence <= EXCLUDED.fence`,
+		struct {
+			aggregator.ScheduleState
+			Lease string `db:"lease"`
+		}{*s, r.lease},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save schedule of channel %s: %w", s.ChannelID, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to save schedule of channel %s: %w", s.ChannelID, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("failed to save schedule of channel %s with fencing token %d: %w", s.ChannelID, s.Fence, infrastructure.ErrStaleFence)
+	}
+
+	return nil
+}
+
+func (r *ScheduleRepository) All(ctx context.Context) ([]*aggregator.ScheduleState, error) {
+	var results []*aggregator.ScheduleState
+	err := r.db.SelectContext(ctx, &results, "SELECT * FROM channel_schedules ORDER BY next_run_at")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedules: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,190 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestScheduleRepository(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(ScheduleRepositorySuite))
+}
+
+type ScheduleRepositorySuite struct {
+	testutils.PostgresSuite
+}
+
+func (suite *ScheduleRepositorySuite) insertChannel(id uuid.UUID) {
+	cAt := time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)
+	_, err := suite.DB.Exec("INSERT INTO channels (id, name, integration, status, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		id,
+		"Channel Name",
+		aggregator.IntegrationArbeitnow,
+		aggregator.ChannelStatusActive,
+		cAt,
+		cAt,
+	)
+	suite.NoError(err)
+}
+
+// insertLease makes token the current one of a lease only this test uses
+func (suite *ScheduleRepositorySuite) insertLease(token int64) string {
+	name := "scheduler-" + uuid.NewString()
+	_, err := suite.DB.Exec("INSERT INTO leases (name, holder, token, acquired_at, renewed_at, expires_at) VALUES ($1, $2, $3, now(), now(), now() + interval '1 minute')",
+		name,
+		"replica-1",
+		token,
+	)
+	suite.NoError(err)
+
+	return name
+}
+
+func (suite *ScheduleRepositorySuite) setToken(lease string, token int64) {
+	_, err := suite.DB.Exec("UPDATE leases SET token = $1 WHERE name = $2", token, lease)
+	suite.NoError(err)
+}
+
+func (suite *ScheduleRepositorySuite) Test_Save_Success() {
+	// Prepare
+	chID := uuid.New()
+	iID := uuid.New()
+	suite.insertChannel(chID)
+	r := postgres.NewScheduleRepository(suite.DB, suite.insertLease(1))
+	err := r.Save(context.Background(), &aggregator.ScheduleState{
+		ChannelID: chID,
+		Spec:      "@daily",
+		NextRunAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		Fence:     1,
+	})
+	suite.NoError(err)
+
+	// Execute
+	err = r.Save(context.Background(), &aggregator.ScheduleState{
+		ChannelID:    chID,
+		Spec:         "@hourly",
+		NextRunAt:    time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC),
+		LastRunAt:    null.TimeFrom(time.Date(2025, 1, 2, 0, 0, 1, 0, time.UTC)),
+		LastImportID: uuid.NullUUID{UUID: iID, Valid: true},
+		Fence:        1,
+	})
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert state change
+	var dbState aggregator.ScheduleState
+	err = suite.DB.Get(&dbState, "SELECT * FROM channel_schedules WHERE channel_id = $1", chID)
+	suite.NoError(err)
+	suite.Equal("@hourly", dbState.Spec)
+	suite.True(dbState.NextRunAt.Equal(time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC)))
+	suite.True(dbState.LastRunAt.Time.Equal(time.Date(2025, 1, 2, 0, 0, 1, 0, time.UTC)))
+	suite.Equal(iID, dbState.LastImportID.UUID)
+}
+
+func (suite *ScheduleRepositorySuite) Test_Save_StaleFence_Fail() {
+	// Prepare
+	chID := uuid.New()
+	suite.insertChannel(chID)
+	lease := suite.insertLease(2)
+	r := postgres.NewScheduleRepository(suite.DB, lease)
+	suite.NoError(r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: chID, Spec: "@daily", NextRunAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), Fence: 2}))
+	suite.setToken(lease, 1)
+
+	// Execute
+	err := r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: chID, Spec: "@hourly", NextRunAt: time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC), Fence: 1})
+
+	// Assert
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+	var dbState aggregator.ScheduleState
+	err = suite.DB.Get(&dbState, "SELECT * FROM channel_schedules WHERE channel_id = $1", chID)
+	suite.NoError(err)
+	suite.Equal("@daily", dbState.Spec)
+	suite.Equal(int64(2), dbState.Fence)
+}
+
+func (suite *ScheduleRepositorySuite) Test_Save_StaleFence_UntouchedSchedule_Fail() {
+	// Prepare
+	ch1ID := uuid.New()
+	ch2ID := uuid.New()
+	suite.insertChannel(ch1ID)
+	suite.insertChannel(ch2ID)
+	lease := suite.insertLease(1)
+	r := postgres.NewScheduleRepository(suite.DB, lease)
+	suite.NoError(r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: ch1ID, Spec: "@daily", NextRunAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), Fence: 1}))
+	suite.NoError(r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: ch2ID, Spec: "@daily", NextRunAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), Fence: 1}))
+	// Another daemon took over, it did not write the schedules yet
+	suite.setToken(lease, 2)
+
+	// Execute
+	err := r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: ch2ID, Spec: "@daily", NextRunAt: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), Fence: 1})
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+	err = r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: uuid.New(), Spec: "@daily", NextRunAt: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), Fence: 1})
+
+	// Assert the deposed leader can neither claim an untouched schedule nor plan a new one
+	suite.ErrorIs(err, infrastructure.ErrStaleFence)
+	var dbState aggregator.ScheduleState
+	err = suite.DB.Get(&dbState, "SELECT * FROM channel_schedules WHERE channel_id = $1", ch2ID)
+	suite.NoError(err)
+	suite.True(dbState.NextRunAt.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)))
+	suite.Equal(int64(1), dbState.Fence)
+}
+
+func (suite *ScheduleRepositorySuite) Test_Save_Error() {
+	// Prepare
+	r := postgres.NewScheduleRepository(suite.BadDB, "scheduler")
+
+	// Execute
+	err := r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: uuid.New()})
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *ScheduleRepositorySuite) Test_All_Success() {
+	// Prepare
+	ch1ID := uuid.New()
+	ch2ID := uuid.New()
+	suite.insertChannel(ch1ID)
+	suite.insertChannel(ch2ID)
+	r := postgres.NewScheduleRepository(suite.DB, suite.insertLease(1))
+	suite.NoError(r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: ch1ID, Spec: "@daily", NextRunAt: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), Fence: 1}))
+	suite.NoError(r.Save(context.Background(), &aggregator.ScheduleState{ChannelID: ch2ID, Spec: "@daily", NextRunAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), Fence: 1}))
+
+	// Execute
+	states, err := r.All(context.Background())
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(states, 2)
+	suite.Equal(ch2ID, states[0].ChannelID)
+	suite.Equal(ch1ID, states[1].ChannelID)
+	suite.False(states[0].LastRunAt.Valid)
+	suite.False(states[0].LastImportID.Valid)
+}
+
+func (suite *ScheduleRepositorySuite) Test_All_Error() {
+	// Prepare
+	r := postgres.NewScheduleRepository(suite.BadDB, "scheduler")
+
+	// Execute
+	states, err := r.All(context.Background())
+
+	// Assert
+	suite.Nil(states)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
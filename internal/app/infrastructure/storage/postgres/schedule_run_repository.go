@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type ScheduleRunRepository struct {
+	db *sqlx.DB
+}
+
+func NewScheduleRunRepository(db *sqlx.DB) *ScheduleRunRepository {
+	return &ScheduleRunRepository{db: db}
+}
+
+func (r *ScheduleRunRepository) Save(ctx context.Context, run *aggregator.ScheduleRun) error {
+	_, err := r.db.NamedExecContext(
+		ctx,
+		`INSERT INTO schedule_runs (id, started_at, ended_at, scheduled, failed, results)
+				VALUES (:id, :started_at, :ended_at, :scheduled, :failed, :results)
+				ON CONFLICT (id) DO UPDATE SET
+					ended_at = EXCLUDED.ended_at,
+					scheduled = EXCLUDED.scheduled,
+					failed = EXCLUDED.failed,
+					results = EXCLUDED.results`,
+		run,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save schedule run %s: %w", run.ID, err)
+	}
+
+	return nil
+}
+
+func (r *ScheduleRunRepository) GetRecent(ctx context.Context, limit int) ([]*aggregator.ScheduleRun, error) {
+	var results []*aggregator.ScheduleRun
+	err := r.db.SelectContext(ctx, &results, "SELECT * FROM schedule_runs ORDER BY started_at DESC LIMIT $1", limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent schedule runs: %w", err)
+	}
+
+	return results, nil
+}
+
+func (r *ScheduleRunRepository) Find(ctx context.Context, id uuid.UUID) (*aggregator.ScheduleRun, error) {
+	var run aggregator.ScheduleRun
+	err := r.db.GetContext(ctx, &run, "SELECT * FROM schedule_runs WHERE id = $1", id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to find schedule run %s: %w", id, infrastructure.ErrScheduleRunNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to find schedule run %s: %w", id, err)
+	}
+
+	return &run, nil
+}
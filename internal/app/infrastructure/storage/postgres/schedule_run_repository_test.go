@@ -0,0 +1,130 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/storage/postgres"
+	"github.com/aviseu/jobs-backoffice/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestScheduleRunRepository(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(ScheduleRunRepositorySuite))
+}
+
+type ScheduleRunRepositorySuite struct {
+	testutils.PostgresSuite
+}
+
+func (suite *ScheduleRunRepositorySuite) Test_Save_Success() {
+	// Prepare
+	runID := uuid.New()
+	chID := uuid.New()
+	iID := uuid.New()
+	r := postgres.NewScheduleRunRepository(suite.DB)
+
+	// Execute
+	err := r.Save(context.Background(), &aggregator.ScheduleRun{
+		ID:        runID,
+		StartedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndedAt:   time.Date(2025, 1, 1, 0, 0, 1, 0, time.UTC),
+		Scheduled: 1,
+		Failed:    1,
+		Results: aggregator.ScheduleRunResults{
+			{ChannelID: chID, ChannelName: "channel 1", ImportID: uuid.NullUUID{UUID: iID, Valid: true}},
+			{ChannelID: uuid.New(), ChannelName: "channel 2", Error: "boom"},
+		},
+	})
+
+	// Assert result
+	suite.NoError(err)
+
+	// Assert state change
+	run, err := r.Find(context.Background(), runID)
+	suite.NoError(err)
+	suite.Equal(1, run.Scheduled)
+	suite.Equal(1, run.Failed)
+	suite.True(run.EndedAt.Equal(time.Date(2025, 1, 1, 0, 0, 1, 0, time.UTC)))
+	suite.Len(run.Results, 2)
+	suite.Equal(chID, run.Results[0].ChannelID)
+	suite.Equal(iID, run.Results[0].ImportID.UUID)
+	suite.False(run.Results[1].ImportID.Valid)
+	suite.Equal("boom", run.Results[1].Error)
+}
+
+func (suite *ScheduleRunRepositorySuite) Test_Save_Error() {
+	// Prepare
+	r := postgres.NewScheduleRunRepository(suite.BadDB)
+
+	// Execute
+	err := r.Save(context.Background(), &aggregator.ScheduleRun{ID: uuid.New()})
+
+	// Assert
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *ScheduleRunRepositorySuite) Test_GetRecent_Success() {
+	// Prepare
+	r := postgres.NewScheduleRunRepository(suite.DB)
+	old := &aggregator.ScheduleRun{ID: uuid.New(), StartedAt: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), EndedAt: time.Date(1990, 1, 1, 0, 0, 1, 0, time.UTC)}
+	recent := &aggregator.ScheduleRun{ID: uuid.New(), StartedAt: time.Date(2990, 1, 2, 0, 0, 0, 0, time.UTC), EndedAt: time.Date(2990, 1, 2, 0, 0, 1, 0, time.UTC)}
+	suite.NoError(r.Save(context.Background(), old))
+	suite.NoError(r.Save(context.Background(), recent))
+
+	// Execute
+	runs, err := r.GetRecent(context.Background(), 1)
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(runs, 1)
+	suite.Equal(recent.ID, runs[0].ID)
+	suite.Empty(runs[0].Results)
+}
+
+func (suite *ScheduleRunRepositorySuite) Test_GetRecent_Error() {
+	// Prepare
+	r := postgres.NewScheduleRunRepository(suite.BadDB)
+
+	// Execute
+	runs, err := r.GetRecent(context.Background(), 10)
+
+	// Assert
+	suite.Nil(runs)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
+
+func (suite *ScheduleRunRepositorySuite) Test_Find_NotFound() {
+	// Prepare
+	r := postgres.NewScheduleRunRepository(suite.DB)
+
+	// Execute
+	run, err := r.Find(context.Background(), uuid.New())
+
+	// Assert
+	suite.Nil(run)
+	suite.ErrorIs(err, infrastructure.ErrScheduleRunNotFound)
+}
+
+func (suite *ScheduleRunRepositorySuite) Test_Find_Error() {
+	// Prepare
+	r := postgres.NewScheduleRunRepository(suite.BadDB)
+
+	// Execute
+	run, err := r.Find(context.Background(), uuid.New())
+
+	// Assert
+	suite.Nil(run)
+	suite.Error(err)
+	suite.ErrorContains(err, "sql: database is closed")
+}
@@ -0,0 +1,91 @@
+package blocklist
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type keyword struct {
+	phrase string
+	entry  *aggregator.BlocklistEntry
+}
+
+type List struct {
+	companies map[string]*aggregator.BlocklistEntry
+	keywords  []*keyword
+}
+
+func New(entries []*aggregator.BlocklistEntry) *List {
+	l := &List{
+		companies: make(map[string]*aggregator.BlocklistEntry),
+		keywords:  make([]*keyword, 0),
+	}
+
+	for _, e := range entries {
+		switch e.Kind {
+		case aggregator.BlocklistKindCompany:
+			l.companies[Normalize(e.Value)] = e
+		case aggregator.BlocklistKindKeyword:
+			l.keywords = append(l.keywords, &keyword{phrase: Normalize(e.Value), entry: e})
+		}
+	}
+
+	return l
+}
+
+func (l *List) Match(j *aggregator.Job) *aggregator.BlocklistEntry {
+	if e, ok := l.companies[Normalize(j.Company)]; ok && j.Company != "" {
+		return e
+	}
+
+	if len(l.keywords) == 0 {
+		return nil
+	}
+
+	text := Normalize(j.Title + " " + j.DescriptionText)
+	for _, k := range l.keywords {
+		if containsWord(text, k.phrase) {
+			return k.entry
+		}
+	}
+
+	return nil
+}
+
+func Normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+func containsWord(text, phrase string) bool {
+	if phrase == "" {
+		return false
+	}
+
+	// Keywords only match whole words, "mlm" should not match "xmlm"
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], phrase)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		end := start + len(phrase)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
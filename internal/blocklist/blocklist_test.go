@@ -0,0 +1,51 @@
+package blocklist_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/blocklist"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBlocklist(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BlocklistSuite))
+}
+
+type BlocklistSuite struct {
+	suite.Suite
+}
+
+func (suite *BlocklistSuite) Test_Match_Company() {
+	// Prepare
+	e := &aggregator.BlocklistEntry{ID: uuid.New(), Kind: aggregator.BlocklistKindCompany, Value: "Scam  Agency GmbH"}
+	l := blocklist.New([]*aggregator.BlocklistEntry{e})
+
+	// Assert
+	suite.Equal(e, l.Match(&aggregator.Job{Company: "scam agency gmbh"}))
+	suite.Nil(l.Match(&aggregator.Job{Company: "Scam Agency"}))
+	suite.Nil(l.Match(&aggregator.Job{Title: "Scam Agency GmbH"}))
+}
+
+func (suite *BlocklistSuite) Test_Match_Keyword() {
+	// Prepare
+	mlm := &aggregator.BlocklistEntry{ID: uuid.New(), Kind: aggregator.BlocklistKindKeyword, Value: "MLM"}
+	phrase := &aggregator.BlocklistEntry{ID: uuid.New(), Kind: aggregator.BlocklistKindKeyword, Value: "Network Marketing"}
+	l := blocklist.New([]*aggregator.BlocklistEntry{mlm, phrase})
+
+	// Assert keywords match whole words in title and description
+	suite.Equal(mlm, l.Match(&aggregator.Job{Title: "Vertriebspartner (MLM)"}))
+	suite.Equal(phrase, l.Match(&aggregator.Job{Title: "Sales", DescriptionText: "Starte jetzt im network\nmarketing durch!"}))
+	suite.Nil(l.Match(&aggregator.Job{Title: "XMLM Engineer", DescriptionText: "Networkmarketing"}))
+	suite.Nil(l.Match(&aggregator.Job{Title: "Accountant"}))
+}
+
+func (suite *BlocklistSuite) Test_Match_Empty() {
+	// Prepare
+	l := blocklist.New(nil)
+
+	// Assert
+	suite.Nil(l.Match(&aggregator.Job{Title: "Anything", Company: ""}))
+}
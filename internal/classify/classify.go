@@ -0,0 +1,211 @@
+package classify
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+const (
+	confidenceProvider      = 0.95
+	confidenceTitle         = 0.9
+	confidenceExperience    = 0.8
+	confidenceProviderLevel = 0.8
+	confidenceDerived       = 0.7
+	confidenceDescription   = 0.6
+	confidenceDefault       = 0.3
+
+	// Seniority is derived from the years of experience that are asked for
+	juniorMaxYears = 1
+	seniorMinYears = 5
+)
+
+type cue[T any] struct {
+	value    T
+	words    []string
+	suffixes []string
+}
+
+// Cues are ordered by precedence, the first one that matches wins
+var (
+	seniorityTitleCues = []cue[aggregator.Seniority]{
+		{aggregator.SeniorityIntern, []string{"intern", "interns", "internship", "praktikum", "praktikant", "praktikantin", "werkstudent", "werkstudentin", "working student", "azubi", "auszubildende", "auszubildender", "ausbildung", "apprentice", "apprenticeship"}, []string{"praktikum", "praktikant", "praktikantin", "werkstudent", "werkstudentin"}},
+		{aggregator.SeniorityLead, []string{"lead", "teamlead", "principal", "head of", "director", "direktor", "direktorin", "vp", "vice president", "chief", "cto", "cio", "cfo", "leiter", "leiterin", "leitung", "teamleitung", "abteilungsleitung", "bereichsleitung"}, []string{"teamleiter", "teamleiterin", "abteilungsleiter", "abteilungsleiterin", "bereichsleiter", "bereichsleiterin", "gruppenleiter", "gruppenleiterin", "filialleiter", "filialleiterin", "standortleiter", "standortleiterin"}},
+		{aggregator.SenioritySenior, []string{"senior", "sr", "expert", "experte", "expertin"}, nil},
+		{aggregator.SeniorityJunior, []string{"junior", "jr", "entry level", "graduate", "absolvent", "absolventin", "berufseinsteiger", "berufseinsteigerin", "einsteiger", "einsteigerin", "trainee", "young professional"}, nil},
+		{aggregator.SeniorityMid, []string{"mid", "mid level", "midlevel", "intermediate", "experienced", "professional"}, nil},
+	}
+
+	seniorityLeadCues = []cue[aggregator.Seniority]{
+		{aggregator.SeniorityLead, []string{"führungserfahrung", "personalverantwortung", "disziplinarische führung", "people management", "lead a team", "leading a team", "manage a team", "managing a team"}, nil},
+	}
+
+	seniorityDescriptionCues = []cue[aggregator.Seniority]{
+		{aggregator.SeniorityJunior, []string{"berufseinsteiger", "berufseinsteigerin", "erste berufserfahrung", "keine berufserfahrung", "no experience required", "entry level", "recent graduate", "graduates"}, nil},
+		{aggregator.SeniorityMid, []string{"mehrjährige berufserfahrung", "mehrjährige erfahrung", "several years of experience", "proven experience"}, nil},
+	}
+
+	employmentTitleCues = []cue[aggregator.EmploymentType]{
+		{aggregator.EmploymentTypeWorkingStudent, []string{"werkstudent", "werkstudentin", "working student", "studentische hilfskraft"}, []string{"werkstudent", "werkstudentin"}},
+		{aggregator.EmploymentTypeInternship, []string{"intern", "interns", "internship", "praktikum", "praktikant", "praktikantin"}, []string{"praktikum", "praktikant", "praktikantin"}},
+		{aggregator.EmploymentTypeContract, []string{"contract", "contractor", "freelance", "freelancer", "freiberuflich", "freiberufler", "interim"}, nil},
+		{aggregator.EmploymentTypeFullTime, []string{"full time", "fulltime", "vollzeit", "permanent", "festanstellung"}, nil},
+		{aggregator.EmploymentTypePartTime, []string{"part time", "parttime", "teilzeit", "minijob", "mini job"}, nil},
+	}
+
+	// A plain "contract" is left out, descriptions mention permanent contracts as well
+	employmentDescriptionCues = []cue[aggregator.EmploymentType]{
+		{aggregator.EmploymentTypeWorkingStudent, []string{"werkstudent", "werkstudentin", "working student", "werkstudententätigkeit"}, nil},
+		{aggregator.EmploymentTypeInternship, []string{"internship", "pflichtpraktikum", "praktikumsdauer"}, nil},
+		{aggregator.EmploymentTypeContract, []string{"contractor", "freelance", "freelancer", "freiberuflich", "freiberufler", "contract role", "contract position", "auf projektbasis"}, nil},
+		{aggregator.EmploymentTypeFullTime, []string{"full time", "vollzeit", "festanstellung", "unbefristet", "unbefristete", "unbefristeten", "permanent position"}, nil},
+		{aggregator.EmploymentTypePartTime, []string{"part time", "teilzeit", "minijob"}, nil},
+	}
+
+	// Job types as sent by providers, keys are lower case
+	providerSeniority = map[string]aggregator.Seniority{
+		"internship":                 aggregator.SeniorityIntern,
+		"praktikum":                  aggregator.SeniorityIntern,
+		"working student":            aggregator.SeniorityIntern,
+		"werkstudent":                aggregator.SeniorityIntern,
+		"ausbildung":                 aggregator.SeniorityIntern,
+		"entry level":                aggregator.SeniorityJunior,
+		"berufseinsteiger":           aggregator.SeniorityJunior,
+		"junior":                     aggregator.SeniorityJunior,
+		"mid level":                  aggregator.SeniorityMid,
+		"berufserfahren":             aggregator.SeniorityMid,
+		"professional / experienced": aggregator.SeniorityMid,
+		"senior level":               aggregator.SenioritySenior,
+		"senior":                     aggregator.SenioritySenior,
+		"manager":                    aggregator.SeniorityLead,
+		"führungskraft":              aggregator.SeniorityLead,
+		"executive":                  aggregator.SeniorityLead,
+	}
+
+	providerEmployment = map[string]aggregator.EmploymentType{
+		"full time":       aggregator.EmploymentTypeFullTime,
+		"vollzeit":        aggregator.EmploymentTypeFullTime,
+		"part time":       aggregator.EmploymentTypePartTime,
+		"teilzeit":        aggregator.EmploymentTypePartTime,
+		"contract":        aggregator.EmploymentTypeContract,
+		"freelance":       aggregator.EmploymentTypeContract,
+		"internship":      aggregator.EmploymentTypeInternship,
+		"praktikum":       aggregator.EmploymentTypeInternship,
+		"working student": aggregator.EmploymentTypeWorkingStudent,
+		"werkstudent":     aggregator.EmploymentTypeWorkingStudent,
+	}
+
+	experienceRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)(\d{1,2})\s*\+?\s*(?:(?:-|–|bis|to)\s*\d{1,2}\s*\+?\s*)?(?:years?|yrs?|jahr|jahre|jahren)\b[^.]{0,40}?(?:experience|erfahrung)`),
+		regexp.MustCompile(`(?i)(?:experience|erfahrung)[^.]{0,40}?(\d{1,2})\s*\+?\s*(?:years?|yrs?|jahr|jahre|jahren)\b`),
+	}
+)
+
+func Classify(title, description string, jobTypes []string) aggregator.Classification {
+	var c aggregator.Classification
+	c.Seniority, c.SeniorityConfidence = seniority(title, description, jobTypes)
+	c.EmploymentType, c.EmploymentTypeConfidence = employmentType(title, description, jobTypes)
+
+	// Students are at the start of their career, whatever else the posting says
+	if c.SeniorityConfidence < confidenceDerived && (c.EmploymentType == aggregator.EmploymentTypeInternship || c.EmploymentType == aggregator.EmploymentTypeWorkingStudent) {
+		c.Seniority, c.SeniorityConfidence = aggregator.SeniorityIntern, confidenceDerived
+	}
+
+	return c
+}
+
+func seniority(title, description string, jobTypes []string) (aggregator.Seniority, float64) {
+	// Titles are more specific than the coarse experience levels of providers
+	if s, ok := match(seniorityTitleCues, title); ok {
+		return s, confidenceTitle
+	}
+	for _, t := range jobTypes {
+		if s, ok := providerSeniority[normalize(t)]; ok {
+			return s, confidenceProviderLevel
+		}
+	}
+
+	if s, ok := match(seniorityLeadCues, description); ok {
+		return s, confidenceDescription
+	}
+	if years, ok := experience(description); ok {
+		switch {
+		case years <= juniorMaxYears:
+			return aggregator.SeniorityJunior, confidenceExperience
+		case years >= seniorMinYears:
+			return aggregator.SenioritySenior, confidenceExperience
+		default:
+			return aggregator.SeniorityMid, confidenceExperience
+		}
+	}
+	if s, ok := match(seniorityDescriptionCues, description); ok {
+		return s, confidenceDescription
+	}
+
+	return aggregator.SeniorityMid, confidenceDefault
+}
+
+func employmentType(title, description string, jobTypes []string) (aggregator.EmploymentType, float64) {
+	// Providers state the employment type explicitly
+	for _, t := range jobTypes {
+		if e, ok := providerEmployment[normalize(t)]; ok {
+			return e, confidenceProvider
+		}
+	}
+	if e, ok := match(employmentTitleCues, title); ok {
+		return e, confidenceTitle
+	}
+	if e, ok := match(employmentDescriptionCues, description); ok {
+		return e, confidenceDescription
+	}
+
+	return aggregator.EmploymentTypeFullTime, confidenceDefault
+}
+
+func experience(text string) (int, bool) {
+	for _, r := range experienceRegexes {
+		if m := r.FindStringSubmatch(text); m != nil {
+			years, err := strconv.Atoi(m[1])
+			if err == nil {
+				return years, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func match[T any](cues []cue[T], text string) (T, bool) {
+	tokens := tokenize(text)
+	padded := " " + strings.Join(tokens, " ") + " "
+	for _, c := range cues {
+		for _, w := range c.words {
+			if strings.Contains(padded, " "+w+" ") {
+				return c.value, true
+			}
+		}
+		for _, s := range c.suffixes {
+			for _, t := range tokens {
+				if strings.HasSuffix(t, s) {
+					return c.value, true
+				}
+			}
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(s, "-", " "))), " ")
+}
@@ -0,0 +1,103 @@
+package classify_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/classify"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ClassifySuite))
+}
+
+type ClassifySuite struct {
+	suite.Suite
+}
+
+func (suite *ClassifySuite) Test_Classify_Title() {
+	cases := []struct {
+		title      string
+		seniority  aggregator.Seniority
+		employment aggregator.EmploymentType
+	}{
+		{"Senior Backend Engineer (Go)", aggregator.SenioritySenior, aggregator.EmploymentTypeFullTime},
+		{"Sr. Accountant - Part-Time", aggregator.SenioritySenior, aggregator.EmploymentTypePartTime},
+		{"Teamleiter Buchhaltung (m/w/d) in Vollzeit", aggregator.SeniorityLead, aggregator.EmploymentTypeFullTime},
+		{"Head of Engineering", aggregator.SeniorityLead, aggregator.EmploymentTypeFullTime},
+		{"Junior Data Analyst (Freelance)", aggregator.SeniorityJunior, aggregator.EmploymentTypeContract},
+		{"Werkstudent Marketing (m/w/d)", aggregator.SeniorityIntern, aggregator.EmploymentTypeWorkingStudent},
+		{"Pflichtpraktikum im Controlling", aggregator.SeniorityIntern, aggregator.EmploymentTypeInternship},
+		{"Mid-Level Frontend Developer", aggregator.SeniorityMid, aggregator.EmploymentTypeFullTime},
+	}
+
+	for _, c := range cases {
+		// Execute
+		result := classify.Classify(c.title, "", nil)
+
+		// Assert
+		suite.Equal(c.seniority, result.Seniority, c.title)
+		suite.Equal(c.employment, result.EmploymentType, c.title)
+	}
+}
+
+func (suite *ClassifySuite) Test_Classify_Provider() {
+	// Execute
+	result := classify.Classify("Accountant", "", []string{"Part Time", "Berufserfahren"})
+
+	// Assert provider job types are used with their confidence
+	suite.Equal(aggregator.Classification{
+		Seniority:                aggregator.SeniorityMid,
+		SeniorityConfidence:      0.8,
+		EmploymentType:           aggregator.EmploymentTypePartTime,
+		EmploymentTypeConfidence: 0.95,
+	}, result)
+}
+
+func (suite *ClassifySuite) Test_Classify_TitleWinsOverProviderLevel() {
+	// Execute
+	result := classify.Classify("Senior Accountant", "", []string{"Professional / Experienced"})
+
+	// Assert
+	suite.Equal(aggregator.SenioritySenior, result.Seniority)
+	suite.Equal(0.9, result.SeniorityConfidence)
+}
+
+func (suite *ClassifySuite) Test_Classify_Description() {
+	cases := []struct {
+		description string
+		seniority   aggregator.Seniority
+		employment  aggregator.EmploymentType
+	}{
+		{"You bring 5+ years of experience with Go.", aggregator.SenioritySenior, aggregator.EmploymentTypeFullTime},
+		{"Mindestens 3 Jahre Berufserfahrung, Anstellung in Teilzeit.", aggregator.SeniorityMid, aggregator.EmploymentTypePartTime},
+		{"Berufserfahrung von 1 Jahr ist von Vorteil.", aggregator.SeniorityJunior, aggregator.EmploymentTypeFullTime},
+		{"Ideal für Berufseinsteiger, unbefristete Festanstellung.", aggregator.SeniorityJunior, aggregator.EmploymentTypeFullTime},
+		{"Sie haben Führungserfahrung und 2 Jahre Erfahrung.", aggregator.SeniorityLead, aggregator.EmploymentTypeFullTime},
+		{"Als Werkstudent unterstützt du unser Team.", aggregator.SeniorityIntern, aggregator.EmploymentTypeWorkingStudent},
+	}
+
+	for _, c := range cases {
+		// Execute
+		result := classify.Classify("Accountant", c.description, nil)
+
+		// Assert
+		suite.Equal(c.seniority, result.Seniority, c.description)
+		suite.Equal(c.employment, result.EmploymentType, c.description)
+	}
+}
+
+func (suite *ClassifySuite) Test_Classify_Default() {
+	// Execute
+	result := classify.Classify("Accountant", "Join our team.", nil)
+
+	// Assert jobs without cues get a guess with a low confidence
+	suite.Equal(aggregator.Classification{
+		Seniority:                aggregator.SeniorityMid,
+		SeniorityConfidence:      0.3,
+		EmploymentType:           aggregator.EmploymentTypeFullTime,
+		EmploymentTypeConfidence: 0.3,
+	}, result)
+}
@@ -0,0 +1,170 @@
+package deadline
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gopkg.in/guregu/null.v3"
+)
+
+// Text following a keyword that is searched for the date
+const suffixLength = 40
+
+var (
+	keywordRegex = regexp.MustCompile(`(?i)\b(?:application deadline|deadline|closing date|apply (?:by|before|until)|applications? (?:close|closes|by|until)|bewerbungsfrist|bewerbungsschluss|bewerbungen? bis|einsendeschluss)\b`)
+
+	months = map[string]time.Month{
+		"january":   time.January,
+		"januar":    time.January,
+		"jänner":    time.January,
+		"jan":       time.January,
+		"february":  time.February,
+		"februar":   time.February,
+		"feb":       time.February,
+		"march":     time.March,
+		"märz":      time.March,
+		"mar":       time.March,
+		"april":     time.April,
+		"apr":       time.April,
+		"may":       time.May,
+		"mai":       time.May,
+		"june":      time.June,
+		"juni":      time.June,
+		"jun":       time.June,
+		"july":      time.July,
+		"juli":      time.July,
+		"jul":       time.July,
+		"august":    time.August,
+		"aug":       time.August,
+		"september": time.September,
+		"sept":      time.September,
+		"sep":       time.September,
+		"october":   time.October,
+		"oktober":   time.October,
+		"oct":       time.October,
+		"okt":       time.October,
+		"november":  time.November,
+		"nov":       time.November,
+		"december":  time.December,
+		"dezember":  time.December,
+		"dec":       time.December,
+		"dez":       time.December,
+	}
+
+	monthNames = monthAlternation()
+
+	isoRegex      = regexp.MustCompile(`\b(\d{4})-(\d{1,2})-(\d{1,2})\b`)
+	numericRegex  = regexp.MustCompile(`\b(\d{1,2})[./](\d{1,2})[./](\d{4})\b`)
+	dayMonthRegex = regexp.MustCompile(`(?i)\b(\d{1,2})\.?\s+` + monthNames + `\.?\s+(\d{4})\b`)
+	monthDayRegex = regexp.MustCompile(`(?i)\b` + monthNames + `\.?\s+(\d{1,2})(?:st|nd|rd|th)?,?\s+(\d{4})\b`)
+)
+
+func monthAlternation() string {
+	names := make([]string, 0, len(months))
+	for name := range months {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+
+	// Longer names go first so they are matched before their prefixes
+	slices.SortFunc(names, func(a, b string) int {
+		if d := utf8.RuneCountInString(b) - utf8.RuneCountInString(a); d != 0 {
+			return d
+		}
+		return strings.Compare(a, b)
+	})
+
+	return `(` + strings.Join(names, "|") + `)`
+}
+
+// Parse finds an application deadline mentioned in the texts. The deadline day itself is still open, the
+// returned time is the start of the day after.
+func Parse(texts ...string) null.Time {
+	for _, text := range texts {
+		if t, ok := parse(text); ok {
+			return null.TimeFrom(t)
+		}
+	}
+
+	return null.NewTime(time.Time{}, false)
+}
+
+func parse(text string) (time.Time, bool) {
+	for _, loc := range keywordRegex.FindAllStringIndex(text, -1) {
+		if t, ok := date(window(text[loc[1]:])); ok {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func window(text string) string {
+	n := 0
+	for i := range text {
+		if n == suffixLength {
+			return text[:i]
+		}
+		n++
+	}
+
+	return text
+}
+
+// date returns the first date in the text, in any of the supported notations
+func date(text string) (time.Time, bool) {
+	first := -1
+	var result time.Time
+	try := func(re *regexp.Regexp, build func(m []string) (time.Time, bool)) {
+		loc := re.FindStringSubmatchIndex(text)
+		if loc == nil || (first >= 0 && loc[0] >= first) {
+			return
+		}
+		m := make([]string, len(loc)/2)
+		for i := range m {
+			m[i] = text[loc[2*i]:loc[2*i+1]]
+		}
+		if t, ok := build(m); ok {
+			first = loc[0]
+			result = t
+		}
+	}
+
+	try(isoRegex, func(m []string) (time.Time, bool) { return day(m[1], month(m[2]), m[3]) })
+	try(numericRegex, func(m []string) (time.Time, bool) { return day(m[3], month(m[2]), m[1]) })
+	try(dayMonthRegex, func(m []string) (time.Time, bool) { return day(m[3], months[strings.ToLower(m[2])], m[1]) })
+	try(monthDayRegex, func(m []string) (time.Time, bool) { return day(m[3], months[strings.ToLower(m[1])], m[2]) })
+
+	return result, first >= 0
+}
+
+func month(s string) time.Month {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+
+	return time.Month(n)
+}
+
+func day(year string, m time.Month, d string) (time.Time, bool) {
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return time.Time{}, false
+	}
+	n, err := strconv.Atoi(d)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// Dates that do not exist, like the 31st of February, are ignored instead of rolled over
+	t := time.Date(y, m, n, 0, 0, 0, 0, time.UTC)
+	if m < time.January || m > time.December || t.Year() != y || t.Month() != m || t.Day() != n {
+		return time.Time{}, false
+	}
+
+	return t.AddDate(0, 0, 1), true
+}
@@ -0,0 +1,77 @@
+package deadline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/deadline"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ParseSuite))
+}
+
+type ParseSuite struct {
+	suite.Suite
+}
+
+func (suite *ParseSuite) Test_Parse_Success() {
+	cases := []struct {
+		text     string
+		expected time.Time
+	}{
+		{
+			text:     "Application deadline: 2025-12-31",
+			expected: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			text:     "Bewerbungsfrist: 15.03.2026, wir freuen uns auf Sie",
+			expected: time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			text:     "Bewerbungsschluss ist der 1. März 2026.",
+			expected: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			text:     "Please apply by December 5th, 2025 at the latest",
+			expected: time.Date(2025, 12, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			text:     "Applications close on 30 Nov 2025",
+			expected: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			text:     "Start 01.02.2026. Bewerbungen bis zum 10/01/2026 an jobs@example.com",
+			expected: time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, c := range cases {
+		suite.Equal(null.TimeFrom(c.expected), deadline.Parse(c.text), c.text)
+	}
+}
+
+func (suite *ParseSuite) Test_Parse_NotFound() {
+	cases := []string{
+		"",
+		"Start date: 01.02.2026",
+		"Deadline-driven environment with a long history, founded 12.05.2010",
+		"Application deadline: 31.02.2026",
+		"Deadline: asap",
+	}
+
+	for _, c := range cases {
+		suite.False(deadline.Parse(c).Valid, c)
+	}
+}
+
+func (suite *ParseSuite) Test_Parse_MultipleTexts_Success() {
+	// Execute
+	result := deadline.Parse("Senior Engineer", "Closing date: 2026-04-30")
+
+	// Assert
+	suite.Equal(null.TimeFrom(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)), result)
+}
@@ -0,0 +1,131 @@
+package gazetteer
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:embed data/cities.tsv
+var citiesData string
+
+//go:embed data/countries.tsv
+var countriesData string
+
+type city struct {
+	name       string
+	region     string
+	country    string
+	latitude   float64
+	longitude  float64
+	population int
+}
+
+type country struct {
+	code   string
+	eu     bool
+	europe bool
+}
+
+type index struct {
+	cities    map[string][]*city
+	countries map[string]*country
+	codes     map[string]*country
+}
+
+var load = sync.OnceValue(func() *index {
+	idx := &index{
+		cities:    make(map[string][]*city),
+		countries: make(map[string]*country),
+		codes:     make(map[string]*country),
+	}
+
+	// The data is embedded, so a malformed line is a programming error
+	for _, f := range records(countriesData, 4) {
+		c := &country{code: f[0], eu: f[2] == "1", europe: f[3] == "1"}
+		idx.codes[c.code] = c
+		for _, name := range strings.Split(f[1], ",") {
+			idx.countries[fold(name)] = c
+		}
+	}
+
+	for _, f := range records(citiesData, 7) {
+		lat, err := strconv.ParseFloat(f[4], 64)
+		if err != nil {
+			panic(fmt.Sprintf("invalid latitude for %s: %s", f[0], err))
+		}
+		lon, err := strconv.ParseFloat(f[5], 64)
+		if err != nil {
+			panic(fmt.Sprintf("invalid longitude for %s: %s", f[0], err))
+		}
+		pop, err := strconv.Atoi(f[6])
+		if err != nil {
+			panic(fmt.Sprintf("invalid population for %s: %s", f[0], err))
+		}
+		if _, ok := idx.codes[f[3]]; !ok {
+			panic(fmt.Sprintf("unknown country %s for %s", f[3], f[0]))
+		}
+
+		c := &city{name: f[0], region: f[2], country: f[3], latitude: lat, longitude: lon, population: pop}
+		names := []string{f[0]}
+		if f[1] != "" {
+			names = append(names, strings.Split(f[1], ",")...)
+		}
+		for _, name := range names {
+			idx.cities[fold(name)] = append(idx.cities[fold(name)], c)
+		}
+	}
+
+	// The most populated city wins when a name is ambiguous
+	for _, cc := range idx.cities {
+		slices.SortFunc(cc, func(a, b *city) int { return b.population - a.population })
+	}
+
+	return idx
+})
+
+func records(data string, fields int) [][]string {
+	result := make([][]string, 0)
+	s := bufio.NewScanner(strings.NewReader(data))
+	for s.Scan() {
+		line := s.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		f := strings.Split(line, "\t")
+		if len(f) != fields {
+			panic(fmt.Sprintf("expected %d fields, got %d: %s", fields, len(f), line))
+		}
+		result = append(result, f)
+	}
+
+	return result
+}
+
+func fold(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(strings.TrimSpace(s))) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case r == 'ß':
+			b.WriteString("ss")
+		case r == 'ø':
+			b.WriteRune('o')
+		case r == 'ł':
+			b.WriteRune('l')
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,122 @@
+package gazetteer
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"gopkg.in/guregu/null.v3"
+)
+
+var (
+	remoteWords    = []string{"remote", "homeoffice", "home office", "work from home", "wfh", "fernarbeit", "telearbeit"}
+	worldwideWords = []string{"worldwide", "anywhere", "global", "weltweit"}
+	euWords        = []string{"eu", "european union"}
+	europeWords    = []string{"europe", "europa", "european"}
+)
+
+func Resolve(raw string, remote bool) aggregator.Geo {
+	idx := load()
+
+	var co *country
+	candidates := make([]*city, 0)
+	words := make([]string, 0)
+
+	// Parts like "Berlin, Germany" or "Remote - EU" are resolved one by one
+	for _, part := range strings.FieldsFunc(raw, isSeparator) {
+		part = strings.TrimSpace(part)
+		ww := strings.Fields(fold(part))
+		words = append(words, ww...)
+
+		// Country codes only count when written as such ("DE"), not as words ("de")
+		if c, ok := idx.codes[part]; ok {
+			if co == nil {
+				co = c
+			}
+			continue
+		}
+
+		// Longer names win over the words they consist of ("Frankfurt am Main")
+		used := make([]bool, len(ww))
+		for n := len(ww); n > 0; n-- {
+			for i := 0; i+n <= len(ww); i++ {
+				if slices.Contains(used[i:i+n], true) {
+					continue
+				}
+
+				name := strings.Join(ww[i:i+n], " ")
+				if c, ok := idx.countries[name]; ok {
+					if co == nil {
+						co = c
+					}
+				} else if cc, ok := idx.cities[name]; ok {
+					candidates = append(candidates, cc...)
+				} else {
+					continue
+				}
+
+				for j := i; j < i+n; j++ {
+					used[j] = true
+				}
+			}
+		}
+	}
+
+	g := aggregator.Geo{}
+	if c := pick(candidates, co); c != nil {
+		g.City = c.name
+		g.Region = c.region
+		g.CountryCode = c.country
+		g.Latitude = null.FloatFrom(c.latitude)
+		g.Longitude = null.FloatFrom(c.longitude)
+		co = idx.codes[c.country]
+	} else if co != nil {
+		g.CountryCode = co.code
+	}
+
+	text := " " + strings.Join(words, " ") + " "
+	switch {
+	case contains(text, worldwideWords):
+		g.RemoteScope = aggregator.RemoteScopeWorldwide
+	case contains(text, euWords):
+		g.RemoteScope = aggregator.RemoteScopeEU
+	case contains(text, europeWords):
+		g.RemoteScope = aggregator.RemoteScopeEurope
+	case remote || contains(text, remoteWords):
+		g.RemoteScope = aggregator.RemoteScopeUnspecified
+		if co != nil {
+			g.RemoteScope = aggregator.RemoteScopeCountry
+		}
+	}
+
+	return g
+}
+
+func pick(candidates []*city, co *country) *city {
+	var best *city
+	for _, c := range candidates {
+		if co != nil && c.country != co.code {
+			continue
+		}
+		if best == nil || c.population > best.population {
+			best = c
+		}
+	}
+
+	return best
+}
+
+func isSeparator(r rune) bool {
+	return strings.ContainsRune(",;/|()[]–—", r) || r == '-' || unicode.IsControl(r)
+}
+
+func contains(text string, words []string) bool {
+	for _, w := range words {
+		if strings.Contains(text, " "+w+" ") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,93 @@
+package gazetteer_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/gazetteer"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ResolveSuite))
+}
+
+type ResolveSuite struct {
+	suite.Suite
+}
+
+func (suite *ResolveSuite) Test_Resolve_Cities() {
+	cases := []struct {
+		raw      string
+		remote   bool
+		expected aggregator.Geo
+	}{
+		{
+			raw:      "Berlin",
+			expected: aggregator.Geo{City: "Berlin", Region: "Berlin", CountryCode: "DE", Latitude: null.FloatFrom(52.52437), Longitude: null.FloatFrom(13.41053)},
+		},
+		{
+			raw:      "München, Deutschland",
+			expected: aggregator.Geo{City: "Munich", Region: "Bavaria", CountryCode: "DE", Latitude: null.FloatFrom(48.13743), Longitude: null.FloatFrom(11.57549)},
+		},
+		{
+			raw:      "Frankfurt am Main",
+			expected: aggregator.Geo{City: "Frankfurt am Main", Region: "Hesse", CountryCode: "DE", Latitude: null.FloatFrom(50.11552), Longitude: null.FloatFrom(8.68417)},
+		},
+		{
+			raw:      "Munich",
+			remote:   true,
+			expected: aggregator.Geo{City: "Munich", Region: "Bavaria", CountryCode: "DE", Latitude: null.FloatFrom(48.13743), Longitude: null.FloatFrom(11.57549), RemoteScope: aggregator.RemoteScopeCountry},
+		},
+		{
+			raw:      "Zürich, CH",
+			expected: aggregator.Geo{City: "Zurich", Region: "Zurich", CountryCode: "CH", Latitude: null.FloatFrom(47.36667), Longitude: null.FloatFrom(8.55)},
+		},
+	}
+
+	for _, c := range cases {
+		suite.Equal(c.expected, gazetteer.Resolve(c.raw, c.remote), c.raw)
+	}
+}
+
+func (suite *ResolveSuite) Test_Resolve_Countries() {
+	suite.Equal(aggregator.Geo{CountryCode: "DE"}, gazetteer.Resolve("Germany", false))
+	suite.Equal(aggregator.Geo{CountryCode: "AT"}, gazetteer.Resolve("Österreich", false))
+	suite.Equal(aggregator.Geo{CountryCode: "GB"}, gazetteer.Resolve("UK", false))
+}
+
+func (suite *ResolveSuite) Test_Resolve_RemoteScope() {
+	cases := []struct {
+		raw      string
+		remote   bool
+		expected aggregator.RemoteScope
+	}{
+		{raw: "Remote - EU", expected: aggregator.RemoteScopeEU},
+		{raw: "Remote (Europe)", expected: aggregator.RemoteScopeEurope},
+		{raw: "Remote, worldwide", expected: aggregator.RemoteScopeWorldwide},
+		{raw: "Anywhere", expected: aggregator.RemoteScopeWorldwide},
+		{raw: "Remote / Germany", expected: aggregator.RemoteScopeCountry},
+		{raw: "Remote", expected: aggregator.RemoteScopeUnspecified},
+		{raw: "", remote: true, expected: aggregator.RemoteScopeUnspecified},
+		{raw: "Berlin", expected: aggregator.RemoteScopeNone},
+	}
+
+	for _, c := range cases {
+		suite.Equal(c.expected, gazetteer.Resolve(c.raw, c.remote).RemoteScope, c.raw)
+	}
+}
+
+func (suite *ResolveSuite) Test_Resolve_CityOutsideCountry() {
+	// Prepare
+	g := gazetteer.Resolve("Paris, Germany", false)
+
+	// Assert cities are only looked up within the given country
+	suite.Equal(aggregator.Geo{CountryCode: "DE"}, g)
+}
+
+func (suite *ResolveSuite) Test_Resolve_Unknown() {
+	suite.Equal(aggregator.Geo{}, gazetteer.Resolve("Somewhere over the rainbow", false))
+	suite.Equal(aggregator.Geo{}, gazetteer.Resolve("", false))
+}
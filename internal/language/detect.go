@@ -0,0 +1,134 @@
+package language
+
+import (
+	"embed"
+	"fmt"
+	"math"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	// Texts with fewer trigrams are too short to tell languages apart
+	minTrigrams = 20
+
+	// The beginning of a posting is enough to detect its language
+	maxLetters = 2000
+)
+
+//go:embed data/*.txt
+var corpora embed.FS
+
+type model struct {
+	language string
+	logProb  map[string]float64
+	unseen   float64
+}
+
+var load = sync.OnceValue(func() []*model {
+	entries, err := corpora.ReadDir("data")
+	if err != nil {
+		panic(fmt.Sprintf("failed to read language corpora: %s", err))
+	}
+
+	// Every corpus is turned into a trigram model with add-one smoothing
+	models := make([]*model, 0, len(entries))
+	for _, e := range entries {
+		b, err := corpora.ReadFile(path.Join("data", e.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("failed to read language corpus %s: %s", e.Name(), err))
+		}
+
+		counts := make(map[string]int)
+		total := 0
+		for _, g := range trigrams(string(b), math.MaxInt) {
+			counts[g]++
+			total++
+		}
+
+		m := &model{
+			language: strings.TrimSuffix(e.Name(), ".txt"),
+			logProb:  make(map[string]float64, len(counts)),
+			unseen:   math.Log(1 / float64(total+len(counts))),
+		}
+		for g, c := range counts {
+			m.logProb[g] = math.Log(float64(c+1) / float64(total+len(counts)))
+		}
+		models = append(models, m)
+	}
+
+	return models
+})
+
+func Supported() []string {
+	result := make([]string, 0)
+	for _, m := range load() {
+		result = append(result, m.language)
+	}
+	slices.Sort(result)
+
+	return result
+}
+
+func IsSupported(language string) bool {
+	return slices.Contains(Supported(), language)
+}
+
+func Detect(texts ...string) string {
+	grams := trigrams(strings.Join(texts, " "), maxLetters)
+	if len(grams) < minTrigrams {
+		return ""
+	}
+
+	best, bestScore := "", math.Inf(-1)
+	for _, m := range load() {
+		score := 0.0
+		for _, g := range grams {
+			if p, ok := m.logProb[g]; ok {
+				score += p
+			} else {
+				score += m.unseen
+			}
+		}
+
+		if score > bestScore {
+			best, bestScore = m.language, score
+		}
+	}
+
+	return best
+}
+
+func trigrams(text string, limit int) []string {
+	// Words are padded with spaces so their beginning and end count as well
+	runes := []rune{' '}
+	letters := 0
+	for _, r := range strings.ToLower(text) {
+		if letters >= limit {
+			break
+		}
+
+		if unicode.IsLetter(r) {
+			runes = append(runes, r)
+			letters++
+		} else if runes[len(runes)-1] != ' ' {
+			runes = append(runes, ' ')
+		}
+	}
+	if runes[len(runes)-1] != ' ' {
+		runes = append(runes, ' ')
+	}
+
+	result := make([]string, 0, len(runes))
+	for i := 0; i+3 <= len(runes); i++ {
+		if runes[i+1] == ' ' {
+			continue
+		}
+		result = append(result, string(runes[i:i+3]))
+	}
+
+	return result
+}
@@ -0,0 +1,44 @@
+package language_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/language"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DetectSuite))
+}
+
+type DetectSuite struct {
+	suite.Suite
+}
+
+func (suite *DetectSuite) Test_Supported_Success() {
+	suite.Equal([]string{"de", "en", "es", "fr", "it", "nl"}, language.Supported())
+	suite.True(language.IsSupported("de"))
+	suite.False(language.IsSupported("xx"))
+}
+
+func (suite *DetectSuite) Test_Detect_Success() {
+	cases := map[string][]string{
+		"de": {"Bankkauffrau im Bereich Zahlungsverkehr (m/w/d)", "Unser Kunde ist im Bereich Vermögensverwaltung und Fondmanagement ein führender Finanzdienstleister mit Sitz in München."},
+		"en": {"Senior Backend Engineer", "You will design and build the services that power our platform and mentor other engineers in the team."},
+		"fr": {"Développeur Full Stack", "Vous rejoindrez une équipe passionnée et participerez à la conception de nouvelles fonctionnalités."},
+		"es": {"Desarrollador Backend", "Formarás parte de un equipo dinámico y participarás en el diseño de nuevas funcionalidades para nuestros usuarios."},
+		"it": {"Sviluppatore Backend", "Entrerai a far parte di una squadra dinamica e parteciperai alla progettazione di nuove funzionalità."},
+		"nl": {"Backend Ontwikkelaar", "Je gaat werken in een enthousiast team en helpt bij het ontwerpen van nieuwe functionaliteiten voor onze gebruikers."},
+	}
+
+	for expected, texts := range cases {
+		suite.Equal(expected, language.Detect(texts...), texts[0])
+	}
+}
+
+func (suite *DetectSuite) Test_Detect_TooShort() {
+	suite.Equal("", language.Detect(""))
+	suite.Equal("", language.Detect("Engineer (m/w/d)"))
+	suite.Equal("", language.Detect("1234 5678 !!!"))
+}
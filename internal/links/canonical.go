@@ -0,0 +1,157 @@
+package links
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// Redirectors can be nested, but not endlessly
+const maxUnwraps = 3
+
+// Redirectors wrap the actual target in a query parameter, keyed by host and path
+var redirectors = map[string]string{
+	"google.com/url":                  "q",
+	"www.google.com/url":              "q",
+	"l.facebook.com/l.php":            "u",
+	"lm.facebook.com/l.php":           "u",
+	"l.instagram.com/":                "u",
+	"www.linkedin.com/redir/redirect": "url",
+	"www.youtube.com/redirect":        "q",
+	"t.umblr.com/redirect":            "z",
+	"href.li/":                        "",
+}
+
+var trackingParams = map[string]struct{}{
+	"gclid":   {},
+	"gbraid":  {},
+	"wbraid":  {},
+	"dclid":   {},
+	"fbclid":  {},
+	"msclkid": {},
+	"yclid":   {},
+	"igshid":  {},
+	"mc_cid":  {},
+	"mc_eid":  {},
+	"_hsenc":  {},
+	"_hsmi":   {},
+}
+
+func Canonicalize(raw string) string {
+	s := strings.TrimSpace(raw)
+
+	for range maxUnwraps + 1 {
+		u, ok := parse(s)
+		if !ok {
+			return s
+		}
+
+		target, ok := unwrap(u)
+		if !ok {
+			return format(u)
+		}
+		s = target
+	}
+
+	return s
+}
+
+func Host(raw string) string {
+	u, ok := parse(raw)
+	if !ok {
+		return ""
+	}
+
+	return u.Host
+}
+
+func parse(s string) (*url.URL, bool) {
+	// Links without a scheme are assumed to be secure
+	switch {
+	case strings.HasPrefix(s, "//"):
+		s = "https:" + s
+	case !strings.Contains(s, "://") && !hasScheme(s):
+		s = "https://" + s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, false
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return nil, false
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	port := u.Port()
+	if port != "" && !(u.Scheme == "http" && port == "80") && !(u.Scheme == "https" && port == "443") {
+		host = net.JoinHostPort(strings.Trim(host, "[]"), port)
+	}
+	u.Host = host
+
+	return u, true
+}
+
+// Opaque links like mailto: have a scheme without slashes, a host with a port is followed by digits
+func hasScheme(s string) bool {
+	scheme, rest, ok := strings.Cut(s, ":")
+	if !ok || scheme == "" || strings.ContainsAny(scheme, "/.") {
+		return false
+	}
+
+	return rest == "" || rest[0] < '0' || rest[0] > '9'
+}
+
+func unwrap(u *url.URL) (string, bool) {
+	param, ok := redirectors[u.Host+u.Path]
+	if !ok {
+		return "", false
+	}
+
+	// Some redirectors put the target right after the question mark
+	target := u.RawQuery
+	if param != "" {
+		target = u.Query().Get(param)
+	}
+
+	t, err := url.Parse(target)
+	if err != nil || (t.Scheme != "http" && t.Scheme != "https") || t.Host == "" {
+		return "", false
+	}
+
+	return target, true
+}
+
+func format(u *url.URL) string {
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	// Rebuild the query by hand, re-encoding it would change the order and escaping of what is kept
+	kept := make([]string, 0)
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		key, _, _ := strings.Cut(pair, "=")
+		if k, err := url.QueryUnescape(key); err == nil {
+			key = k
+		}
+		key = strings.ToLower(key)
+		if strings.HasPrefix(key, "utm_") {
+			continue
+		}
+		if _, ok := trackingParams[key]; ok {
+			continue
+		}
+		kept = append(kept, pair)
+	}
+	u.RawQuery = strings.Join(kept, "&")
+	u.ForceQuery = false
+
+	return u.String()
+}
@@ -0,0 +1,89 @@
+package links_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/links"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLinks(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(LinksSuite))
+}
+
+type LinksSuite struct {
+	suite.Suite
+}
+
+func (suite *LinksSuite) Test_Canonicalize_Tracking() {
+	cases := map[string]string{
+		"https://example.com/jobs/1?utm_source=arbeitnow&utm_medium=feed":         "https://example.com/jobs/1",
+		"https://example.com/jobs/1?ref=feed&UTM_Campaign=x&gclid=abc&id=2#apply": "https://example.com/jobs/1?ref=feed&id=2#apply",
+		"https://example.com/jobs/1?":                                             "https://example.com/jobs/1",
+		"https://example.com/search?q=a%20b&fbclid=x":                             "https://example.com/search?q=a%20b",
+	}
+
+	for raw, expected := range cases {
+		// Execute
+		result := links.Canonicalize(raw)
+
+		// Assert
+		suite.Equal(expected, result, raw)
+	}
+}
+
+func (suite *LinksSuite) Test_Canonicalize_SchemeAndHost() {
+	cases := map[string]string{
+		" HTTPS://Jobs.Example.COM./Engineer ": "https://jobs.example.com/Engineer",
+		"https://example.com:443/jobs":         "https://example.com/jobs",
+		"http://example.com:80":                "http://example.com/",
+		"http://example.com:8080/jobs":         "http://example.com:8080/jobs",
+		"www.example.com/jobs":                 "https://www.example.com/jobs",
+		"//example.com/jobs":                   "https://example.com/jobs",
+	}
+
+	for raw, expected := range cases {
+		// Execute
+		result := links.Canonicalize(raw)
+
+		// Assert
+		suite.Equal(expected, result, raw)
+	}
+}
+
+func (suite *LinksSuite) Test_Canonicalize_Redirectors() {
+	cases := map[string]string{
+		"https://www.google.com/url?q=https%3A%2F%2Fexample.com%2Fjobs%2F1%3Futm_source%3Dgoogle&sa=D":                             "https://example.com/jobs/1",
+		"https://l.facebook.com/l.php?u=https%3A%2F%2Fwww.linkedin.com%2Fredir%2Fredirect%3Furl%3Dhttps%253A%252F%252Fexample.com": "https://example.com/",
+		"https://href.li/?https://example.com/jobs":        "https://example.com/jobs",
+		"https://www.google.com/url?q=javascript:alert(1)": "https://www.google.com/url?q=javascript:alert(1)",
+	}
+
+	for raw, expected := range cases {
+		// Execute
+		result := links.Canonicalize(raw)
+
+		// Assert
+		suite.Equal(expected, result, raw)
+	}
+}
+
+func (suite *LinksSuite) Test_Canonicalize_Invalid() {
+	cases := []string{"", "mailto:jobs@example.com", "/jobs/1", "https://exa mple.com/"}
+
+	for _, raw := range cases {
+		// Execute
+		result := links.Canonicalize(raw)
+
+		// Assert links that are not web links are left alone
+		suite.Equal(raw, result, raw)
+	}
+}
+
+func (suite *LinksSuite) Test_Host() {
+	// Execute & Assert
+	suite.Equal("example.com", links.Host("https://Example.com:443/jobs"))
+	suite.Equal("example.com:8080", links.Host("http://example.com:8080/jobs"))
+	suite.Empty(links.Host("mailto:jobs@example.com"))
+}
@@ -0,0 +1,196 @@
+package quality
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+const (
+	maxScore = 100
+
+	shortDescription = 150
+	thinDescription  = 400
+	shortTitle       = 5
+	longTitle        = 120
+
+	// Titles need enough letters before shouting can be told apart from acronyms
+	capsMinLetters = 8
+	capsRatio      = 0.8
+
+	duplicatePenalty    = 10
+	maxDuplicatePenalty = 30
+)
+
+var (
+	shorteners = map[string]struct{}{
+		"bit.ly":      {},
+		"buff.ly":     {},
+		"cutt.ly":     {},
+		"goo.gl":      {},
+		"is.gd":       {},
+		"ow.ly":       {},
+		"rb.gy":       {},
+		"rebrand.ly":  {},
+		"shorturl.at": {},
+		"t.co":        {},
+		"t.ly":        {},
+		"tiny.cc":     {},
+		"tinyurl.com": {},
+	}
+
+	redirectParams = []string{"url", "redirect", "redirect_url", "redirect_uri", "target", "dest", "destination", "goto", "out", "link"}
+
+	shouting = []string{"!!", "??", "$$$", "€€€", "***"}
+)
+
+type Scorer struct {
+	titles       map[string]int
+	descriptions map[string]int
+}
+
+func NewScorer(jobs []*aggregator.Job) *Scorer {
+	s := &Scorer{
+		titles:       make(map[string]int, len(jobs)),
+		descriptions: make(map[string]int, len(jobs)),
+	}
+
+	for _, j := range jobs {
+		s.titles[titleKey(j)]++
+		if k := descriptionKey(j); k != "" {
+			s.descriptions[k]++
+		}
+	}
+
+	return s
+}
+
+func (s *Scorer) Score(j *aggregator.Job) *aggregator.Quality {
+	q := &aggregator.Quality{Score: maxScore, Signals: make([]*aggregator.QualitySignal, 0)}
+
+	for _, signal := range []*aggregator.QualitySignal{
+		descriptionLength(j),
+		titleCaps(j),
+		titlePunctuation(j),
+		titleLength(j),
+		urlReputation(j),
+		s.duplicates(j),
+	} {
+		if signal == nil {
+			continue
+		}
+		q.Signals = append(q.Signals, signal)
+		q.Score -= signal.Penalty
+	}
+	q.Score = max(q.Score, 0)
+
+	return q
+}
+
+func descriptionLength(j *aggregator.Job) *aggregator.QualitySignal {
+	n := utf8.RuneCountInString(strings.TrimSpace(j.DescriptionText))
+	switch {
+	case n < shortDescription:
+		return &aggregator.QualitySignal{Name: "description_length", Penalty: 40, Reason: fmt.Sprintf("description has only %d characters", n)}
+	case n < thinDescription:
+		return &aggregator.QualitySignal{Name: "description_length", Penalty: 15, Reason: fmt.Sprintf("description has only %d characters", n)}
+	}
+
+	return nil
+}
+
+func titleCaps(j *aggregator.Job) *aggregator.QualitySignal {
+	letters, upper := 0, 0
+	for _, r := range j.Title {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+
+	if letters >= capsMinLetters && float64(upper)/float64(letters) >= capsRatio {
+		return &aggregator.QualitySignal{Name: "title_caps", Penalty: 20, Reason: "title is written in capitals"}
+	}
+
+	return nil
+}
+
+func titlePunctuation(j *aggregator.Job) *aggregator.QualitySignal {
+	for _, p := range shouting {
+		if strings.Contains(j.Title, p) {
+			return &aggregator.QualitySignal{Name: "title_punctuation", Penalty: 10, Reason: fmt.Sprintf("title contains %q", p)}
+		}
+	}
+
+	return nil
+}
+
+func titleLength(j *aggregator.Job) *aggregator.QualitySignal {
+	n := utf8.RuneCountInString(strings.TrimSpace(j.Title))
+	switch {
+	case n < shortTitle:
+		return &aggregator.QualitySignal{Name: "title_length", Penalty: 15, Reason: fmt.Sprintf("title has only %d characters", n)}
+	case n > longTitle:
+		return &aggregator.QualitySignal{Name: "title_length", Penalty: 10, Reason: fmt.Sprintf("title has %d characters", n)}
+	}
+
+	return nil
+}
+
+func urlReputation(j *aggregator.Job) *aggregator.QualitySignal {
+	u, err := url.Parse(j.URL)
+	if err != nil || u.Hostname() == "" {
+		return &aggregator.QualitySignal{Name: "url_domain", Penalty: 30, Reason: "url is not valid"}
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if _, ok := shorteners[host]; ok {
+		return &aggregator.QualitySignal{Name: "url_domain", Penalty: 30, Reason: fmt.Sprintf("url uses link shortener %s", host)}
+	}
+
+	// Postings that bounce applicants through to another site
+	q := u.Query()
+	for _, p := range redirectParams {
+		target := strings.ToLower(q.Get(p))
+		if strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+			return &aggregator.QualitySignal{Name: "url_domain", Penalty: 25, Reason: fmt.Sprintf("url redirects through %s", host)}
+		}
+	}
+
+	if u.Scheme != "https" {
+		return &aggregator.QualitySignal{Name: "url_domain", Penalty: 5, Reason: "url is not secure"}
+	}
+
+	return nil
+}
+
+func (s *Scorer) duplicates(j *aggregator.Job) *aggregator.QualitySignal {
+	// Every job counts itself once
+	n := s.titles[titleKey(j)] - 1
+	if k := descriptionKey(j); k != "" {
+		n = max(n, s.descriptions[k]-1)
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	return &aggregator.QualitySignal{Name: "duplicates", Penalty: min(n*duplicatePenalty, maxDuplicatePenalty), Reason: fmt.Sprintf("%d other postings are (nearly) identical", n)}
+}
+
+func titleKey(j *aggregator.Job) string {
+	return normalize(j.Title) + "|" + normalize(j.Company)
+}
+
+func descriptionKey(j *aggregator.Job) string {
+	return normalize(j.DescriptionText)
+}
+
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
@@ -0,0 +1,138 @@
+package quality_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/quality"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ScoreSuite))
+}
+
+type ScoreSuite struct {
+	suite.Suite
+}
+
+func goodJob() *aggregator.Job {
+	return &aggregator.Job{
+		URL:             "https://www.arbeitnow.com/jobs/companies/acme/backend-engineer-berlin-1",
+		Title:           "Backend Engineer (Go)",
+		Company:         "ACME",
+		DescriptionText: strings.Repeat("We are looking for an engineer to build our platform. ", 10),
+	}
+}
+
+func (suite *ScoreSuite) Test_Score_Clean() {
+	// Prepare
+	j := goodJob()
+
+	// Execute
+	q := quality.NewScorer([]*aggregator.Job{j}).Score(j)
+
+	// Assert
+	suite.Equal(100, q.Score)
+	suite.Empty(q.Signals)
+}
+
+func (suite *ScoreSuite) Test_Score_Signals() {
+	cases := []struct {
+		name     string
+		modify   func(j *aggregator.Job)
+		expected *aggregator.QualitySignal
+	}{
+		{
+			name:     "tiny description",
+			modify:   func(j *aggregator.Job) { j.DescriptionText = "Apply now." },
+			expected: &aggregator.QualitySignal{Name: "description_length", Penalty: 40, Reason: "description has only 10 characters"},
+		},
+		{
+			name:     "thin description",
+			modify:   func(j *aggregator.Job) { j.DescriptionText = strings.Repeat("a", 200) },
+			expected: &aggregator.QualitySignal{Name: "description_length", Penalty: 15, Reason: "description has only 200 characters"},
+		},
+		{
+			name:     "all caps title",
+			modify:   func(j *aggregator.Job) { j.Title = "EARN MONEY FROM HOME" },
+			expected: &aggregator.QualitySignal{Name: "title_caps", Penalty: 20, Reason: "title is written in capitals"},
+		},
+		{
+			name:     "shouting title",
+			modify:   func(j *aggregator.Job) { j.Title = "Sales Agent wanted!!" },
+			expected: &aggregator.QualitySignal{Name: "title_punctuation", Penalty: 10, Reason: `title contains "!!"`},
+		},
+		{
+			name:     "short title",
+			modify:   func(j *aggregator.Job) { j.Title = "Job" },
+			expected: &aggregator.QualitySignal{Name: "title_length", Penalty: 15, Reason: "title has only 3 characters"},
+		},
+		{
+			name:     "link shortener",
+			modify:   func(j *aggregator.Job) { j.URL = "https://bit.ly/3xYz" },
+			expected: &aggregator.QualitySignal{Name: "url_domain", Penalty: 30, Reason: "url uses link shortener bit.ly"},
+		},
+		{
+			name:     "redirect",
+			modify:   func(j *aggregator.Job) { j.URL = "https://jobs.example.com/out?url=https%3A%2F%2Fscam.example.org" },
+			expected: &aggregator.QualitySignal{Name: "url_domain", Penalty: 25, Reason: "url redirects through jobs.example.com"},
+		},
+		{
+			name:     "insecure",
+			modify:   func(j *aggregator.Job) { j.URL = "http://example.com/jobs/1" },
+			expected: &aggregator.QualitySignal{Name: "url_domain", Penalty: 5, Reason: "url is not secure"},
+		},
+		{
+			name:     "invalid url",
+			modify:   func(j *aggregator.Job) { j.URL = "not a url" },
+			expected: &aggregator.QualitySignal{Name: "url_domain", Penalty: 30, Reason: "url is not valid"},
+		},
+	}
+
+	for _, c := range cases {
+		j := goodJob()
+		c.modify(j)
+
+		q := quality.NewScorer([]*aggregator.Job{j}).Score(j)
+
+		suite.Equal([]*aggregator.QualitySignal{c.expected}, q.Signals, c.name)
+		suite.Equal(100-c.expected.Penalty, q.Score, c.name)
+	}
+}
+
+func (suite *ScoreSuite) Test_Score_Duplicates() {
+	// Prepare
+	jobs := make([]*aggregator.Job, 0)
+	for range 5 {
+		jobs = append(jobs, goodJob())
+	}
+	other := goodJob()
+	other.Title = "Frontend Engineer"
+	other.DescriptionText = strings.Repeat("Build beautiful interfaces with us. ", 20)
+	jobs = append(jobs, other)
+	s := quality.NewScorer(jobs)
+
+	// Execute
+	q := s.Score(jobs[0])
+
+	// Assert penalties are capped
+	suite.Equal([]*aggregator.QualitySignal{{Name: "duplicates", Penalty: 30, Reason: "4 other postings are (nearly) identical"}}, q.Signals)
+	suite.Equal(70, q.Score)
+	suite.Equal(100, s.Score(other).Score)
+}
+
+func (suite *ScoreSuite) Test_Score_NeverNegative() {
+	// Prepare
+	j := &aggregator.Job{URL: "http://bit.ly/x", Title: "$$$ CASH NOW $$$", DescriptionText: "DM me"}
+	s := quality.NewScorer([]*aggregator.Job{j, j, j, j})
+
+	// Execute
+	q := s.Score(j)
+
+	// Assert
+	suite.Equal(0, q.Score)
+	suite.Len(q.Signals, 4)
+}
@@ -0,0 +1,247 @@
+package richtext
+
+import (
+	"strconv"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+type block struct {
+	text string
+	item bool
+}
+
+type list struct {
+	ordered bool
+	count   int
+}
+
+type renderer struct {
+	markdown bool
+	blocks   []*block
+	lines    []string
+	current  strings.Builder
+	prefix   string
+	item     bool
+	lists    []*list
+	quotes   int
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+
+func Text(s string) string {
+	return render(s, false)
+}
+
+func Markdown(s string) string {
+	return render(s, true)
+}
+
+func render(s string, markdown bool) string {
+	r := &renderer{markdown: markdown}
+	for _, n := range parse(s) {
+		r.walk(n)
+	}
+	r.flush()
+
+	var b strings.Builder
+	for i, bl := range r.blocks {
+		if i > 0 {
+			if bl.item && r.blocks[i-1].item {
+				b.WriteString("\n")
+			} else {
+				b.WriteString("\n\n")
+			}
+		}
+		b.WriteString(bl.text)
+	}
+
+	return b.String()
+}
+
+func (r *renderer) write(s string) {
+	r.current.WriteString(s)
+}
+
+func (r *renderer) breakLine() {
+	r.lines = append(r.lines, r.current.String())
+	r.current.Reset()
+}
+
+func (r *renderer) flush() {
+	r.breakLine()
+
+	lines := make([]string, 0, len(r.lines))
+	for _, l := range r.lines {
+		if l = strings.Join(strings.Fields(l), " "); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	r.lines = nil
+	if len(lines) == 0 {
+		return
+	}
+
+	r.add(lines, r.item)
+	r.prefix = strings.Repeat(" ", len(r.prefix))
+}
+
+func (r *renderer) add(lines []string, item bool) {
+	quote := ""
+	if r.markdown {
+		quote = strings.Repeat("> ", r.quotes)
+	}
+
+	separator := "\n"
+	if r.markdown {
+		separator = "  \n"
+	}
+
+	indent := strings.Repeat(" ", len(r.prefix))
+	for i, l := range lines {
+		if i == 0 {
+			lines[i] = quote + r.prefix + l
+		} else {
+			lines[i] = quote + indent + l
+		}
+	}
+
+	r.blocks = append(r.blocks, &block{text: strings.Join(lines, separator), item: item})
+}
+
+func (r *renderer) children(n *xhtml.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		r.walk(c)
+	}
+}
+
+func (r *renderer) inline(n *xhtml.Node, marker string) {
+	if r.markdown {
+		r.write(marker)
+	}
+	r.children(n)
+	if r.markdown {
+		r.write(marker)
+	}
+}
+
+func (r *renderer) walk(n *xhtml.Node) {
+	switch n.Type {
+	case xhtml.TextNode:
+		if r.markdown {
+			r.write(markdownEscaper.Replace(n.Data))
+		} else {
+			r.write(n.Data)
+		}
+		return
+	case xhtml.ElementNode:
+	default:
+		return
+	}
+
+	if droppedTags[n.DataAtom] {
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Br:
+		r.breakLine()
+	case atom.Hr:
+		r.flush()
+		if r.markdown {
+			r.add([]string{"---"}, false)
+		}
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		r.flush()
+		if r.markdown {
+			level, _ := strconv.Atoi(n.Data[1:])
+			r.write(strings.Repeat("#", level) + " ")
+		}
+		r.children(n)
+		r.flush()
+	case atom.Ul, atom.Ol:
+		r.flush()
+		r.lists = append(r.lists, &list{ordered: n.DataAtom == atom.Ol})
+		r.children(n)
+		r.flush()
+		r.lists = r.lists[:len(r.lists)-1]
+	case atom.Li:
+		r.flush()
+		r.prefix, r.item = r.bullet(), true
+		r.children(n)
+		r.flush()
+		r.prefix, r.item = "", false
+	case atom.Blockquote:
+		r.flush()
+		r.quotes++
+		r.children(n)
+		r.flush()
+		r.quotes--
+	case atom.Pre:
+		r.flush()
+		r.preformatted(n)
+	case atom.Strong, atom.B:
+		r.inline(n, "**")
+	case atom.Em, atom.I:
+		r.inline(n, "_")
+	case atom.Code:
+		r.inline(n, "`")
+	case atom.A:
+		href, ok := safeHref(n)
+		if !r.markdown || !ok {
+			r.children(n)
+			return
+		}
+		r.write("[")
+		r.children(n)
+		r.write("](" + href + ")")
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Table, atom.Tr:
+		r.flush()
+		r.children(n)
+		r.flush()
+	default:
+		r.children(n)
+	}
+}
+
+func (r *renderer) bullet() string {
+	indent := ""
+	if len(r.lists) > 1 {
+		indent = strings.Repeat("  ", len(r.lists)-1)
+	}
+
+	if len(r.lists) == 0 || !r.lists[len(r.lists)-1].ordered {
+		return indent + "- "
+	}
+
+	l := r.lists[len(r.lists)-1]
+	l.count++
+	return indent + strconv.Itoa(l.count) + ". "
+}
+
+func (r *renderer) preformatted(n *xhtml.Node) {
+	// Whitespace is significant in preformatted text, so it is kept verbatim
+	var b strings.Builder
+	var collect func(n *xhtml.Node)
+	collect = func(n *xhtml.Node) {
+		if n.Type == xhtml.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			collect(c)
+		}
+	}
+	collect(n)
+
+	text := strings.Trim(b.String(), "\n")
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+
+	if r.markdown {
+		text = "```\n" + text + "\n```"
+	}
+	r.blocks = append(r.blocks, &block{text: text})
+}
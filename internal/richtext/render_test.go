@@ -0,0 +1,68 @@
+package richtext_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/richtext"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RenderSuite))
+}
+
+type RenderSuite struct {
+	suite.Suite
+}
+
+const description = `<p>Hello <strong>world</strong> &amp; <em>friends</em></p>
+<h2>Tasks</h2>
+<ul>
+<li>One</li>
+<li>Two <a href="https://example.com/a?b=1&amp;c=2">link</a>
+<ol><li>nested</li><li>again</li></ol></li>
+</ul>
+<p>Text<br>next   line</p>
+<pre>  keep
+    spacing</pre>`
+
+func (suite *RenderSuite) Test_Text_Success() {
+	// Execute
+	s := richtext.Text(description)
+
+	// Assert
+	suite.Equal("Hello world & friends\n\nTasks\n\n- One\n- Two link\n  1. nested\n  2. again\n\nText\nnext line\n\n  keep\n    spacing", s)
+}
+
+func (suite *RenderSuite) Test_Markdown_Success() {
+	// Execute
+	s := richtext.Markdown(description)
+
+	// Assert
+	suite.Equal("Hello **world** & _friends_\n\n## Tasks\n\n- One\n- Two [link](https://example.com/a?b=1&c=2)\n  1. nested\n  2. again\n\nText  \nnext line\n\n```\n  keep\n    spacing\n```", s)
+}
+
+func (suite *RenderSuite) Test_Markdown_EscapesText() {
+	// Execute
+	s := richtext.Markdown(`<p>5 * 3 = [15] and some_var</p>`)
+
+	// Assert
+	suite.Equal(`5 \* 3 = \[15\] and some\_var`, s)
+}
+
+func (suite *RenderSuite) Test_Text_IgnoresScripts() {
+	// Execute
+	s := richtext.Text(`<div>Visible<script>var hidden = 1;</script></div><!-- comment -->`)
+
+	// Assert
+	suite.Equal("Visible", s)
+}
+
+func (suite *RenderSuite) Test_Text_Empty() {
+	// Execute
+	s := richtext.Text("")
+
+	// Assert
+	suite.Empty(s)
+}
@@ -0,0 +1,159 @@
+package richtext
+
+import (
+	"html"
+	"net/url"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var allowedTags = map[atom.Atom]bool{
+	atom.A:          true,
+	atom.B:          true,
+	atom.Blockquote: true,
+	atom.Br:         true,
+	atom.Code:       true,
+	atom.Div:        true,
+	atom.Em:         true,
+	atom.H1:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+	atom.H4:         true,
+	atom.H5:         true,
+	atom.H6:         true,
+	atom.Hr:         true,
+	atom.I:          true,
+	atom.Li:         true,
+	atom.Ol:         true,
+	atom.P:          true,
+	atom.Pre:        true,
+	atom.Strong:     true,
+	atom.U:          true,
+	atom.Ul:         true,
+}
+
+var droppedTags = map[atom.Atom]bool{
+	atom.Button:   true,
+	atom.Embed:    true,
+	atom.Form:     true,
+	atom.Head:     true,
+	atom.Iframe:   true,
+	atom.Input:    true,
+	atom.Math:     true,
+	atom.Noscript: true,
+	atom.Object:   true,
+	atom.Script:   true,
+	atom.Select:   true,
+	atom.Style:    true,
+	atom.Svg:      true,
+	atom.Template: true,
+	atom.Textarea: true,
+	atom.Title:    true,
+}
+
+var allowedSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+func parse(s string) []*xhtml.Node {
+	body := &xhtml.Node{Type: xhtml.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := xhtml.ParseFragment(strings.NewReader(s), body)
+	if err != nil {
+		// Reading from a string does not fail, keep the text to be safe anyway
+		return []*xhtml.Node{{Type: xhtml.TextNode, Data: s}}
+	}
+
+	return nodes
+}
+
+func safeHref(n *xhtml.Node) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Namespace != "" || a.Key != "href" {
+			continue
+		}
+
+		u, err := url.Parse(strings.TrimSpace(a.Val))
+		if err != nil || !allowedSchemes[strings.ToLower(u.Scheme)] {
+			return "", false
+		}
+
+		return u.String(), true
+	}
+
+	return "", false
+}
+
+func Sanitize(s string) string {
+	var b strings.Builder
+	for _, n := range parse(s) {
+		sanitize(&b, n)
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+func sanitize(b *strings.Builder, n *xhtml.Node) {
+	switch n.Type {
+	case xhtml.TextNode:
+		b.WriteString(html.EscapeString(n.Data))
+		return
+	case xhtml.ElementNode:
+	default:
+		// Comments and doctypes are dropped
+		return
+	}
+
+	// Executable and embedded content is dropped, other unknown tags are unwrapped
+	if droppedTags[n.DataAtom] {
+		return
+	}
+
+	allowed := allowedTags[n.DataAtom]
+	if n.DataAtom == atom.A {
+		href, ok := safeHref(n)
+		if ok {
+			b.WriteString(`<a href="` + html.EscapeString(href) + `" rel="nofollow noopener">`)
+		}
+		allowed = ok
+	} else if allowed {
+		b.WriteString("<" + n.Data + ">")
+	}
+
+	// Void elements have no content nor closing tag
+	if n.DataAtom == atom.Br || n.DataAtom == atom.Hr {
+		return
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sanitize(b, c)
+	}
+
+	if allowed {
+		b.WriteString("</" + n.Data + ">")
+	}
+}
+
+func FromText(s string) string {
+	s = strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+
+	var b strings.Builder
+	for _, p := range strings.Split(s, "\n\n") {
+		lines := make([]string, 0)
+		for _, l := range strings.Split(p, "\n") {
+			if l = strings.TrimSpace(l); l != "" {
+				lines = append(lines, html.EscapeString(l))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+
+		b.WriteString("<p>" + strings.Join(lines, "<br>") + "</p>")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package richtext_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/richtext"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSanitize(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SanitizeSuite))
+}
+
+type SanitizeSuite struct {
+	suite.Suite
+}
+
+func (suite *SanitizeSuite) Test_Sanitize_RemovesScriptsAndStyles() {
+	// Execute
+	s := richtext.Sanitize(`<p style="color:red" onclick="alert(1)">Hello<script>alert(1)</script><style>p{}</style></p>`)
+
+	// Assert
+	suite.Equal(`<p>Hello</p>`, s)
+}
+
+func (suite *SanitizeSuite) Test_Sanitize_UnwrapsUnknownTags() {
+	// Execute
+	s := richtext.Sanitize(`<p><span class="x">Hello</span> <font>world</font></p>`)
+
+	// Assert
+	suite.Equal(`<p>Hello world</p>`, s)
+}
+
+func (suite *SanitizeSuite) Test_Sanitize_Links() {
+	// Execute
+	s := richtext.Sanitize(`<a href="https://example.com/?a=1&b=2" target="_blank">good</a> <a href="javascript:alert(1)">bad</a> <a href="/relative">relative</a>`)
+
+	// Assert
+	suite.Equal(`<a href="https://example.com/?a=1&amp;b=2" rel="nofollow noopener">good</a> bad relative`, s)
+}
+
+func (suite *SanitizeSuite) Test_Sanitize_FixesMalformedHTML() {
+	// Execute
+	s := richtext.Sanitize(`<p>Find <a href="https://www.arbeitnow.com/">Jobs</a> on Arbeitnow</a><ul><li>open`)
+
+	// Assert
+	suite.Equal(`<p>Find <a href="https://www.arbeitnow.com/" rel="nofollow noopener">Jobs</a> on Arbeitnow</p><ul><li>open</li></ul>`, s)
+}
+
+func (suite *SanitizeSuite) Test_Sanitize_EscapesText() {
+	// Execute
+	s := richtext.Sanitize(`Fish &amp; Chips &lt;script&gt;`)
+
+	// Assert
+	suite.Equal(`Fish &amp; Chips &lt;script&gt;`, s)
+}
+
+func (suite *SanitizeSuite) Test_Sanitize_Idempotent() {
+	// Prepare
+	s := richtext.Sanitize(`<h2>Title</h2><ul><li><a href="https://example.com">link</a></li></ul><br><hr>`)
+
+	// Execute
+	again := richtext.Sanitize(s)
+
+	// Assert
+	suite.Equal(s, again)
+}
+
+func (suite *SanitizeSuite) Test_FromText_Success() {
+	// Execute
+	s := richtext.FromText("First line\r\nsecond <line>\n\n\n  Second paragraph  \n")
+
+	// Assert
+	suite.Equal(`<p>First line<br>second &lt;line&gt;</p><p>Second paragraph</p>`, s)
+}
+
+func (suite *SanitizeSuite) Test_FromText_Empty() {
+	// Execute
+	s := richtext.FromText(" \n\n ")
+
+	// Assert
+	suite.Empty(s)
+}
@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type getter func(j *aggregator.Job) (string, bool)
+
+var fields = map[string]getter{
+	"title":        func(j *aggregator.Job) (string, bool) { return j.Title, true },
+	"description":  func(j *aggregator.Job) (string, bool) { return j.DescriptionText, true },
+	"company":      func(j *aggregator.Job) (string, bool) { return j.Company, true },
+	"location":     func(j *aggregator.Job) (string, bool) { return j.Location, true },
+	"city":         func(j *aggregator.Job) (string, bool) { return j.City, true },
+	"region":       func(j *aggregator.Job) (string, bool) { return j.Region, true },
+	"country":      func(j *aggregator.Job) (string, bool) { return j.CountryCode, true },
+	"remote":       func(j *aggregator.Job) (string, bool) { return strconv.FormatBool(j.Remote), true },
+	"remote_scope": func(j *aggregator.Job) (string, bool) { return j.RemoteScope.String(), true },
+	"language":     func(j *aggregator.Job) (string, bool) { return j.Language, true },
+	"source":       func(j *aggregator.Job) (string, bool) { return j.Source, true },
+	"url":          func(j *aggregator.Job) (string, bool) { return j.URL, true },
+	"salary_min": func(j *aggregator.Job) (string, bool) {
+		if j.Salary == nil {
+			return "", false
+		}
+		return strconv.FormatFloat(j.Salary.AnnualMin, 'f', -1, 64), true
+	},
+	"salary_max": func(j *aggregator.Job) (string, bool) {
+		if j.Salary == nil {
+			return "", false
+		}
+		return strconv.FormatFloat(j.Salary.AnnualMax, 'f', -1, 64), true
+	},
+}
+
+func field(name string) (getter, error) {
+	// Enrichments are addressed by their key, e.g. "enrichments.seniority"
+	if key, ok := strings.CutPrefix(name, "enrichments."); ok && key != "" {
+		return func(j *aggregator.Job) (string, bool) {
+			v, ok := j.Enrichments[key]
+			return v, ok
+		}, nil
+	}
+
+	if get, ok := fields[name]; ok {
+		return get, nil
+	}
+
+	return nil, fmt.Errorf("unknown field %q", name)
+}
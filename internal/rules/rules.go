@@ -0,0 +1,221 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+var (
+	ErrNameRequired      = errors.New("rule name is required")
+	ErrDuplicateName     = errors.New("rule names must be unique")
+	ErrConditionRequired = errors.New("condition is required")
+	ErrAmbiguousNode     = errors.New("condition must be exactly one of a predicate, all, any or not")
+)
+
+type predicate func(j *aggregator.Job) bool
+
+type rule struct {
+	name  string
+	match predicate
+}
+
+type Set struct {
+	include []*rule
+	exclude []*rule
+}
+
+func Compile(rr []*aggregator.Rule) (*Set, error) {
+	s := &Set{
+		include: make([]*rule, 0),
+		exclude: make([]*rule, 0),
+	}
+
+	seen := make(map[string]struct{}, len(rr))
+	for _, r := range rr {
+		if strings.TrimSpace(r.Name) == "" {
+			return nil, ErrNameRequired
+		}
+		if _, ok := seen[r.Name]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateName, r.Name)
+		}
+		seen[r.Name] = struct{}{}
+
+		p, err := compile(r.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", r.Name, err)
+		}
+
+		switch r.Action {
+		case aggregator.RuleActionInclude:
+			s.include = append(s.include, &rule{name: r.Name, match: p})
+		case aggregator.RuleActionExclude:
+			s.exclude = append(s.exclude, &rule{name: r.Name, match: p})
+		default:
+			return nil, fmt.Errorf("rule %s: invalid action %d", r.Name, r.Action)
+		}
+	}
+
+	return s, nil
+}
+
+func (s *Set) Allows(j *aggregator.Job) bool {
+	// Exclusions always win, inclusions only narrow down when there are any
+	for _, r := range s.exclude {
+		if r.match(j) {
+			return false
+		}
+	}
+	if len(s.include) == 0 {
+		return true
+	}
+
+	for _, r := range s.include {
+		if r.match(j) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compile(c *aggregator.RuleCondition) (predicate, error) {
+	if c == nil {
+		return nil, ErrConditionRequired
+	}
+
+	nodes := 0
+	for _, set := range []bool{c.Field != "" || c.Op != "", c.All != nil, c.Any != nil, c.Not != nil} {
+		if set {
+			nodes++
+		}
+	}
+	if nodes != 1 {
+		return nil, ErrAmbiguousNode
+	}
+
+	switch {
+	case c.All != nil:
+		pp, err := compileAll(c.All)
+		if err != nil {
+			return nil, err
+		}
+		return func(j *aggregator.Job) bool {
+			for _, p := range pp {
+				if !p(j) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case c.Any != nil:
+		pp, err := compileAll(c.Any)
+		if err != nil {
+			return nil, err
+		}
+		return func(j *aggregator.Job) bool {
+			for _, p := range pp {
+				if p(j) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case c.Not != nil:
+		p, err := compile(c.Not)
+		if err != nil {
+			return nil, err
+		}
+		return func(j *aggregator.Job) bool { return !p(j) }, nil
+	}
+
+	return compilePredicate(c)
+}
+
+func compileAll(cc []*aggregator.RuleCondition) ([]predicate, error) {
+	if len(cc) == 0 {
+		return nil, ErrConditionRequired
+	}
+
+	result := make([]predicate, 0, len(cc))
+	for _, c := range cc {
+		p, err := compile(c)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+
+	return result, nil
+}
+
+func compilePredicate(c *aggregator.RuleCondition) (predicate, error) {
+	get, err := field(c.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.Op {
+	case "equals":
+		return func(j *aggregator.Job) bool {
+			v, ok := get(j)
+			return ok && strings.EqualFold(v, c.Value)
+		}, nil
+	case "contains":
+		value := strings.ToLower(c.Value)
+		return func(j *aggregator.Job) bool {
+			v, ok := get(j)
+			return ok && strings.Contains(strings.ToLower(v), value)
+		}, nil
+	case "in":
+		if len(c.Values) == 0 {
+			return nil, fmt.Errorf("operator in requires values for field %s", c.Field)
+		}
+		return func(j *aggregator.Job) bool {
+			v, ok := get(j)
+			return ok && slices.ContainsFunc(c.Values, func(s string) bool { return strings.EqualFold(s, v) })
+		}, nil
+	case "matches":
+		// Patterns are case-insensitive, titles are written in all kinds of ways
+		re, err := regexp.Compile("(?i)" + c.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern for field %s: %w", c.Field, err)
+		}
+		return func(j *aggregator.Job) bool {
+			v, ok := get(j)
+			return ok && re.MatchString(v)
+		}, nil
+	case "gte", "lte":
+		limit, err := strconv.ParseFloat(c.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("operator %s requires a number for field %s", c.Op, c.Field)
+		}
+		gte := c.Op == "gte"
+		return func(j *aggregator.Job) bool {
+			v, ok := get(j)
+			if !ok {
+				return false
+			}
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return false
+			}
+			if gte {
+				return n >= limit
+			}
+			return n <= limit
+		}, nil
+	case "exists":
+		return func(j *aggregator.Job) bool {
+			v, ok := get(j)
+			return ok && v != ""
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown operator %q for field %s", c.Op, c.Field)
+}
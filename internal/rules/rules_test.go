@@ -0,0 +1,139 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/rules"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RulesSuite))
+}
+
+type RulesSuite struct {
+	suite.Suite
+}
+
+func (suite *RulesSuite) Test_Allows_NoRules() {
+	// Prepare
+	s, err := rules.Compile(nil)
+	suite.NoError(err)
+
+	// Assert
+	suite.True(s.Allows(&aggregator.Job{Title: "Werkstudent Marketing"}))
+}
+
+func (suite *RulesSuite) Test_Allows_Exclude() {
+	// Prepare
+	s, err := rules.Compile([]*aggregator.Rule{
+		{Name: "no students", Action: aggregator.RuleActionExclude, Condition: &aggregator.RuleCondition{Field: "title", Op: "matches", Value: "praktikum|werkstudent"}},
+		{Name: "no agencies", Action: aggregator.RuleActionExclude, Condition: &aggregator.RuleCondition{Field: "company", Op: "in", Values: []string{"OPUS ONE Recruitment GmbH", "Hays"}}},
+	})
+	suite.NoError(err)
+
+	// Assert
+	suite.False(s.Allows(&aggregator.Job{Title: "Werkstudent Marketing (m/w/d)"}))
+	suite.False(s.Allows(&aggregator.Job{Title: "Pflichtpraktikum Vertrieb"}))
+	suite.False(s.Allows(&aggregator.Job{Title: "Accountant", Company: "opus one recruitment gmbh"}))
+	suite.True(s.Allows(&aggregator.Job{Title: "Accountant", Company: "ACME"}))
+}
+
+func (suite *RulesSuite) Test_Allows_Include() {
+	// Prepare
+	s, err := rules.Compile([]*aggregator.Rule{
+		{Name: "remote", Action: aggregator.RuleActionInclude, Condition: &aggregator.RuleCondition{Field: "remote", Op: "equals", Value: "true"}},
+		{Name: "munich or berlin", Action: aggregator.RuleActionInclude, Condition: &aggregator.RuleCondition{Any: []*aggregator.RuleCondition{
+			{Field: "city", Op: "equals", Value: "munich"},
+			{Field: "city", Op: "equals", Value: "berlin"},
+		}}},
+	})
+	suite.NoError(err)
+
+	// Assert a job needs to match at least one inclusion
+	suite.True(s.Allows(&aggregator.Job{Remote: true}))
+	suite.True(s.Allows(&aggregator.Job{Geo: aggregator.Geo{City: "Berlin"}}))
+	suite.False(s.Allows(&aggregator.Job{Geo: aggregator.Geo{City: "Hamburg"}}))
+}
+
+func (suite *RulesSuite) Test_Allows_Combinators() {
+	// Prepare
+	s, err := rules.Compile([]*aggregator.Rule{
+		{Name: "well paid outside germany", Action: aggregator.RuleActionInclude, Condition: &aggregator.RuleCondition{All: []*aggregator.RuleCondition{
+			{Not: &aggregator.RuleCondition{Field: "country", Op: "equals", Value: "DE"}},
+			{Field: "salary_min", Op: "gte", Value: "60000"},
+			{Field: "enrichments.seniority", Op: "exists"},
+		}}},
+	})
+	suite.NoError(err)
+
+	// Assert
+	suite.True(s.Allows(&aggregator.Job{Geo: aggregator.Geo{CountryCode: "AT"}, Salary: &aggregator.Salary{AnnualMin: 65000}, Enrichments: aggregator.Enrichments{"seniority": "senior"}}))
+	suite.False(s.Allows(&aggregator.Job{Geo: aggregator.Geo{CountryCode: "DE"}, Salary: &aggregator.Salary{AnnualMin: 65000}, Enrichments: aggregator.Enrichments{"seniority": "senior"}}))
+	suite.False(s.Allows(&aggregator.Job{Geo: aggregator.Geo{CountryCode: "AT"}, Salary: &aggregator.Salary{AnnualMin: 40000}, Enrichments: aggregator.Enrichments{"seniority": "senior"}}))
+	suite.False(s.Allows(&aggregator.Job{Geo: aggregator.Geo{CountryCode: "AT"}, Enrichments: aggregator.Enrichments{"seniority": "senior"}}))
+	suite.False(s.Allows(&aggregator.Job{Geo: aggregator.Geo{CountryCode: "AT"}, Salary: &aggregator.Salary{AnnualMin: 65000}}))
+}
+
+func (suite *RulesSuite) Test_Compile_Fail() {
+	exists := &aggregator.RuleCondition{Field: "title", Op: "exists"}
+	cases := []struct {
+		rule     *aggregator.Rule
+		expected string
+	}{
+		{
+			rule:     &aggregator.Rule{Condition: exists},
+			expected: "rule name is required",
+		},
+		{
+			rule:     &aggregator.Rule{Name: "r"},
+			expected: "rule r: condition is required",
+		},
+		{
+			rule:     &aggregator.Rule{Name: "r", Condition: &aggregator.RuleCondition{All: []*aggregator.RuleCondition{}}},
+			expected: "rule r: condition is required",
+		},
+		{
+			rule:     &aggregator.Rule{Name: "r", Condition: &aggregator.RuleCondition{Field: "title", Op: "exists", Not: exists}},
+			expected: "rule r: condition must be exactly one of a predicate, all, any or not",
+		},
+		{
+			rule:     &aggregator.Rule{Name: "r", Condition: &aggregator.RuleCondition{Field: "salary", Op: "exists"}},
+			expected: `rule r: unknown field "salary"`,
+		},
+		{
+			rule:     &aggregator.Rule{Name: "r", Condition: &aggregator.RuleCondition{Field: "title", Op: "like", Value: "x"}},
+			expected: `rule r: unknown operator "like" for field title`,
+		},
+		{
+			rule:     &aggregator.Rule{Name: "r", Condition: &aggregator.RuleCondition{Field: "company", Op: "in"}},
+			expected: "rule r: operator in requires values for field company",
+		},
+		{
+			rule:     &aggregator.Rule{Name: "r", Condition: &aggregator.RuleCondition{Field: "salary_min", Op: "gte", Value: "a lot"}},
+			expected: "rule r: operator gte requires a number for field salary_min",
+		},
+		{
+			rule:     &aggregator.Rule{Name: "r", Condition: &aggregator.RuleCondition{Field: "title", Op: "matches", Value: "(unclosed"}},
+			expected: "rule r: invalid pattern for field title: error parsing regexp: missing closing ): `(?i)(unclosed`",
+		},
+	}
+
+	for _, c := range cases {
+		_, err := rules.Compile([]*aggregator.Rule{c.rule})
+		suite.EqualError(err, c.expected)
+	}
+}
+
+func (suite *RulesSuite) Test_Compile_DuplicateNameFail() {
+	// Execute
+	_, err := rules.Compile([]*aggregator.Rule{
+		{Name: "r", Action: aggregator.RuleActionExclude, Condition: &aggregator.RuleCondition{Field: "title", Op: "exists"}},
+		{Name: "r", Action: aggregator.RuleActionInclude, Condition: &aggregator.RuleCondition{Field: "title", Op: "exists"}},
+	})
+
+	// Assert
+	suite.ErrorIs(err, rules.ErrDuplicateName)
+}
@@ -0,0 +1,218 @@
+package salary
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+const (
+	currency = `(€|\$|£|\b(?:eur|usd|gbp|chf)\b)`
+	amount   = `(\d{1,3}(?:[.,']\d{3})+(?:[.,]\d{1,2})?|\d+(?:[.,]\d+)?)(\s?k\b)?`
+	dash     = `\s?(?:-|–|—|\bto\b|\bbis\b)\s?`
+
+	// Text following an amount that is searched for the period and gross/net
+	suffixLength = 40
+
+	minAnnual = 1000
+	maxAnnual = 5000000
+)
+
+var (
+	salaryRegex = regexp.MustCompile(`(?i)` + currency + `?\s?` + amount + `\s?` + currency + `?(?:` + dash + currency + `?\s?` + amount + `\s?` + currency + `?)?`)
+	netRegex    = regexp.MustCompile(`(?i)\b(?:net|netto)\b`)
+
+	currencies = map[string]string{
+		"€":   "EUR",
+		"eur": "EUR",
+		"$":   "USD",
+		"usd": "USD",
+		"£":   "GBP",
+		"gbp": "GBP",
+		"chf": "CHF",
+	}
+
+	// Longer keywords go first so they are matched before their prefixes
+	periods = []struct {
+		keyword string
+		period  aggregator.SalaryPeriod
+	}{
+		{"p.a.", aggregator.SalaryPeriodYear},
+		{"pa", aggregator.SalaryPeriodYear},
+		{"yearly", aggregator.SalaryPeriodYear},
+		{"year", aggregator.SalaryPeriodYear},
+		{"yr", aggregator.SalaryPeriodYear},
+		{"annually", aggregator.SalaryPeriodYear},
+		{"annual", aggregator.SalaryPeriodYear},
+		{"annum", aggregator.SalaryPeriodYear},
+		{"jährlich", aggregator.SalaryPeriodYear},
+		{"jahr", aggregator.SalaryPeriodYear},
+		{"monthly", aggregator.SalaryPeriodMonth},
+		{"month", aggregator.SalaryPeriodMonth},
+		{"monatlich", aggregator.SalaryPeriodMonth},
+		{"monat", aggregator.SalaryPeriodMonth},
+		{"mtl.", aggregator.SalaryPeriodMonth},
+		{"mo", aggregator.SalaryPeriodMonth},
+		{"weekly", aggregator.SalaryPeriodWeek},
+		{"week", aggregator.SalaryPeriodWeek},
+		{"wöchentlich", aggregator.SalaryPeriodWeek},
+		{"woche", aggregator.SalaryPeriodWeek},
+		{"daily", aggregator.SalaryPeriodDay},
+		{"day", aggregator.SalaryPeriodDay},
+		{"täglich", aggregator.SalaryPeriodDay},
+		{"tag", aggregator.SalaryPeriodDay},
+		{"hourly", aggregator.SalaryPeriodHour},
+		{"hour", aggregator.SalaryPeriodHour},
+		{"hr", aggregator.SalaryPeriodHour},
+		{"stündlich", aggregator.SalaryPeriodHour},
+		{"stunde", aggregator.SalaryPeriodHour},
+		{"std", aggregator.SalaryPeriodHour},
+		{"h", aggregator.SalaryPeriodHour},
+	}
+)
+
+func Parse(texts ...string) *aggregator.Salary {
+	for _, text := range texts {
+		if s := parse(text); s != nil {
+			return s
+		}
+	}
+
+	return nil
+}
+
+func parse(text string) *aggregator.Salary {
+	for _, m := range salaryRegex.FindAllStringSubmatchIndex(text, -1) {
+		g := func(i int) string {
+			if m[2*i] < 0 {
+				return ""
+			}
+			return text[m[2*i]:m[2*i+1]]
+		}
+
+		// Only amounts next to a currency are considered a salary
+		cur := ""
+		for _, i := range []int{1, 4, 5, 8} {
+			if c := g(i); c != "" {
+				cur = currencies[strings.ToLower(c)]
+				break
+			}
+		}
+		if cur == "" {
+			continue
+		}
+
+		minK, maxK := g(3) != "", g(7) != ""
+		low, ok := parseAmount(g(2), minK)
+		if !ok {
+			continue
+		}
+		high := low
+		if v, ok := parseAmount(g(6), maxK); ok {
+			high = v
+		}
+
+		// A thousands suffix on the upper bound applies to both ("60-75k")
+		if maxK && !minK && low < 1000 {
+			low *= 1000
+		}
+		if high < low {
+			high = low
+		}
+
+		suffix := text[m[1]:min(len(text), m[1]+suffixLength)]
+		for !utf8.ValidString(suffix) {
+			suffix = suffix[:len(suffix)-1]
+		}
+		period, ok := parsePeriod(suffix)
+		if !ok {
+			period = guessPeriod(high)
+		}
+
+		s := &aggregator.Salary{
+			Currency: cur,
+			Min:      low,
+			Max:      high,
+			Period:   period,
+			Net:      netRegex.MatchString(suffix),
+		}
+		s.Annualize()
+
+		if s.AnnualMin < minAnnual || s.AnnualMax > maxAnnual {
+			continue
+		}
+
+		return s
+	}
+
+	return nil
+}
+
+func parseAmount(s string, thousands bool) (float64, bool) {
+	s = strings.ReplaceAll(s, "'", "")
+
+	// The last separator is a decimal one, unless three digits follow it
+	if i := strings.LastIndexAny(s, ".,"); i >= 0 {
+		integer, fraction := s[:i], s[i+1:]
+		integer = strings.NewReplacer(".", "", ",", "").Replace(integer)
+		if len(fraction) == 3 && !thousands {
+			s = integer + fraction
+		} else {
+			s = integer + "." + fraction
+		}
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v <= 0 {
+		return 0, false
+	}
+	if thousands {
+		v *= 1000
+	}
+
+	return v, true
+}
+
+func parsePeriod(s string) (aggregator.SalaryPeriod, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimSpace(strings.TrimPrefix(s, "/"))
+	for _, p := range []string{"per ", "pro ", "im ", "a ", "an "} {
+		s = strings.TrimPrefix(s, p)
+	}
+
+	// Gross or net may be mentioned before the period ("brutto pro Jahr")
+	for _, p := range []string{"gross ", "brutto ", "net ", "netto "} {
+		if strings.HasPrefix(s, p) {
+			return parsePeriod(s[len(p):])
+		}
+	}
+
+	for _, p := range periods {
+		if !strings.HasPrefix(s, p.keyword) {
+			continue
+		}
+		next, _ := utf8.DecodeRuneInString(s[len(p.keyword):])
+		if unicode.IsLetter(next) {
+			continue
+		}
+
+		return p.period, true
+	}
+
+	return aggregator.SalaryPeriodYear, false
+}
+
+func guessPeriod(amount float64) aggregator.SalaryPeriod {
+	switch {
+	case amount < 500:
+		return aggregator.SalaryPeriodHour
+	case amount < 20000:
+		return aggregator.SalaryPeriodMonth
+	default:
+		return aggregator.SalaryPeriodYear
+	}
+}
@@ -0,0 +1,91 @@
+package salary_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/salary"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ParseSuite))
+}
+
+type ParseSuite struct {
+	suite.Suite
+}
+
+func (suite *ParseSuite) Test_Parse_Success() {
+	cases := []struct {
+		text     string
+		expected *aggregator.Salary
+	}{
+		{
+			text:     "Senior Engineer (€60k–75k)",
+			expected: &aggregator.Salary{Currency: "EUR", Min: 60000, Max: 75000, AnnualMin: 60000, AnnualMax: 75000, Period: aggregator.SalaryPeriodYear},
+		},
+		{
+			text:     "Das Gehalt beträgt 45.000 EUR p.a. brutto",
+			expected: &aggregator.Salary{Currency: "EUR", Min: 45000, Max: 45000, AnnualMin: 45000, AnnualMax: 45000, Period: aggregator.SalaryPeriodYear},
+		},
+		{
+			text:     "We pay $50/hour",
+			expected: &aggregator.Salary{Currency: "USD", Min: 50, Max: 50, AnnualMin: 104000, AnnualMax: 104000, Period: aggregator.SalaryPeriodHour},
+		},
+		{
+			text:     "Vergütung: 3.500 - 4.000 € netto pro Monat",
+			expected: &aggregator.Salary{Currency: "EUR", Min: 3500, Max: 4000, AnnualMin: 42000, AnnualMax: 48000, Period: aggregator.SalaryPeriodMonth, Net: true},
+		},
+		{
+			text:     "Salary of £40,000 to £55,000 per annum",
+			expected: &aggregator.Salary{Currency: "GBP", Min: 40000, Max: 55000, AnnualMin: 40000, AnnualMax: 55000, Period: aggregator.SalaryPeriodYear},
+		},
+		{
+			text:     "CHF 90-110k",
+			expected: &aggregator.Salary{Currency: "CHF", Min: 90000, Max: 110000, AnnualMin: 90000, AnnualMax: 110000, Period: aggregator.SalaryPeriodYear},
+		},
+		{
+			text:     "Stundenlohn 18,50 € brutto",
+			expected: &aggregator.Salary{Currency: "EUR", Min: 18.5, Max: 18.5, AnnualMin: 38480, AnnualMax: 38480, Period: aggregator.SalaryPeriodHour},
+		},
+		{
+			text:     "Tagessatz 600 € pro Tag",
+			expected: &aggregator.Salary{Currency: "EUR", Min: 600, Max: 600, AnnualMin: 156000, AnnualMax: 156000, Period: aggregator.SalaryPeriodDay},
+		},
+	}
+
+	for _, c := range cases {
+		suite.Equal(c.expected, salary.Parse(c.text), c.text)
+	}
+}
+
+func (suite *ParseSuite) Test_Parse_NoSalary() {
+	cases := []string{
+		"",
+		"Bankkaufmann (m/w/d) in München seit 2025",
+		"Wir bieten 30 Tage Urlaub und 1.000 Möglichkeiten",
+		"Gutschein über 5 € pro Monat",
+	}
+
+	for _, c := range cases {
+		suite.Nil(salary.Parse(c), c)
+	}
+}
+
+func (suite *ParseSuite) Test_Parse_FirstTextWins() {
+	// Execute
+	s := salary.Parse("Engineer (€80k)", "Salary between 60.000 and 70.000 EUR")
+
+	// Assert
+	suite.Equal(80000.0, s.Min)
+}
+
+func (suite *ParseSuite) Test_Parse_FallsBackToNextText() {
+	// Execute
+	s := salary.Parse("Engineer", "Salary 60.000 - 70.000 EUR")
+
+	// Assert
+	suite.Equal(&aggregator.Salary{Currency: "EUR", Min: 60000, Max: 70000, AnnualMin: 60000, AnnualMax: 70000, Period: aggregator.SalaryPeriodYear}, s)
+}
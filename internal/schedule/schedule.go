@@ -0,0 +1,145 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/robfig/cron/v3"
+)
+
+type CatchUp int
+
+const (
+	// CatchUpOnce runs a single import for any number of missed runs
+	CatchUpOnce CatchUp = iota
+	// CatchUpSkip drops missed runs and waits for the next one
+	CatchUpSkip
+	// CatchUpAll runs every missed run, up to a limit
+	CatchUpAll
+)
+
+func (c CatchUp) String() string {
+	return [...]string{"once", "skip", "all"}[c]
+}
+
+func ParseCatchUp(s string) (CatchUp, bool) {
+	for _, v := range []CatchUp{CatchUpOnce, CatchUpSkip, CatchUpAll} {
+		if v.String() == s {
+			return v, true
+		}
+	}
+
+	return -1, false
+}
+
+// Intervals shorter than this would flood the import queue
+const minInterval = time.Minute
+
+var (
+	ErrMissingSpec     = errors.New("either a cron expression or an interval is required")
+	ErrConflictingSpec = errors.New("a cron expression and an interval cannot be combined")
+	ErrInvalidCron     = errors.New("invalid cron expression")
+	ErrInvalidInterval = errors.New("interval must be at least 1m")
+	ErrInvalidJitter   = errors.New("jitter cannot be negative")
+	ErrInvalidCatchUp  = errors.New("catch up policy must be one of once, skip or all")
+)
+
+type Schedule struct {
+	s       cron.Schedule
+	spec    string
+	jitter  time.Duration
+	catchUp CatchUp
+}
+
+func Parse(cfg aggregator.ChannelSchedule, defaultCatchUp CatchUp) (*Schedule, error) {
+	var err error
+	var s cron.Schedule
+	var spec string
+
+	switch {
+	case cfg.Cron == "" && cfg.Interval == 0:
+		err = errors.Join(err, ErrMissingSpec)
+	case cfg.Cron != "" && cfg.Interval != 0:
+		err = errors.Join(err, ErrConflictingSpec)
+	case cfg.Cron != "":
+		var cerr error
+		s, cerr = cron.ParseStandard(cfg.Cron)
+		if cerr != nil {
+			err = errors.Join(err, fmt.Errorf("%w %s: %w", ErrInvalidCron, cfg.Cron, cerr))
+		}
+		spec = cfg.Cron
+	case cfg.Interval < minInterval:
+		err = errors.Join(err, ErrInvalidInterval)
+	default:
+		s = cron.Every(cfg.Interval)
+		spec = "@every " + cfg.Interval.String()
+	}
+
+	if cfg.Jitter < 0 {
+		err = errors.Join(err, ErrInvalidJitter)
+	}
+
+	catchUp := defaultCatchUp
+	if cfg.CatchUp != "" {
+		c, ok := ParseCatchUp(cfg.CatchUp)
+		if !ok {
+			err = errors.Join(err, ErrInvalidCatchUp)
+		}
+		catchUp = c
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Jitter > 0 {
+		spec += " ~" + cfg.Jitter.String()
+	}
+
+	return &Schedule{s: s, spec: spec, jitter: cfg.Jitter, catchUp: catchUp}, nil
+}
+
+// Spec identifies when a schedule runs, a changed spec invalidates the planned run
+func (s *Schedule) Spec() string {
+	return s.spec
+}
+
+func (s *Schedule) CatchUp() CatchUp {
+	return s.catchUp
+}
+
+// Next is the first run after t, with jitter to spread channels that share a schedule
+func (s *Schedule) Next(t time.Time) time.Time {
+	next := s.s.Next(t)
+	if s.jitter > 0 {
+		next = next.Add(rand.N(s.jitter))
+	}
+
+	return next
+}
+
+// Oldest is the first of the last limit runs from the planned one up to now, older runs are dropped
+func (s *Schedule) Oldest(planned, now time.Time, limit int) time.Time {
+	runs := []time.Time{planned}
+	for t := s.s.Next(planned); !t.After(now); t = s.s.Next(t) {
+		runs = append(runs, t)
+		if len(runs) > max(limit, 1) {
+			runs = runs[1:]
+		}
+	}
+
+	return runs[0]
+}
+
+// Due counts the runs from the planned one up to now, up to limit
+func (s *Schedule) Due(planned, now time.Time, limit int) int {
+	n := 1
+	for t := s.s.Next(planned); n < limit && !t.After(now); t = s.s.Next(t) {
+		n++
+	}
+
+	return n
+}
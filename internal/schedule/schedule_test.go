@@ -0,0 +1,108 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/schedule"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ScheduleSuite))
+}
+
+type ScheduleSuite struct {
+	suite.Suite
+}
+
+func (suite *ScheduleSuite) Test_Parse_Cron() {
+	// Execute
+	s, err := schedule.Parse(aggregator.ChannelSchedule{Cron: "0 6 * * *"}, schedule.CatchUpOnce)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("0 6 * * *", s.Spec())
+	suite.Equal(schedule.CatchUpOnce, s.CatchUp())
+	suite.Equal(time.Date(2025, 1, 2, 6, 0, 0, 0, time.UTC), s.Next(time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)))
+}
+
+func (suite *ScheduleSuite) Test_Parse_Interval() {
+	// Execute
+	s, err := schedule.Parse(aggregator.ChannelSchedule{Interval: 2 * time.Hour, CatchUp: "all"}, schedule.CatchUpOnce)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal("@every 2h0m0s", s.Spec())
+	suite.Equal(schedule.CatchUpAll, s.CatchUp())
+	suite.Equal(time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC), s.Next(time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)))
+}
+
+func (suite *ScheduleSuite) Test_Parse_Jitter() {
+	// Prepare
+	s, err := schedule.Parse(aggregator.ChannelSchedule{Cron: "@hourly", Jitter: 10 * time.Minute}, schedule.CatchUpOnce)
+	suite.NoError(err)
+	base := time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC)
+
+	for range 20 {
+		// Execute
+		next := s.Next(time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC))
+
+		// Assert runs are spread out after the scheduled time
+		suite.False(next.Before(base))
+		suite.True(next.Before(base.Add(10 * time.Minute)))
+	}
+	suite.Equal("@hourly ~10m0s", s.Spec())
+}
+
+func (suite *ScheduleSuite) Test_Parse_Invalid() {
+	cases := map[string]struct {
+		cfg      aggregator.ChannelSchedule
+		expected []error
+	}{
+		"missing":     {aggregator.ChannelSchedule{}, []error{schedule.ErrMissingSpec}},
+		"conflicting": {aggregator.ChannelSchedule{Cron: "@daily", Interval: time.Hour}, []error{schedule.ErrConflictingSpec}},
+		"cron":        {aggregator.ChannelSchedule{Cron: "every monday"}, []error{schedule.ErrInvalidCron}},
+		"interval":    {aggregator.ChannelSchedule{Interval: 30 * time.Second}, []error{schedule.ErrInvalidInterval}},
+		"multiple":    {aggregator.ChannelSchedule{Cron: "@daily", Jitter: -time.Minute, CatchUp: "sometimes"}, []error{schedule.ErrInvalidJitter, schedule.ErrInvalidCatchUp}},
+	}
+
+	for name, c := range cases {
+		// Execute
+		s, err := schedule.Parse(c.cfg, schedule.CatchUpOnce)
+
+		// Assert
+		suite.Nil(s, name)
+		for _, e := range c.expected {
+			suite.ErrorIs(err, e, name)
+		}
+	}
+}
+
+func (suite *ScheduleSuite) Test_Due() {
+	// Prepare
+	s, err := schedule.Parse(aggregator.ChannelSchedule{Cron: "0 */6 * * *"}, schedule.CatchUpOnce)
+	suite.NoError(err)
+	planned := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+
+	// Execute & Assert
+	suite.Equal(1, s.Due(planned, planned, 10))
+	suite.Equal(1, s.Due(planned, time.Date(2025, 1, 1, 11, 59, 0, 0, time.UTC), 10))
+	suite.Equal(4, s.Due(planned, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), 10))
+	suite.Equal(10, s.Due(planned, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), 10))
+}
+
+func (suite *ScheduleSuite) Test_Oldest() {
+	// Prepare
+	s, err := schedule.Parse(aggregator.ChannelSchedule{Cron: "0 */6 * * *"}, schedule.CatchUpAll)
+	suite.NoError(err)
+	planned := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+
+	// Execute & Assert
+	suite.Equal(planned, s.Oldest(planned, planned, 10))
+	suite.Equal(planned, s.Oldest(planned, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), 10))
+	suite.Equal(time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC), s.Oldest(planned, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), 2))
+	suite.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), s.Oldest(planned, time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC), 0))
+}
@@ -0,0 +1,189 @@
+package skills
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+// contextWindow is the number of tokens around an ambiguous term that are searched for context
+const contextWindow = 3
+
+// contextWords tell an ambiguous term is meant as the skill, as in "Go developer" or "Programmiersprache Rust"
+var contextWords = map[string]struct{}{
+	"backend":             {},
+	"code":                {},
+	"coding":              {},
+	"developer":           {},
+	"developers":          {},
+	"engineer":            {},
+	"engineers":           {},
+	"engineering":         {},
+	"entwickler":          {},
+	"entwicklerin":        {},
+	"entwicklung":         {},
+	"language":            {},
+	"languages":           {},
+	"programmer":          {},
+	"programmers":         {},
+	"programming":         {},
+	"programmiersprache":  {},
+	"programmiersprachen": {},
+}
+
+type mention struct {
+	tm    *term
+	start int
+}
+
+func Extract(texts ...string) aggregator.Skills {
+	t := load()
+
+	counts := make(map[string]int)
+	unconfirmed := make(map[string]int)
+	for _, text := range texts {
+		for _, sentence := range sentences(text) {
+			tokens := tokenize(sentence)
+			mentions := t.scan(tokens)
+			for _, m := range mentions {
+				if m.tm.ambiguous && !hasContext(tokens, m, mentions) {
+					unconfirmed[m.tm.skill]++
+					continue
+				}
+				counts[m.tm.skill]++
+			}
+		}
+	}
+
+	// Ambiguous mentions without context still count for a skill the job mentions in a way that leaves no doubt
+	for name, c := range unconfirmed {
+		if counts[name] > 0 {
+			counts[name] += c
+		}
+	}
+
+	if len(counts) == 0 {
+		return nil
+	}
+
+	result := make(aggregator.Skills, 0, len(counts))
+	for name, c := range counts {
+		result = append(result, &aggregator.Skill{Name: name, Count: c})
+	}
+
+	// The most mentioned skills go first, the order is stable so unchanged jobs stay equal
+	slices.SortFunc(result, func(a, b *aggregator.Skill) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
+
+func Canonical(name string) (string, bool) {
+	// Lookups ignore the casing of exact terms, "go" is meant as the language here
+	canonical, ok := load().names[strings.Join(foldAll(tokenize(name)), " ")]
+	return canonical, ok
+}
+
+func (t *taxonomy) scan(tokens []string) []mention {
+	var result []mention
+	for i := 0; i < len(tokens); {
+		tm := t.match(tokens[i:])
+		if tm == nil {
+			i++
+			continue
+		}
+
+		result = append(result, mention{tm: tm, start: i})
+		i += len(tm.tokens)
+	}
+
+	return result
+}
+
+// hasContext tells whether an ambiguous mention is meant as the skill: it stands on its own like a tag,
+// or another skill or a context word is close to it
+func hasContext(tokens []string, m mention, mentions []mention) bool {
+	if len(tokens) == len(m.tm.tokens) {
+		return true
+	}
+
+	for _, o := range mentions {
+		if o.start != m.start && abs(o.start-m.start) <= contextWindow {
+			return true
+		}
+	}
+
+	for i := max(0, m.start-contextWindow); i < min(len(tokens), m.start+len(m.tm.tokens)+contextWindow); i++ {
+		if _, ok := contextWords[fold(tokens[i])]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+func (t *taxonomy) match(tokens []string) *term {
+	for _, tm := range t.terms[fold(tokens[0])] {
+		if tm.matches(tokens) {
+			return tm
+		}
+	}
+
+	return nil
+}
+
+// sentences splits text where sentences end, so context does not reach into the next sentence.
+// Dots within names like Node.js or .NET are not followed by a space and do not end a sentence.
+func sentences(text string) []string {
+	var result []string
+	start := 0
+	for i := 0; i < len(text); i++ {
+		end := text[i] == '\n'
+		if strings.IndexByte(".!?;", text[i]) >= 0 {
+			end = i+1 == len(text) || strings.IndexByte(" \t\r\n", text[i+1]) >= 0
+		}
+		if end {
+			result = append(result, text[start:i+1])
+			start = i + 1
+		}
+	}
+	if start < len(text) {
+		result = append(result, text[start:])
+	}
+
+	return result
+}
+
+func tokenize(text string) []string {
+	// Symbols that are part of technology names (C++, C#, Node.js, .NET) stay within tokens
+	tokens := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#' && r != '.'
+	})
+
+	result := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		// Dots end sentences and abbreviations, only a leading one belongs to a name
+		tok = strings.TrimRight(tok, ".")
+		if strings.Trim(tok, ".") == "" {
+			continue
+		}
+		result = append(result, tok)
+	}
+
+	return result
+}
@@ -0,0 +1,134 @@
+package skills_test
+
+import (
+	"testing"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/aviseu/jobs-backoffice/internal/skills"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSkills(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SkillsSuite))
+}
+
+type SkillsSuite struct {
+	suite.Suite
+}
+
+func (suite *SkillsSuite) Test_Extract_Synonyms() {
+	// Execute
+	result := skills.Extract(
+		"Senior Golang Engineer",
+		"You will run our Go services on k8s. Experience with Kubernetes and PostgreSQL is a plus.",
+	)
+
+	// Assert synonyms are counted towards their skill, most mentioned first
+	suite.Equal(aggregator.Skills{
+		{Name: "Go", Count: 2},
+		{Name: "Kubernetes", Count: 2},
+		{Name: "PostgreSQL", Count: 1},
+	}, result)
+}
+
+func (suite *SkillsSuite) Test_Extract_Symbols() {
+	// Execute
+	result := skills.Extract("Fullstack (C++/C#, .NET Core, Node.js, CI/CD)")
+
+	// Assert symbols belonging to technology names are kept
+	suite.Equal([]string{".NET", "C#", "C++", "CI/CD", "Node.js"}, result.Names())
+}
+
+func (suite *SkillsSuite) Test_Extract_LongestMatch() {
+	// Execute
+	result := skills.Extract("Spring Boot, Spring and React Native")
+
+	// Assert multi word skills win over their prefixes
+	suite.Equal(aggregator.Skills{
+		{Name: "React Native", Count: 1},
+		{Name: "Spring", Count: 1},
+		{Name: "Spring Boot", Count: 1},
+	}, result)
+}
+
+func (suite *SkillsSuite) Test_Extract_ExactCase() {
+	// Execute
+	result := skills.Extract("Let's go! We react fast and take a rest in spring.")
+
+	// Assert ambiguous words only match with the casing of the technology
+	suite.Nil(result)
+}
+
+func (suite *SkillsSuite) Test_Extract_AmbiguousWithoutContext() {
+	cases := []string{
+		"Wir backen Brot. R&D. C level. Rust belt.",
+		"Go to the office twice a week.",
+		"Swift response to customer requests.",
+		"You are a Go-getter. We have a Rust belt background.",
+	}
+
+	for _, text := range cases {
+		// Execute
+		result := skills.Extract("Sales Manager", text)
+
+		// Assert common words with the casing of a technology are not taken for it
+		suite.Nil(result, text)
+	}
+}
+
+func (suite *SkillsSuite) Test_Extract_AmbiguousWithContext() {
+	// Execute
+	result := skills.Extract(
+		"Rust Developer",
+		"We build our backend in Python, Go and Rust.",
+		"Swift",
+	)
+
+	// Assert ambiguous terms count next to context words and other skills, and on their own as a tag
+	suite.Equal(aggregator.Skills{
+		{Name: "Rust", Count: 2},
+		{Name: "Go", Count: 1},
+		{Name: "Python", Count: 1},
+		{Name: "Swift", Count: 1},
+	}, result)
+}
+
+func (suite *SkillsSuite) Test_Extract_AmbiguousConfirmedElsewhere() {
+	// Execute
+	result := skills.Extract("Golang Engineer", "Go is what we write every day.")
+
+	// Assert an ambiguous mention counts once the skill is mentioned without doubt
+	suite.Equal(aggregator.Skills{{Name: "Go", Count: 2}}, result)
+}
+
+func (suite *SkillsSuite) Test_Extract_Tags() {
+	// Execute
+	result := skills.Extract("Backend Engineer", "Kubernetes", "Finance", "golang")
+
+	// Assert provider tags are matched against the taxonomy as well
+	suite.Equal([]string{"Go", "Kubernetes"}, result.Names())
+}
+
+func (suite *SkillsSuite) Test_Canonical() {
+	cases := map[string]string{
+		"go":          "Go",
+		"Golang":      "Go",
+		"K8S":         "Kubernetes",
+		"react.js":    "React",
+		"spring boot": "Spring Boot",
+		"postgres":    "PostgreSQL",
+	}
+
+	for name, expected := range cases {
+		// Execute
+		canonical, ok := skills.Canonical(name)
+
+		// Assert
+		suite.True(ok, name)
+		suite.Equal(expected, canonical, name)
+	}
+
+	_, ok := skills.Canonical("cobolt")
+	suite.False(ok)
+}
@@ -0,0 +1,109 @@
+package skills
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+//go:embed data/taxonomy.tsv
+var taxonomyData string
+
+type term struct {
+	skill     string
+	tokens    []string
+	exact     bool
+	ambiguous bool
+}
+
+type taxonomy struct {
+	// Terms are indexed by their first folded token, longest terms first
+	terms map[string][]*term
+	names map[string]string
+}
+
+var load = sync.OnceValue(func() *taxonomy {
+	t := &taxonomy{
+		terms: make(map[string][]*term),
+		names: make(map[string]string),
+	}
+
+	// The taxonomy is embedded, so a malformed line is a programming error
+	s := bufio.NewScanner(strings.NewReader(taxonomyData))
+	for s.Scan() {
+		line := s.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		f := strings.Split(line, "\t")
+		if len(f) > 2 {
+			panic(fmt.Sprintf("expected at most 2 fields, got %d: %s", len(f), line))
+		}
+
+		name := strings.TrimPrefix(strings.TrimPrefix(f[0], "?"), "=")
+		if _, ok := t.names[fold(name)]; ok {
+			panic(fmt.Sprintf("duplicate skill %s", name))
+		}
+
+		raw := []string{f[0]}
+		if len(f) == 2 && f[1] != "" {
+			raw = append(raw, strings.Split(f[1], ",")...)
+		}
+		for _, r := range raw {
+			tm := &term{skill: name, ambiguous: strings.HasPrefix(r, "?")}
+			r = strings.TrimPrefix(r, "?")
+			tm.exact = strings.HasPrefix(r, "=")
+			tm.tokens = tokenize(strings.TrimPrefix(r, "="))
+			if len(tm.tokens) == 0 {
+				panic(fmt.Sprintf("empty term for skill %s", name))
+			}
+			if !tm.exact {
+				tm.tokens = foldAll(tm.tokens)
+			}
+
+			first := fold(tm.tokens[0])
+			t.terms[first] = append(t.terms[first], tm)
+			t.names[strings.Join(foldAll(tm.tokens), " ")] = name
+		}
+	}
+
+	for _, tt := range t.terms {
+		slices.SortStableFunc(tt, func(a, b *term) int { return len(b.tokens) - len(a.tokens) })
+	}
+
+	return t
+})
+
+func (tm *term) matches(tokens []string) bool {
+	if len(tokens) < len(tm.tokens) {
+		return false
+	}
+
+	for i, tok := range tm.tokens {
+		if tm.exact && tokens[i] != tok {
+			return false
+		}
+		if !tm.exact && fold(tokens[i]) != tok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func fold(s string) string {
+	return strings.ToLower(s)
+}
+
+func foldAll(tokens []string) []string {
+	result := make([]string, len(tokens))
+	for i, tok := range tokens {
+		result[i] = fold(tok)
+	}
+
+	return result
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"gopkg.in/guregu/null.v3"
@@ -13,7 +14,12 @@ import (
 const (
 	pageSize = 2
 
-	ArbeitnowMethodNotFound = "3fae894d-3484-4274-b337-fcd35a9f135c"
+	ArbeitnowMethodNotFound  = "3fae894d-3484-4274-b337-fcd35a9f135c"
+	ArbeitnowSecondPageFails = "8d3b0b4c-6f0e-4b8c-9f53-2a1de3c7a9b1"
+	ArbeitnowInvalidJobs     = "c5a7f2e1-2b4d-4e0a-8f3c-6d9b1a0e7c42"
+	ArbeitnowSalaryJobs      = "e2b8c4d6-9a1f-4c3e-b7d5-0f6a2e8c1b93"
+	ArbeitnowEnglishJobs     = "7f4d2a91-c3e8-4b6f-a0d5-9e1b8c3f6a27"
+	ArbeitnowSlow            = "b4e1c7a2-5d3f-4e8b-9c6a-1f2d3e4a5b6c"
 )
 
 type jobEntry struct {
@@ -59,7 +65,51 @@ func NewArbeitnowServer() *httptest.Server {
 			return
 		}
 
+		if r.Header.Get("X-Channel-Id") == ArbeitnowSlow {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			http.Error(w, "gateway timeout", http.StatusGatewayTimeout)
+			return
+		}
+
+		if r.Header.Get("X-Channel-Id") == ArbeitnowSecondPageFails && page > 1 {
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+
 		data := arbeitnowData()
+		if r.Header.Get("X-Channel-Id") == ArbeitnowInvalidJobs {
+			data = append(data, &jobEntry{
+				Slug:      "invalid-job",
+				URL:       "/jobs/invalid-job",
+				Location:  "Berlin",
+				CreatedAt: 0,
+			})
+		}
+		if r.Header.Get("X-Channel-Id") == ArbeitnowSalaryJobs {
+			data = append(data, &jobEntry{
+				Slug:        "senior-accountant-berlin-410022",
+				Title:       "Senior Accountant (€60k–75k)",
+				Description: "<p>Vergütung: 3.500 - 4.000 € netto pro Monat</p>",
+				URL:         "https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/senior-accountant-berlin-410022",
+				Location:    "Berlin",
+				CreatedAt:   1739357344,
+			})
+		}
+		if r.Header.Get("X-Channel-Id") == ArbeitnowEnglishJobs {
+			data = append(data, &jobEntry{
+				Slug:        "backend-engineer-go-berlin-520311",
+				Title:       "Backend Engineer (Go)",
+				Description: "<p>We are looking for an experienced backend engineer to join our growing platform team. You will design, build and maintain the services that power our products and work closely with product managers and other engineers.</p><ul><li>Several years of experience with Go and relational databases</li><li>Good communication skills and a strong sense of ownership</li></ul>",
+				URL:         "https://WWW.arbeitnow.com:443/jobs/companies/opus-one-recruitment-gmbh/backend-engineer-go-berlin-520311?utm_source=arbeitnow&utm_medium=api",
+				Tags:        []string{"Golang", "Kubernetes"},
+				JobTypes:    []string{"Full Time", "Berufserfahren"},
+				Location:    "Berlin",
+				CreatedAt:   1739357344,
+			})
+		}
 		// paginate data based on page and pageSize and length
 		start := (page - 1) * pageSize
 		end := start + pageSize
@@ -0,0 +1,105 @@
+package testutils
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type BlobStore struct {
+	Blobs map[string]*aggregator.Blob
+	Data  map[string][]byte
+	err   error
+	m     sync.Mutex
+}
+
+func NewBlobStore() *BlobStore {
+	return &BlobStore{
+		Blobs: make(map[string]*aggregator.Blob),
+		Data:  make(map[string][]byte),
+	}
+}
+
+func (s *BlobStore) FailWith(err error) {
+	s.err = err
+}
+
+func (s *BlobStore) Age(d time.Duration) {
+	for _, b := range s.Blobs {
+		b.ModifiedAt = b.ModifiedAt.Add(-d)
+	}
+}
+
+func (s *BlobStore) Put(_ context.Context, key string, data []byte) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.Blobs[key] = &aggregator.Blob{Key: key, ModifiedAt: time.Now()}
+	s.Data[key] = slices.Clone(data)
+
+	return nil
+}
+
+func (s *BlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	data, ok := s.Data[key]
+	if !ok {
+		return nil, infrastructure.ErrBlobNotFound
+	}
+
+	return slices.Clone(data), nil
+}
+
+func (s *BlobStore) List(_ context.Context, prefix string) ([]*aggregator.Blob, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	blobs := make([]*aggregator.Blob, 0)
+	for key, b := range s.Blobs {
+		if strings.HasPrefix(key, prefix) {
+			blobs = append(blobs, b)
+		}
+	}
+
+	slices.SortFunc(blobs, func(a, b *aggregator.Blob) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return blobs, nil
+}
+
+func (s *BlobStore) Delete(_ context.Context, key string) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, ok := s.Blobs[key]; !ok {
+		return infrastructure.ErrBlobNotFound
+	}
+	delete(s.Blobs, key)
+	delete(s.Data, key)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package testutils
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+type BlocklistRepository struct {
+	Entries map[uuid.UUID]*aggregator.BlocklistEntry
+	err     error
+}
+
+func NewBlocklistRepository() *BlocklistRepository {
+	return &BlocklistRepository{
+		Entries: make(map[uuid.UUID]*aggregator.BlocklistEntry),
+	}
+}
+
+func (r *BlocklistRepository) First() *aggregator.BlocklistEntry {
+	for _, e := range r.Entries {
+		return e
+	}
+
+	return nil
+}
+
+func (r *BlocklistRepository) Add(e *aggregator.BlocklistEntry) {
+	r.Entries[e.ID] = e
+}
+
+func (r *BlocklistRepository) FailWith(err error) {
+	r.err = err
+}
+
+func (r *BlocklistRepository) All(_ context.Context) ([]*aggregator.BlocklistEntry, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	entries := make([]*aggregator.BlocklistEntry, 0, len(r.Entries))
+	for _, e := range r.Entries {
+		entries = append(entries, e)
+	}
+
+	slices.SortFunc(entries, func(a, b *aggregator.BlocklistEntry) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+
+	return entries, nil
+}
+
+func (r *BlocklistRepository) Save(_ context.Context, e *aggregator.BlocklistEntry) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.Entries[e.ID] = e
+	return nil
+}
+
+func (r *BlocklistRepository) Delete(_ context.Context, id uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if _, ok := r.Entries[id]; !ok {
+		return infrastructure.ErrBlocklistEntryNotFound
+	}
+
+	delete(r.Entries, id)
+	return nil
+}
@@ -2,14 +2,18 @@ package testutils
 
 import (
 	"bytes"
+	"context"
 	"log/slog"
 	oghttp "net/http"
 	"net/http/httptest"
 	"time"
 
 	"github.com/aviseu/jobs-backoffice/internal/app/application/http"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/blocking"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/configuring"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/dispatching"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/importing"
+	"github.com/aviseu/jobs-backoffice/internal/app/domain/linkchecking"
 	"github.com/aviseu/jobs-backoffice/internal/app/domain/scheduling"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/api/arbeitnow"
@@ -25,18 +29,35 @@ type DSL struct {
 	LogBuffer        *bytes.Buffer
 	AirbeitnowServer *httptest.Server
 	Config           *importing.Config
+	LinkConfig       *linkchecking.Config
+	ScheduleConfig   *scheduling.Config
+	DispatchConfig   *dispatching.Config
+	Enrichers        map[string]importing.Enricher
 
 	// Infrastructure
-	JobRepository       *JobRepository
-	ChannelRepository   *ChannelRepository
-	ImportRepository    *ImportRepository
-	PubSubImportService *PubSubImportService
-	PubSubJobService    *PubSubJobService
+	JobRepository         *JobRepository
+	ChannelRepository     *ChannelRepository
+	ImportRepository      *ImportRepository
+	BlocklistRepository   *BlocklistRepository
+	LinkRepository        *LinkRepository
+	ScheduleRepository    *ScheduleRepository
+	LeaseRepository       *LeaseRepository
+	ScheduleRunRepository *ScheduleRunRepository
+	ImportQueueRepository *ImportQueueRepository
+	PubSubImportService   *PubSubImportService
+	PubSubJobService      *PubSubJobService
+	BlobStore             *BlobStore
 
 	// Domains
 	ConfiguringService *configuring.Service
 	ImportService      *importing.Service
+	PreviewService     *importing.PreviewService
+	BlockingService    *blocking.Service
 	SchedulingService  *scheduling.Service
+	SchedulingDaemon   *scheduling.Daemon
+	LinkService        *linkchecking.Service
+	ImportQueue        *dispatching.Queue
+	Dispatcher         *dispatching.Dispatcher
 
 	// Application
 	APIServer    oghttp.Handler
@@ -64,6 +85,132 @@ func WithImportRepositoryError(err error) DSLOptions {
 	}
 }
 
+func WithJobRepositoryError(err error) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.JobRepository == nil {
+			dsl.JobRepository = NewJobRepository()
+		}
+		dsl.JobRepository.FailWith(err)
+	}
+}
+
+func WithBlocklistRepositoryError(err error) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.BlocklistRepository == nil {
+			dsl.BlocklistRepository = NewBlocklistRepository()
+		}
+		dsl.BlocklistRepository.FailWith(err)
+	}
+}
+
+func WithLinkRepositoryError(err error) DSLOptions {
+	return func(dsl *DSL) {
+		dsl.initLinkRepository()
+		dsl.LinkRepository.FailWith(err)
+	}
+}
+
+func WithLinkConfig(cfg linkchecking.Config) DSLOptions {
+	return func(dsl *DSL) {
+		dsl.LinkConfig = &cfg
+	}
+}
+
+func WithScheduleRepositoryError(err error) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.ScheduleRepository == nil {
+			dsl.ScheduleRepository = NewScheduleRepository()
+		}
+		dsl.ScheduleRepository.FailWith(err)
+	}
+}
+
+func WithScheduleConfig(cfg scheduling.Config) DSLOptions {
+	return func(dsl *DSL) {
+		dsl.ScheduleConfig = &cfg
+	}
+}
+
+func WithScheduleState(chID uuid.UUID, spec string, nextRunAt time.Time) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.ScheduleRepository == nil {
+			dsl.ScheduleRepository = NewScheduleRepository()
+		}
+		dsl.ScheduleRepository.Add(&aggregator.ScheduleState{
+			ChannelID: chID,
+			Spec:      spec,
+			NextRunAt: nextRunAt,
+		})
+	}
+}
+
+func WithScheduleRunRepositoryError(err error) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.ScheduleRunRepository == nil {
+			dsl.ScheduleRunRepository = NewScheduleRunRepository()
+		}
+		dsl.ScheduleRunRepository.FailWith(err)
+	}
+}
+
+func WithScheduleRun(run *aggregator.ScheduleRun) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.ScheduleRunRepository == nil {
+			dsl.ScheduleRunRepository = NewScheduleRunRepository()
+		}
+		dsl.ScheduleRunRepository.Add(run)
+	}
+}
+
+func WithImportQueueRepositoryError(err error) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.ImportQueueRepository == nil {
+			dsl.ImportQueueRepository = NewImportQueueRepository()
+		}
+		dsl.ImportQueueRepository.FailWith(err)
+	}
+}
+
+func WithQueuedImport(qi *aggregator.QueuedImport) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.ImportQueueRepository == nil {
+			dsl.ImportQueueRepository = NewImportQueueRepository()
+		}
+		dsl.ImportQueueRepository.Add(qi)
+	}
+}
+
+func WithDispatchConfig(cfg dispatching.Config) DSLOptions {
+	return func(dsl *DSL) {
+		dsl.DispatchConfig = &cfg
+	}
+}
+
+func WithLeaseRepositoryError(err error) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.LeaseRepository == nil {
+			dsl.LeaseRepository = NewLeaseRepository()
+		}
+		dsl.LeaseRepository.FailWith(err)
+	}
+}
+
+func WithLease(name, holder string, token int64, expiresAt time.Time) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.LeaseRepository == nil {
+			dsl.LeaseRepository = NewLeaseRepository()
+		}
+		dsl.LeaseRepository.Add(&aggregator.Lease{
+			Name:       name,
+			Holder:     holder,
+			Token:      token,
+			AcquiredAt: expiresAt.Add(-time.Minute),
+			RenewedAt:  expiresAt.Add(-time.Minute),
+			ExpiresAt:  expiresAt,
+		})
+	}
+}
+
 func WithPubSubServiceError(err error) DSLOptions {
 	return func(dsl *DSL) {
 		if dsl.PubSubImportService == nil {
@@ -81,7 +228,59 @@ func WithArbeitnowEnabled() DSLOptions {
 
 		if dsl.Config == nil {
 			dsl.Config = dsl.defaultConfig()
-			dsl.Config.Arbeitnow.URL = dsl.AirbeitnowServer.URL
+		}
+		dsl.Config.Arbeitnow.URL = dsl.AirbeitnowServer.URL
+	}
+}
+
+func WithImportGuard(maxPercentage float64, maxCount, minActive int) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.Config == nil {
+			dsl.Config = dsl.defaultConfig()
+		}
+		dsl.Config.Guard = importing.ConfigGuard{
+			MaxMissingPercentage: maxPercentage,
+			MaxMissingCount:      maxCount,
+			MinActiveJobs:        minActive,
+		}
+	}
+}
+
+func WithPreviewLimit(maxPages int, timeout time.Duration) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.Config == nil {
+			dsl.Config = dsl.defaultConfig()
+		}
+		dsl.Config.Preview = importing.ConfigPreview{
+			MaxPages: maxPages,
+			Timeout:  timeout,
+		}
+	}
+}
+
+func WithBlobStoreError(err error) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.BlobStore == nil {
+			dsl.BlobStore = NewBlobStore()
+		}
+		dsl.BlobStore.FailWith(err)
+	}
+}
+
+func WithEnricher(name string, e importing.Enricher) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.Enrichers == nil {
+			dsl.Enrichers = make(map[string]importing.Enricher)
+		}
+		dsl.Enrichers[name] = e
+	}
+}
+
+// WithNoopEnrichers registers enrichers that leave jobs as they are, for tests that only configure them
+func WithNoopEnrichers(names ...string) DSLOptions {
+	return func(dsl *DSL) {
+		for _, name := range names {
+			WithEnricher(name, importing.EnricherFunc(func(context.Context, *aggregator.Job) error { return nil }))(dsl)
 		}
 	}
 }
@@ -131,6 +330,12 @@ func WithChannelTimestamps(cat, uat time.Time) WithChannelOptions {
 	}
 }
 
+func WithChannelSettings(settings aggregator.ChannelSettings) WithChannelOptions {
+	return func(ch *aggregator.Channel) {
+		ch.Settings = settings
+	}
+}
+
 func WithChannel(opts ...WithChannelOptions) DSLOptions {
 	return func(dsl *DSL) {
 		if dsl.ChannelRepository == nil {
@@ -191,6 +396,12 @@ func WithImportStartedAt(startedAt time.Time) WithImportOptions {
 	}
 }
 
+func WithImportUpdatedAt(updatedAt time.Time) WithImportOptions {
+	return func(i *aggregator.Import) {
+		i.UpdatedAt = updatedAt
+	}
+}
+
 func WithImportEndedAt(endedAt time.Time) WithImportOptions {
 	return func(i *aggregator.Import) {
 		i.EndedAt = null.NewTime(endedAt, true)
@@ -203,6 +414,24 @@ func WithoutImportEndedAt() WithImportOptions {
 	}
 }
 
+func WithImportReplayOf(id uuid.UUID) WithImportOptions {
+	return func(i *aggregator.Import) {
+		i.ReplayOf = uuid.NullUUID{UUID: id, Valid: true}
+	}
+}
+
+func WithImportCheckpoint(pages int, completed bool) WithImportOptions {
+	return func(i *aggregator.Import) {
+		i.Checkpoint = &aggregator.ImportCheckpoint{Pages: pages, Completed: completed, UpdatedAt: time.Now()}
+	}
+}
+
+func WithImportApproved() WithImportOptions {
+	return func(i *aggregator.Import) {
+		i.Approved = true
+	}
+}
+
 func WithImportMetrics(metricType aggregator.ImportMetricType, count int) WithImportOptions {
 	return func(i *aggregator.Import) {
 		for j := 0; j < count; j++ {
@@ -227,6 +456,10 @@ func WithImportMetadata(metricType aggregator.ImportMetricType, count int) WithI
 				Published:        0,
 				LatePublished:    0,
 				MissingPublished: 0,
+				PendingMissing:   0,
+				Expired:          0,
+				Filtered:         0,
+				Blocked:          0,
 			}
 		}
 		switch metricType {
@@ -246,6 +479,18 @@ func WithImportMetadata(metricType aggregator.ImportMetricType, count int) WithI
 			i.Metadata.LatePublished += count
 		case aggregator.ImportMetricTypeMissingPublish:
 			i.Metadata.MissingPublished += count
+		case aggregator.ImportMetricTypePendingMissing:
+			i.Metadata.PendingMissing += count
+		case aggregator.ImportMetricTypeExpired:
+			i.Metadata.Expired += count
+		case aggregator.ImportMetricTypeFiltered:
+			i.Metadata.Filtered += count
+		case aggregator.ImportMetricTypeBlocked:
+			i.Metadata.Blocked += count
+		case aggregator.ImportMetricTypeDeadLink:
+			i.Metadata.DeadLinks += count
+		case aggregator.ImportMetricTypeHeldPublish:
+			i.Metadata.HeldPublished += count
 		}
 	}
 }
@@ -257,6 +502,7 @@ func WithImport(opts ...WithImportOptions) DSLOptions {
 		}
 		i := &aggregator.Import{
 			StartedAt: time.Date(2020, 1, 1, 0, 0, 3, 0, time.UTC),
+			UpdatedAt: time.Now(),
 			EndedAt:   null.NewTime(time.Now(), false),
 			Error:     null.NewString("", false),
 			Metrics:   make([]*aggregator.ImportMetric, 0),
@@ -270,6 +516,10 @@ func WithImport(opts ...WithImportOptions) DSLOptions {
 		dsl.ImportRepository.AddImport(
 			i,
 		)
+		if i.Checkpoint != nil {
+			i.Checkpoint.ImportID = i.ID
+			dsl.ImportRepository.AddCheckpoint(i.Checkpoint)
+		}
 	}
 }
 
@@ -317,12 +567,25 @@ func WithJobDescription(description string) WithJobOptions {
 	}
 }
 
+func WithJobDescriptionRenderings(text, markdown string) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.DescriptionText = text
+		j.DescriptionMarkdown = markdown
+	}
+}
+
 func WithJobSource(source string) WithJobOptions {
 	return func(j *aggregator.Job) {
 		j.Source = source
 	}
 }
 
+func WithJobCompany(company string) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.Company = company
+	}
+}
+
 func WithJobLocation(location string) WithJobOptions {
 	return func(j *aggregator.Job) {
 		j.Location = location
@@ -341,6 +604,61 @@ func WithJobPostedAt(postedAt time.Time) WithJobOptions {
 	}
 }
 
+func WithJobMissed(imports int, since time.Time) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.MissedImports = imports
+		j.MissingSince = null.TimeFrom(since)
+	}
+}
+
+func WithJobValidThrough(validThrough time.Time) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.ValidThrough = null.TimeFrom(validThrough)
+	}
+}
+
+func WithJobEnrichments(enrichments map[string]string) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.Enrichments = enrichments
+	}
+}
+
+func WithJobSalary(salary *aggregator.Salary) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.Salary = salary
+	}
+}
+
+func WithJobGeo(geo aggregator.Geo) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.Geo = geo
+	}
+}
+
+func WithJobQuality(quality *aggregator.Quality) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.Quality = quality
+	}
+}
+
+func WithJobSkills(skills aggregator.Skills) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.Skills = skills
+	}
+}
+
+func WithJobClassification(c aggregator.Classification) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.Classification = c
+	}
+}
+
+func WithJobLanguage(language string) WithJobOptions {
+	return func(j *aggregator.Job) {
+		j.Language = language
+	}
+}
+
 func WithJobTimestamps(cat, uat time.Time) WithJobOptions {
 	return func(j *aggregator.Job) {
 		j.CreatedAt = cat
@@ -360,13 +678,34 @@ func WithJob(opts ...WithJobOptions) DSLOptions {
 			PublishStatus: aggregator.JobPublishStatusPublished,
 			URL:           "https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288",
 			Title:         "Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)",
-			Description:   "<p>Unser Kunde ist im Bereich Vermögensverwaltung und Fondmanagement ein führender Finanzdienstleister mit Sitz in München. Als zuverlässiger Partner unabhängiger Vermögensberater und ausgewählter institutioneller Kunden verfügt das Unternehmen über ein Verwaltungsvolumen mehrerer Mrd. EUR. Mit derzeit über 40 Mitarbeitern befasst sich das Unternehmen um alle Vermögensbelange seines Kunden. Nachhaltige Qualität und Kundenzufriedenheit stehen im Mittelpunkt des Unternehmens.</p>\n<p>Wir freuen uns auf Ihre Bewerbung als</p>\n<p><strong>Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Überprüfung und Dokumentation von Daueraufträgen sowie (Dauer)-Lastschriften.</li>\n<li>Abwicklung des Zahlungsverkehrs im In- und Ausland.</li>\n<li>Bearbeitung von Nachlasskonten im Zusammenhang mit der Kontolöschung.</li>\n<li>Erfassung interner Kostenrechnungen und Kundenbuchungen.</li>\n<li>Überprüfung und Erfassung von Kontolöschungen. </li>\n<li>Durchführung von Tests für bestehende und neu einzuführende Prozesse.</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung als Bankkaufmann (m/w/d) oder vergleichbare kaufmännische Qualifikation.</li>\n<li>Expertise im nationalen und internationalen Zahlungsverkehr.</li>\n<li>Kenntnisse in der Kundenstammdatenpflege.</li>\n<li>Fähigkeit zur selbstständigen Arbeit sowie analytische Herangehensweise</li>\n<li>Anwendungssicher in MS Office, insbesondere Excel von Vorteil.</li>\n<li>Hohes Maß an sorgfältiger und präziser Arbeitsweise</li>\n</ul>\n<h2>Benefits</h2>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen) </li>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching </li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen </li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen </li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers </li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Wir freuen uns darauf, Dich kennen zu lernen! Sende Deine aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Deinem Gehaltswunsch sowie Deinem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Dich ist und ob wir Dir außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>DEIN ANSPRECHPARTNER:</strong></p>\n<p>Frau Elwira Dabrowska | Tel.: 089/890 648 1039</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\">Jobs in Germany</a> on Arbeitnow</a>",
+			Description:   "<p>Unser Kunde ist im Bereich Vermögensverwaltung und Fondmanagement ein führender Finanzdienstleister mit Sitz in München. Als zuverlässiger Partner unabhängiger Vermögensberater und ausgewählter institutioneller Kunden verfügt das Unternehmen über ein Verwaltungsvolumen mehrerer Mrd. EUR. Mit derzeit über 40 Mitarbeitern befasst sich das Unternehmen um alle Vermögensbelange seines Kunden. Nachhaltige Qualität und Kundenzufriedenheit stehen im Mittelpunkt des Unternehmens.</p>\n<p>Wir freuen uns auf Ihre Bewerbung als</p>\n<p><strong>Bankkauffrau im Bereich Zahlungsverkehr und Kontolöschung (m/w/d)</strong></p>\n<h2>Aufgaben</h2>\n<ul>\n<li>Überprüfung und Dokumentation von Daueraufträgen sowie (Dauer)-Lastschriften.</li>\n<li>Abwicklung des Zahlungsverkehrs im In- und Ausland.</li>\n<li>Bearbeitung von Nachlasskonten im Zusammenhang mit der Kontolöschung.</li>\n<li>Erfassung interner Kostenrechnungen und Kundenbuchungen.</li>\n<li>Überprüfung und Erfassung von Kontolöschungen. </li>\n<li>Durchführung von Tests für bestehende und neu einzuführende Prozesse.</li>\n</ul>\n<h2>Qualifikation</h2>\n<ul>\n<li>Abgeschlossene Ausbildung als Bankkaufmann (m/w/d) oder vergleichbare kaufmännische Qualifikation.</li>\n<li>Expertise im nationalen und internationalen Zahlungsverkehr.</li>\n<li>Kenntnisse in der Kundenstammdatenpflege.</li>\n<li>Fähigkeit zur selbstständigen Arbeit sowie analytische Herangehensweise</li>\n<li>Anwendungssicher in MS Office, insbesondere Excel von Vorteil.</li>\n<li>Hohes Maß an sorgfältiger und präziser Arbeitsweise</li>\n</ul>\n<h2>Benefits</h2>\n<ul>\n<li>Sie bewerben sich einmal bei uns und wir übernehmen die Suche nach einem passenden Job für Sie</li>\n<li>Zugang zum sog. verdeckten Stellenmarkt (nicht ausgeschriebene Stellen) </li>\n<li>Persönliches Interview mit anschließendem individuellem Karrierecoaching </li>\n<li>Eine Vielzahl an Stellen, die kurzfristig besetzt werden müssen </li>\n<li>Persönliche Kontakte zu Entscheidern und hilfreiche Informationen </li>\n<li>Beratung zum Arbeitsvertrag des neuen Arbeitgebers </li>\n<li>Selbstverständlich behandeln wir Ihre persönlichen Daten und Bewerbungsunterlagen absolut vertraulich und diskret</li>\n<li>Unsere Leistung ist für Sie als Bewerber (m/w/d) absolut kostenlos</li>\n</ul>\n<p>Wir freuen uns darauf, Dich kennen zu lernen! Sende Deine aussagekräftigen Bewerbungsunterlagen (mit Angaben zu Deinem Gehaltswunsch sowie Deinem nächstmöglichen Eintrittstermin).</p>\n<p>Gemeinsam finden wir heraus, ob diese Position die Richtige für Dich ist und ob wir Dir außerdem noch andere Perspektiven anbieten können.</p>\n<p><strong>DEIN ANSPRECHPARTNER:</strong></p>\n<p>Frau Elwira Dabrowska | Tel.: 089/890 648 1039</p>\n<p>Find <a href=\"https://www.arbeitnow.com/\" rel=\"nofollow noopener\">Jobs in Germany</a> on Arbeitnow</p>",
 			Source:        aggregator.IntegrationArbeitnow.String(),
+			Company:       "OPUS ONE Recruitment GmbH",
 			Location:      "Munich",
-			Remote:        true,
-			PostedAt:      time.Unix(1739357344, 0),
-			CreatedAt:     time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
-			UpdatedAt:     time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC),
+			Language:      "de",
+			Geo: aggregator.Geo{
+				City:        "Munich",
+				Region:      "Bavaria",
+				CountryCode: "DE",
+				Latitude:    null.FloatFrom(48.13743),
+				Longitude:   null.FloatFrom(11.57549),
+				RemoteScope: aggregator.RemoteScopeCountry,
+			},
+			Quality: &aggregator.Quality{
+				Score:   100,
+				Signals: make([]*aggregator.QualitySignal, 0),
+			},
+			Skills: aggregator.Skills{{Name: "Excel", Count: 1}},
+			Classification: aggregator.Classification{
+				Seniority:                aggregator.SeniorityMid,
+				SeniorityConfidence:      0.3,
+				EmploymentType:           aggregator.EmploymentTypeFullTime,
+				EmploymentTypeConfidence: 0.3,
+			},
+			Remote:    true,
+			PostedAt:  time.Unix(1739357344, 0),
+			CreatedAt: time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC),
 		}
 		for _, opt := range opts {
 			opt(j)
@@ -375,6 +714,86 @@ func WithJob(opts ...WithJobOptions) DSLOptions {
 	}
 }
 
+type WithLinkOptions func(l *aggregator.Link)
+
+func WithLinkURL(url string) WithLinkOptions {
+	return func(l *aggregator.Link) {
+		l.URL = url
+	}
+}
+
+func WithLinkCheckedAt(checkedAt time.Time) WithLinkOptions {
+	return func(l *aggregator.Link) {
+		l.CheckedAt = checkedAt
+	}
+}
+
+func WithLink(jobID uuid.UUID, status aggregator.LinkStatus, failures int, opts ...WithLinkOptions) DSLOptions {
+	return func(dsl *DSL) {
+		dsl.initLinkRepository()
+		l := &aggregator.Link{
+			JobID:     jobID,
+			URL:       "https://www.arbeitnow.com/jobs/companies/opus-one-recruitment-gmbh/bankkauffrau-im-bereich-zahlungsverkehr-und-kontoloschung-munich-290288",
+			Status:    status,
+			Failures:  failures,
+			CheckedAt: time.Date(2025, 1, 1, 0, 5, 0, 0, time.UTC),
+		}
+		for _, opt := range opts {
+			opt(l)
+		}
+		dsl.LinkRepository.Add(l)
+	}
+}
+
+type WithBlocklistEntryOptions func(e *aggregator.BlocklistEntry)
+
+func WithBlocklistEntryID(id uuid.UUID) WithBlocklistEntryOptions {
+	return func(e *aggregator.BlocklistEntry) {
+		e.ID = id
+	}
+}
+
+func WithBlocklistEntryReason(reason string) WithBlocklistEntryOptions {
+	return func(e *aggregator.BlocklistEntry) {
+		e.Reason = reason
+	}
+}
+
+func WithBlocklistEntry(kind aggregator.BlocklistKind, value string, opts ...WithBlocklistEntryOptions) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.BlocklistRepository == nil {
+			dsl.BlocklistRepository = NewBlocklistRepository()
+		}
+		e := &aggregator.BlocklistEntry{
+			ID:        uuid.New(),
+			Kind:      kind,
+			Value:     value,
+			CreatedAt: time.Date(2025, 1, 1, 0, 4, 0, 0, time.UTC),
+		}
+		for _, opt := range opts {
+			opt(e)
+		}
+		dsl.BlocklistRepository.Add(e)
+	}
+}
+
+func WithJobVersion(jobID, importID uuid.UUID, reason aggregator.ImportMetricType, changes ...*aggregator.JobFieldChange) DSLOptions {
+	return func(dsl *DSL) {
+		if dsl.JobRepository == nil {
+			dsl.JobRepository = NewJobRepository()
+		}
+		dsl.JobRepository.AddVersion(&aggregator.JobVersion{
+			ID:        uuid.New(),
+			JobID:     jobID,
+			ImportID:  uuid.NullUUID{UUID: importID, Valid: true},
+			Version:   len(dsl.JobRepository.Versions[jobID]) + 1,
+			Reason:    reason,
+			Changes:   changes,
+			CreatedAt: time.Date(2025, 1, 1, 0, 3, 0, 0, time.UTC),
+		})
+	}
+}
+
 func NewDSL(opts ...DSLOptions) *DSL {
 	dsl := &DSL{}
 
@@ -385,15 +804,16 @@ func NewDSL(opts ...DSLOptions) *DSL {
 	if dsl.ChannelRepository == nil {
 		dsl.ChannelRepository = NewChannelRepository()
 	}
-	if dsl.ConfiguringService == nil {
-		dsl.ConfiguringService = configuring.NewService(dsl.ChannelRepository)
-	}
 	if dsl.ImportRepository == nil {
 		dsl.ImportRepository = NewImportRepository()
 	}
 	if dsl.JobRepository == nil {
 		dsl.JobRepository = NewJobRepository()
 	}
+	if dsl.BlocklistRepository == nil {
+		dsl.BlocklistRepository = NewBlocklistRepository()
+	}
+	dsl.initLinkRepository()
 	if dsl.HTTPClient == nil {
 		dsl.HTTPClient = NewHTTPClientMock()
 	}
@@ -406,14 +826,68 @@ func NewDSL(opts ...DSLOptions) *DSL {
 	if dsl.PubSubJobService == nil {
 		dsl.PubSubJobService = NewPubSubJobService()
 	}
+	if dsl.BlobStore == nil {
+		dsl.BlobStore = NewBlobStore()
+	}
 	if dsl.ImportService == nil {
-		dsl.ImportService = importing.NewService(dsl.ChannelRepository, dsl.ImportRepository, dsl.JobRepository, dsl.HTTPClient, *dsl.Config, dsl.PubSubJobService, dsl.Logger)
+		dsl.ImportService = importing.NewService(dsl.ChannelRepository, dsl.ImportRepository, dsl.JobRepository, dsl.BlocklistRepository, dsl.LinkRepository, dsl.HTTPClient, *dsl.Config, dsl.PubSubJobService, dsl.BlobStore, dsl.Logger)
+	}
+	if dsl.PreviewService == nil {
+		dsl.PreviewService = importing.NewPreviewService(dsl.ChannelRepository, dsl.JobRepository, dsl.BlocklistRepository, dsl.LinkRepository, dsl.HTTPClient, *dsl.Config, dsl.Logger)
+	}
+	for name, e := range importing.BuiltinEnrichers() {
+		dsl.ImportService.RegisterEnricher(name, e)
+		dsl.PreviewService.RegisterEnricher(name, e)
+	}
+	for name, e := range dsl.Enrichers {
+		dsl.ImportService.RegisterEnricher(name, e)
+		dsl.PreviewService.RegisterEnricher(name, e)
+	}
+	if dsl.ConfiguringService == nil {
+		dsl.ConfiguringService = configuring.NewService(dsl.ChannelRepository, dsl.ImportService)
+	}
+	if dsl.BlockingService == nil {
+		dsl.BlockingService = blocking.NewService(dsl.BlocklistRepository, dsl.JobRepository, dsl.PubSubJobService, dsl.Logger)
+	}
+	if dsl.LinkConfig == nil {
+		dsl.LinkConfig = &linkchecking.Config{Workers: 2, DeadAfter: 3, Interval: 24 * time.Hour}
+	}
+	if dsl.LinkService == nil {
+		dsl.LinkService = linkchecking.NewService(dsl.LinkRepository, dsl.JobRepository, dsl.PubSubJobService, oghttp.DefaultClient, *dsl.LinkConfig, dsl.Logger)
 	}
 	if dsl.PubSubImportService == nil {
 		dsl.PubSubImportService = NewPubSubImportService()
 	}
+	if dsl.ScheduleRunRepository == nil {
+		dsl.ScheduleRunRepository = NewScheduleRunRepository()
+	}
 	if dsl.SchedulingService == nil {
-		dsl.SchedulingService = scheduling.NewService(dsl.ImportRepository, dsl.ChannelRepository, dsl.PubSubImportService, dsl.Logger)
+		dsl.SchedulingService = scheduling.NewService(dsl.ImportRepository, dsl.ChannelRepository, dsl.ScheduleRunRepository, dsl.PubSubImportService, scheduling.ServiceConfig{Workers: 2}, dsl.Logger)
+	}
+	if dsl.ScheduleRepository == nil {
+		dsl.ScheduleRepository = NewScheduleRepository()
+	}
+	if dsl.LeaseRepository == nil {
+		dsl.LeaseRepository = NewLeaseRepository()
+	}
+	dsl.ScheduleRepository.FenceWith(dsl.LeaseRepository, scheduling.LeaderLease)
+	if dsl.ScheduleConfig == nil {
+		dsl.ScheduleConfig = &scheduling.Config{Tick: time.Second, Grace: 5 * time.Minute, MaxCatchUp: 5, DefaultCron: "@daily", CatchUp: "once", LeaseTTL: 3 * time.Second, Holder: "replica-1", StuckAfter: time.Hour}
+	}
+	if dsl.SchedulingDaemon == nil {
+		dsl.SchedulingDaemon = scheduling.NewDaemon(dsl.SchedulingService, dsl.ChannelRepository, dsl.ScheduleRepository, dsl.LeaseRepository, *dsl.ScheduleConfig, dsl.Logger)
+	}
+	if dsl.ImportQueueRepository == nil {
+		dsl.ImportQueueRepository = NewImportQueueRepository()
+	}
+	if dsl.ImportQueue == nil {
+		dsl.ImportQueue = dispatching.NewQueue(dsl.ImportRepository, dsl.ChannelRepository, dsl.ImportQueueRepository)
+	}
+	if dsl.DispatchConfig == nil {
+		dsl.DispatchConfig = &dispatching.Config{Mode: dispatching.ModeQueue, Workers: 2, PollInterval: 10 * time.Millisecond, StaleAfter: time.Hour, Heartbeat: time.Minute}
+	}
+	if dsl.Dispatcher == nil {
+		dsl.Dispatcher = dispatching.NewDispatcher(dsl.ImportQueueRepository, dsl.ImportService, *dsl.DispatchConfig, dsl.Logger)
 	}
 
 	if dsl.HTTPConfig == nil {
@@ -421,7 +895,7 @@ func NewDSL(opts ...DSLOptions) *DSL {
 	}
 
 	if dsl.APIServer == nil {
-		dsl.APIServer = http.APIRootHandler(dsl.ConfiguringService, dsl.ChannelRepository, dsl.ImportRepository, dsl.SchedulingService, *dsl.HTTPConfig, dsl.Logger)
+		dsl.APIServer = http.APIRootHandler(dsl.ConfiguringService, dsl.ChannelRepository, dsl.ImportRepository, dsl.JobRepository, dsl.SchedulingService, dsl.PreviewService, dsl.BlockingService, dsl.BlocklistRepository, dsl.LeaseRepository, dsl.ScheduleRunRepository, *dsl.HTTPConfig, dsl.Logger)
 	}
 
 	if dsl.ImportServer == nil {
@@ -431,8 +905,25 @@ func NewDSL(opts ...DSLOptions) *DSL {
 	return dsl
 }
 
+func (dsl *DSL) initLinkRepository() {
+	if dsl.JobRepository == nil {
+		dsl.JobRepository = NewJobRepository()
+	}
+	if dsl.LinkRepository == nil {
+		dsl.LinkRepository = NewLinkRepository(dsl.JobRepository)
+	}
+}
+
 func (dsl *DSL) defaultConfig() *importing.Config {
 	return &importing.Config{
+		Archive: importing.ConfigArchive{
+			Enabled:   true,
+			Retention: 720 * time.Hour,
+		},
+		Preview: importing.ConfigPreview{
+			MaxPages: 10,
+			Timeout:  5 * time.Second,
+		},
 		Import: struct {
 			Metric  importing.ConfigWorker `envPrefix:"METRIC_"`
 			Job     importing.ConfigWorker `envPrefix:"JOB_"`
@@ -454,6 +945,19 @@ func (dsl *DSL) defaultConfig() *importing.Config {
 	}
 }
 
+func (dsl *DSL) QueuedImports() []*aggregator.QueuedImport {
+	dsl.ImportQueueRepository.m.Lock()
+	defer dsl.ImportQueueRepository.m.Unlock()
+
+	queued := make([]*aggregator.QueuedImport, 0, len(dsl.ImportQueueRepository.Queue))
+	for _, qi := range dsl.ImportQueueRepository.Queue {
+		c := *qi
+		queued = append(queued, &c)
+	}
+
+	return queued
+}
+
 func (dsl *DSL) Channels() []*aggregator.Channel {
 	var channels []*aggregator.Channel
 	for _, ch := range dsl.ChannelRepository.Channels {
@@ -475,6 +979,19 @@ func (dsl *DSL) FirstChannel() *aggregator.Channel {
 	return nil
 }
 
+func (dsl *DSL) BlocklistEntries() []*aggregator.BlocklistEntry {
+	var entries []*aggregator.BlocklistEntry
+	for _, e := range dsl.BlocklistRepository.Entries {
+		entries = append(entries, e)
+	}
+
+	return entries
+}
+
+func (dsl *DSL) BlocklistEntry(id uuid.UUID) *aggregator.BlocklistEntry {
+	return dsl.BlocklistRepository.Entries[id]
+}
+
 func (dsl *DSL) Imports() []*aggregator.Import {
 	var imports []*aggregator.Import
 	for _, i := range dsl.ImportRepository.Imports {
@@ -492,6 +1009,14 @@ func (dsl *DSL) FirstImport() *aggregator.Import {
 	return nil
 }
 
+func (dsl *DSL) ImportCheckpoint(importID uuid.UUID) *aggregator.ImportCheckpoint {
+	return dsl.ImportRepository.Checkpoints[importID]
+}
+
+func (dsl *DSL) StagedJobs(importID uuid.UUID) []*aggregator.Job {
+	return dsl.ImportRepository.StagedJobs[importID]
+}
+
 func (dsl *DSL) PublishedImports() []uuid.UUID {
 	return dsl.PubSubImportService.ImportIDs
 }
@@ -533,6 +1058,10 @@ func (dsl *DSL) Job(id uuid.UUID) *aggregator.Job {
 	return dsl.JobRepository.Jobs[id]
 }
 
+func (dsl *DSL) JobVersions(jobID uuid.UUID) []*aggregator.JobVersion {
+	return dsl.JobRepository.Versions[jobID]
+}
+
 func (dsl *DSL) PublishedJobInformations() []*aggregator.Job {
 	return dsl.PubSubJobService.JobInformations
 }
@@ -561,6 +1090,45 @@ func (dsl *DSL) PublishedJobMissing(id uuid.UUID) *aggregator.Job {
 	return nil
 }
 
+func (dsl *DSL) PublishedJobBlockeds() []*aggregator.Job {
+	return dsl.PubSubJobService.JobBlockeds
+}
+
+func (dsl *DSL) PublishedJobBlocked(id uuid.UUID) *aggregator.Job {
+	for _, j := range dsl.PubSubJobService.JobBlockeds {
+		if j.ID == id {
+			return j
+		}
+	}
+
+	return nil
+}
+
+func (dsl *DSL) PublishedJobHelds() []*aggregator.Job {
+	return dsl.PubSubJobService.JobHelds
+}
+
+func (dsl *DSL) Link(jobID uuid.UUID) *aggregator.Link {
+	return dsl.LinkRepository.Links[jobID]
+}
+
+func (dsl *DSL) ScheduleState(chID uuid.UUID) *aggregator.ScheduleState {
+	return dsl.ScheduleRepository.States[chID]
+}
+
+func (dsl *DSL) ScheduleRuns() []*aggregator.ScheduleRun {
+	runs := make([]*aggregator.ScheduleRun, 0, len(dsl.ScheduleRunRepository.Runs))
+	for _, run := range dsl.ScheduleRunRepository.Runs {
+		runs = append(runs, run)
+	}
+
+	return runs
+}
+
+func (dsl *DSL) Lease(name string) *aggregator.Lease {
+	return dsl.LeaseRepository.Leases[name]
+}
+
 func (dsl *DSL) LogLines() []string {
 	return LogLines(dsl.LogBuffer)
 }
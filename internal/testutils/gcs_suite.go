@@ -0,0 +1,62 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"google.golang.org/api/option"
+)
+
+type GCSSuite struct {
+	suite.Suite
+
+	container testcontainers.Container
+
+	Client *storage.Client
+	Bucket string
+}
+
+func (suite *GCSSuite) SetupSuite() {
+	ctx := context.Background()
+	req := testcontainers.ContainerRequest{
+		Image:        "fsouza/fake-gcs-server:1.52",
+		ExposedPorts: []string{"4443/tcp"},
+		Cmd:          []string{"-scheme", "http", "-port", "4443"},
+		WaitingFor:   wait.ForLog("server started").WithStartupTimeout(10 * time.Second),
+	}
+
+	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	suite.NoError(err)
+	suite.container = c
+
+	host, err := c.Host(ctx)
+	suite.NoError(err)
+
+	port, err := c.MappedPort(ctx, "4443")
+	suite.NoError(err)
+
+	suite.Client, err = storage.NewClient(
+		ctx,
+		option.WithEndpoint(fmt.Sprintf("http://%s:%s/storage/v1/", host, port.Port())),
+		option.WithoutAuthentication(),
+	)
+	suite.NoError(err)
+
+	suite.Bucket = "archive-test"
+	suite.NoError(suite.Client.Bucket(suite.Bucket).Create(ctx, "test-project", nil))
+}
+
+func (suite *GCSSuite) TearDownSuite() {
+	go func() {
+		suite.NoError(suite.Client.Close())
+		suite.NoError(suite.container.Terminate(context.Background()))
+	}()
+}
@@ -0,0 +1,191 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v3"
+)
+
+type ImportQueueRepository struct {
+	Queue map[uuid.UUID]*aggregator.QueuedImport
+	err   error
+	m     sync.Mutex
+}
+
+func NewImportQueueRepository() *ImportQueueRepository {
+	return &ImportQueueRepository{
+		Queue: make(map[uuid.UUID]*aggregator.QueuedImport),
+	}
+}
+
+func (r *ImportQueueRepository) Add(qi *aggregator.QueuedImport) {
+	r.Queue[qi.ImportID] = qi
+}
+
+func (r *ImportQueueRepository) FailWith(err error) {
+	r.err = err
+}
+
+func (r *ImportQueueRepository) Enqueue(_ context.Context, qi *aggregator.QueuedImport) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if _, ok := r.Queue[qi.ImportID]; ok {
+		return nil
+	}
+
+	var channelTime, minQueued, maxAll float64
+	hasQueued := false
+	for _, q := range r.Queue {
+		if q.ChannelID == qi.ChannelID {
+			channelTime = max(channelTime, q.VirtualTime)
+		}
+		if q.Status == aggregator.QueueStatusQueued && (!hasQueued || q.VirtualTime < minQueued) {
+			minQueued = q.VirtualTime
+			hasQueued = true
+		}
+		maxAll = max(maxAll, q.VirtualTime)
+	}
+	systemTime := maxAll
+	if hasQueued {
+		systemTime = minQueued
+	}
+
+	c := *qi
+	c.Status = aggregator.QueueStatusQueued
+	c.VirtualTime = max(channelTime, systemTime) + 1.0/float64(qi.Priority)
+	r.Queue[c.ImportID] = &c
+	return nil
+}
+
+func (r *ImportQueueRepository) Claim(_ context.Context, caps map[aggregator.Integration]int, defaultCap int) (*aggregator.QueuedImport, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	running := make(map[aggregator.Integration]int)
+	queued := make([]*aggregator.QueuedImport, 0, len(r.Queue))
+	for _, q := range r.Queue {
+		if q.Status == aggregator.QueueStatusRunning {
+			running[q.Integration]++
+		} else {
+			queued = append(queued, q)
+		}
+	}
+	slices.SortFunc(queued, func(a, b *aggregator.QueuedImport) int {
+		if a.VirtualTime != b.VirtualTime {
+			if a.VirtualTime < b.VirtualTime {
+				return -1
+			}
+			return 1
+		}
+		return a.EnqueuedAt.Compare(b.EnqueuedAt)
+	})
+
+	for _, q := range queued {
+		limit := caps[q.Integration]
+		if limit == 0 {
+			limit = defaultCap
+		}
+		if limit > 0 && running[q.Integration] >= limit {
+			continue
+		}
+
+		q.Status = aggregator.QueueStatusRunning
+		q.StartedAt = null.TimeFrom(time.Now())
+		q.HeartbeatAt = q.StartedAt
+		q.ClaimToken = uuid.NullUUID{UUID: uuid.New(), Valid: true}
+		c := *q
+		return &c, nil
+	}
+
+	return nil, infrastructure.ErrQueueEmpty
+}
+
+func (r *ImportQueueRepository) Complete(_ context.Context, importID, claimToken uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.checkClaim(importID, claimToken); err != nil {
+		return err
+	}
+	delete(r.Queue, importID)
+	return nil
+}
+
+func (r *ImportQueueRepository) Requeue(_ context.Context, importID, claimToken uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.checkClaim(importID, claimToken); err != nil {
+		return err
+	}
+	q := r.Queue[importID]
+	q.Status = aggregator.QueueStatusQueued
+	q.StartedAt = null.NewTime(time.Time{}, false)
+	q.HeartbeatAt = null.NewTime(time.Time{}, false)
+	q.ClaimToken = uuid.NullUUID{}
+	return nil
+}
+
+func (r *ImportQueueRepository) Heartbeat(_ context.Context, importID, claimToken uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.checkClaim(importID, claimToken); err != nil {
+		return err
+	}
+	r.Queue[importID].HeartbeatAt = null.TimeFrom(time.Now())
+	return nil
+}
+
+func (r *ImportQueueRepository) checkClaim(importID, claimToken uuid.UUID) error {
+	q, ok := r.Queue[importID]
+	if !ok || q.Status != aggregator.QueueStatusRunning || !q.ClaimToken.Valid || q.ClaimToken.UUID != claimToken {
+		return fmt.Errorf("failed to touch queued import %s with claim token %s: %w", importID, claimToken, infrastructure.ErrStaleFence)
+	}
+	return nil
+}
+
+func (r *ImportQueueRepository) RequeueStale(_ context.Context, heartbeatBefore time.Time) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	n := 0
+	for _, q := range r.Queue {
+		if q.Status == aggregator.QueueStatusRunning && q.HeartbeatAt.Time.Before(heartbeatBefore) {
+			q.Status = aggregator.QueueStatusQueued
+			q.StartedAt = null.NewTime(time.Time{}, false)
+			q.HeartbeatAt = null.NewTime(time.Time{}, false)
+			q.ClaimToken = uuid.NullUUID{}
+			n++
+		}
+	}
+	return n, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
@@ -11,14 +12,20 @@ import (
 )
 
 type ImportRepository struct {
-	Imports map[uuid.UUID]*aggregator.Import
-	err     error
-	m       sync.Mutex
+	Imports     map[uuid.UUID]*aggregator.Import
+	Checkpoints map[uuid.UUID]*aggregator.ImportCheckpoint
+	StagedJobs  map[uuid.UUID][]*aggregator.Job
+	channelErrs map[uuid.UUID]error
+	err         error
+	m           sync.Mutex
 }
 
 func NewImportRepository() *ImportRepository {
 	return &ImportRepository{
-		Imports: make(map[uuid.UUID]*aggregator.Import),
+		Imports:     make(map[uuid.UUID]*aggregator.Import),
+		Checkpoints: make(map[uuid.UUID]*aggregator.ImportCheckpoint),
+		StagedJobs:  make(map[uuid.UUID][]*aggregator.Job),
+		channelErrs: make(map[uuid.UUID]error),
 	}
 }
 
@@ -58,16 +65,29 @@ func (r *ImportRepository) AddImportMetric(importID uuid.UUID, m *aggregator.Imp
 	i.Metrics = append(i.Metrics, m)
 }
 
+func (r *ImportRepository) AddCheckpoint(cp *aggregator.ImportCheckpoint, staged ...*aggregator.Job) {
+	r.Checkpoints[cp.ImportID] = cp
+	r.StagedJobs[cp.ImportID] = append(r.StagedJobs[cp.ImportID], staged...)
+}
+
 func (r *ImportRepository) FailWith(err error) {
 	r.err = err
 }
 
+func (r *ImportRepository) FailForChannel(chID uuid.UUID, err error) {
+	r.channelErrs[chID] = err
+}
+
 func (r *ImportRepository) SaveImport(_ context.Context, i *aggregator.Import) error {
 	if r.err != nil {
 		return r.err
 	}
+	if err, ok := r.channelErrs[i.ChannelID]; ok {
+		return err
+	}
 	r.m.Lock()
 	defer r.m.Unlock()
+	i.UpdatedAt = time.Now()
 	old, ok := r.Imports[i.ID]
 
 	if ok {
@@ -93,6 +113,7 @@ func (r *ImportRepository) FindImport(_ context.Context, id uuid.UUID) (*aggrega
 	if !ok {
 		return nil, infrastructure.ErrImportNotFound
 	}
+	i.Checkpoint = r.Checkpoints[id]
 
 	return i, nil
 }
@@ -114,6 +135,42 @@ func (r *ImportRepository) GetImports(_ context.Context) ([]*aggregator.Import,
 	return ii, nil
 }
 
+func (r *ImportRepository) HasRunningImport(_ context.Context, channelID uuid.UUID, since time.Time) (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	for _, i := range r.Imports {
+		if i.ChannelID != channelID || i.UpdatedAt.Before(since) {
+			continue
+		}
+		switch i.Status {
+		case aggregator.ImportStatusPending, aggregator.ImportStatusFetching, aggregator.ImportStatusProcessing, aggregator.ImportStatusPublishing:
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *ImportRepository) HasCompletedImportSince(_ context.Context, channelID uuid.UUID, since time.Time) (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	for _, i := range r.Imports {
+		if i.ChannelID == channelID && i.StartedAt.After(since) && i.Status == aggregator.ImportStatusCompleted {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (r *ImportRepository) SaveImportMetric(_ context.Context, importID uuid.UUID, m *aggregator.ImportMetric) error {
 	if r.err != nil {
 		return r.err
@@ -136,3 +193,51 @@ func (r *ImportRepository) SaveImportMetric(_ context.Context, importID uuid.UUI
 
 	return nil
 }
+
+func (r *ImportRepository) SaveImportCheckpoint(_ context.Context, cp *aggregator.ImportCheckpoint, jobs []*aggregator.Job) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.Checkpoints[cp.ImportID] = cp
+	r.StagedJobs[cp.ImportID] = append(r.StagedJobs[cp.ImportID], jobs...)
+
+	return nil
+}
+
+func (r *ImportRepository) FindImportCheckpoint(_ context.Context, importID uuid.UUID) (*aggregator.ImportCheckpoint, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	cp, ok := r.Checkpoints[importID]
+	if !ok {
+		return nil, infrastructure.ErrImportCheckpointNotFound
+	}
+
+	return cp, nil
+}
+
+func (r *ImportRepository) GetStagedJobs(_ context.Context, importID uuid.UUID) ([]*aggregator.Job, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return slices.Clone(r.StagedJobs[importID]), nil
+}
+
+func (r *ImportRepository) ClearStagedJobs(_ context.Context, importID uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	delete(r.StagedJobs, importID)
+
+	return nil
+}
@@ -2,21 +2,26 @@ package testutils
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"sync"
 
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
 	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
 	"github.com/google/uuid"
 )
 
 type JobRepository struct {
-	Jobs map[uuid.UUID]*aggregator.Job
-	err  error
-	m    sync.Mutex
+	Jobs     map[uuid.UUID]*aggregator.Job
+	Versions map[uuid.UUID][]*aggregator.JobVersion
+	err      error
+	m        sync.Mutex
 }
 
 func NewJobRepository() *JobRepository {
 	return &JobRepository{
-		Jobs: make(map[uuid.UUID]*aggregator.Job),
+		Jobs:     make(map[uuid.UUID]*aggregator.Job),
+		Versions: make(map[uuid.UUID][]*aggregator.JobVersion),
 	}
 }
 
@@ -36,6 +41,10 @@ func (r *JobRepository) FailWith(err error) {
 	r.err = err
 }
 
+func (r *JobRepository) AddVersion(v *aggregator.JobVersion) {
+	r.Versions[v.JobID] = append(r.Versions[v.JobID], v)
+}
+
 func (r *JobRepository) Save(_ context.Context, j *aggregator.Job) error {
 	if r.err != nil {
 		return r.err
@@ -62,6 +71,21 @@ func (r *JobRepository) GetByChannelID(_ context.Context, chID uuid.UUID) ([]*ag
 	return jobs, nil
 }
 
+func (r *JobRepository) GetActive(_ context.Context) ([]*aggregator.Job, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var jobs []*aggregator.Job
+	for _, j := range r.Jobs {
+		if j.Status == aggregator.JobStatusActive {
+			jobs = append(jobs, j)
+		}
+	}
+
+	return jobs, nil
+}
+
 func (r *JobRepository) GetActiveUnpublishedByChannelID(_ context.Context, chID uuid.UUID) ([]*aggregator.Job, error) {
 	if r.err != nil {
 		return nil, r.err
@@ -69,10 +93,84 @@ func (r *JobRepository) GetActiveUnpublishedByChannelID(_ context.Context, chID
 
 	var jobs []*aggregator.Job
 	for _, j := range r.Jobs {
-		if j.ChannelID == chID && j.PublishStatus == aggregator.JobPublishStatusUnpublished && j.Status == aggregator.JobStatusActive {
+		if j.ChannelID == chID && j.PublishStatus != aggregator.JobPublishStatusPublished && j.Status == aggregator.JobStatusActive {
 			jobs = append(jobs, j)
 		}
 	}
 
 	return jobs, nil
 }
+
+func (r *JobRepository) GetJobs(_ context.Context, f *aggregator.JobFilter) ([]*aggregator.Job, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	jobs := make([]*aggregator.Job, 0)
+	for _, j := range r.Jobs {
+		switch {
+		case f.ChannelID.Valid && j.ChannelID != f.ChannelID.UUID:
+		case f.Status != nil && j.Status != *f.Status:
+		case f.CountryCode != "" && j.CountryCode != f.CountryCode:
+		case f.Region != "" && !strings.EqualFold(j.Region, f.Region):
+		case f.City != "" && !strings.EqualFold(j.City, f.City):
+		case f.RemoteScope != nil && j.RemoteScope != *f.RemoteScope:
+		case f.Language != "" && j.Language != f.Language:
+		case f.Seniority != nil && j.Seniority != *f.Seniority:
+		case f.EmploymentType != nil && j.EmploymentType != *f.EmploymentType:
+		case f.MinQuality > 0 && (j.Quality == nil || j.Quality.Score < f.MinQuality):
+		case !hasSkills(j, f.Skills):
+		default:
+			jobs = append(jobs, j)
+		}
+	}
+
+	slices.SortFunc(jobs, func(a, b *aggregator.Job) int {
+		return b.PostedAt.Compare(a.PostedAt)
+	})
+
+	return jobs[:min(len(jobs), f.Limit)], nil
+}
+
+func hasSkills(j *aggregator.Job, skills []string) bool {
+	for _, sk := range skills {
+		if !slices.ContainsFunc(j.Skills.Names(), func(name string) bool { return strings.EqualFold(name, sk) }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *JobRepository) Find(_ context.Context, id uuid.UUID) (*aggregator.Job, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	j, ok := r.Jobs[id]
+	if !ok {
+		return nil, infrastructure.ErrJobNotFound
+	}
+
+	return j, nil
+}
+
+func (r *JobRepository) SaveVersion(_ context.Context, v *aggregator.JobVersion) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	v.Version = len(r.Versions[v.JobID]) + 1
+	r.Versions[v.JobID] = append(r.Versions[v.JobID], v)
+	r.m.Unlock()
+	return nil
+}
+
+func (r *JobRepository) GetVersions(_ context.Context, jobID uuid.UUID) ([]*aggregator.JobVersion, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.Versions[jobID], nil
+}
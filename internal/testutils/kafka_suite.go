@@ -0,0 +1,46 @@
+package testutils
+
+import (
+	"context"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/kafka"
+	"github.com/stretchr/testify/suite"
+	tckafka "github.com/testcontainers/testcontainers-go/modules/kafka"
+)
+
+type KafkaSuite struct {
+	suite.Suite
+
+	container *tckafka.KafkaContainer
+
+	Config kafka.Config
+}
+
+func (suite *KafkaSuite) SetupSuite() {
+	ctx := context.Background()
+
+	c, err := tckafka.Run(ctx, "confluentinc/confluent-local:7.5.0", tckafka.WithClusterID("test-cluster"))
+	suite.NoError(err)
+
+	brokers, err := c.Brokers(ctx)
+	suite.NoError(err)
+
+	suite.container = c
+	suite.Config = kafka.Config{Brokers: brokers, BatchTimeout: 10 * time.Millisecond}
+}
+
+// Broker creates a new broker that is closed with the test
+func (suite *KafkaSuite) Broker(cfg broker.SubscriberConfig) *kafka.Broker {
+	b := kafka.NewBroker(suite.Config, cfg)
+	suite.T().Cleanup(func() {
+		suite.NoError(b.Close())
+	})
+
+	return b
+}
+
+func (suite *KafkaSuite) TearDownSuite() {
+	suite.NoError(suite.container.Terminate(context.Background()))
+}
@@ -0,0 +1,79 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+)
+
+type LeaseRepository struct {
+	Leases map[string]*aggregator.Lease
+	err    error
+}
+
+func NewLeaseRepository() *LeaseRepository {
+	return &LeaseRepository{
+		Leases: make(map[string]*aggregator.Lease),
+	}
+}
+
+func (r *LeaseRepository) Add(l *aggregator.Lease) {
+	r.Leases[l.Name] = l
+}
+
+func (r *LeaseRepository) FailWith(err error) {
+	r.err = err
+}
+
+func (r *LeaseRepository) Acquire(_ context.Context, name, holder string, ttl time.Duration) (*aggregator.Lease, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	now := time.Now()
+	l, ok := r.Leases[name]
+	switch {
+	case !ok:
+		l = &aggregator.Lease{Name: name, Holder: holder, Token: 1, AcquiredAt: now}
+	case l.Holder == holder && !l.IsExpired(now):
+	case l.IsExpired(now):
+		l = &aggregator.Lease{Name: name, Holder: holder, Token: l.Token + 1, AcquiredAt: now}
+	default:
+		return nil, fmt.Errorf("failed to acquire lease %s for %s: %w", name, holder, infrastructure.ErrLeaseHeld)
+	}
+	l.RenewedAt = now
+	l.ExpiresAt = now.Add(ttl)
+	r.Leases[name] = l
+
+	c := *l
+	return &c, nil
+}
+
+func (r *LeaseRepository) Release(_ context.Context, l *aggregator.Lease) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if cur, ok := r.Leases[l.Name]; ok && cur.Holder == l.Holder && cur.Token == l.Token {
+		cur.ExpiresAt = time.Now()
+	}
+
+	return nil
+}
+
+func (r *LeaseRepository) Find(_ context.Context, name string) (*aggregator.Lease, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	l, ok := r.Leases[name]
+	if !ok {
+		return nil, fmt.Errorf("failed to find lease %s: %w", name, infrastructure.ErrLeaseNotFound)
+	}
+
+	c := *l
+	return &c, nil
+}
@@ -0,0 +1,77 @@
+package testutils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+type LinkRepository struct {
+	Links map[uuid.UUID]*aggregator.Link
+	jr    *JobRepository
+	err   error
+	m     sync.Mutex
+}
+
+func NewLinkRepository(jr *JobRepository) *LinkRepository {
+	return &LinkRepository{
+		Links: make(map[uuid.UUID]*aggregator.Link),
+		jr:    jr,
+	}
+}
+
+func (r *LinkRepository) Add(l *aggregator.Link) {
+	r.Links[l.JobID] = l
+}
+
+func (r *LinkRepository) FailWith(err error) {
+	r.err = err
+}
+
+func (r *LinkRepository) Save(_ context.Context, l *aggregator.Link) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	r.Links[l.JobID] = l
+	r.m.Unlock()
+	return nil
+}
+
+func (r *LinkRepository) GetActive(_ context.Context) ([]*aggregator.Link, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(j *aggregator.Job, _ *aggregator.Link) bool {
+		return j.Status == aggregator.JobStatusActive
+	}), nil
+}
+
+func (r *LinkRepository) GetDeadByChannelID(_ context.Context, chID uuid.UUID) ([]*aggregator.Link, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(j *aggregator.Job, l *aggregator.Link) bool {
+		return j.ChannelID == chID && l.Status == aggregator.LinkStatusDead
+	}), nil
+}
+
+func (r *LinkRepository) filter(fn func(j *aggregator.Job, l *aggregator.Link) bool) []*aggregator.Link {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	var links []*aggregator.Link
+	for _, l := range r.Links {
+		j, ok := r.jr.Jobs[l.JobID]
+		if ok && fn(j, l) {
+			links = append(links, l)
+		}
+	}
+
+	return links
+}
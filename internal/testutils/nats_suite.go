@@ -0,0 +1,47 @@
+package testutils
+
+import (
+	"context"
+	"time"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/broker/nats"
+	"github.com/stretchr/testify/suite"
+	tcnats "github.com/testcontainers/testcontainers-go/modules/nats"
+)
+
+type NATSSuite struct {
+	suite.Suite
+
+	container *tcnats.NATSContainer
+
+	Config nats.Config
+}
+
+func (suite *NATSSuite) SetupSuite() {
+	ctx := context.Background()
+
+	c, err := tcnats.Run(ctx, "nats:2.10")
+	suite.NoError(err)
+
+	url, err := c.ConnectionString(ctx)
+	suite.NoError(err)
+
+	suite.container = c
+	suite.Config = nats.Config{URL: url, AckWait: time.Second, MaxDeliver: 5}
+}
+
+// Broker connects a new broker that is closed with the test
+func (suite *NATSSuite) Broker(cfg broker.SubscriberConfig) *nats.Broker {
+	b, err := nats.Connect(suite.Config, cfg)
+	suite.NoError(err)
+	suite.T().Cleanup(func() {
+		suite.NoError(b.Close())
+	})
+
+	return b
+}
+
+func (suite *NATSSuite) TearDownSuite() {
+	suite.NoError(suite.container.Terminate(context.Background()))
+}
@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"context"
+	"sync"
 
 	"github.com/google/uuid"
 )
@@ -9,6 +10,7 @@ import (
 type PubSubImportService struct {
 	ImportIDs []uuid.UUID
 	err       error
+	m         sync.Mutex
 }
 
 func NewPubSubImportService() *PubSubImportService {
@@ -24,6 +26,8 @@ func (p *PubSubImportService) PublishImportCommand(_ context.Context, importID u
 		return p.err
 	}
 
+	p.m.Lock()
+	defer p.m.Unlock()
 	p.ImportIDs = append(p.ImportIDs, importID)
 	return nil
 }
@@ -10,6 +10,8 @@ import (
 type PubSubJobService struct {
 	JobInformations []*aggregator.Job
 	JobMissings     []*aggregator.Job
+	JobBlockeds     []*aggregator.Job
+	JobHelds        []*aggregator.Job
 	err             error
 	m               sync.Mutex
 }
@@ -43,3 +45,25 @@ func (p *PubSubJobService) PublishJobMissing(_ context.Context, job *aggregator.
 	p.JobMissings = append(p.JobMissings, job)
 	return nil
 }
+
+func (p *PubSubJobService) PublishJobBlocked(_ context.Context, job *aggregator.Job) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.JobBlockeds = append(p.JobBlockeds, job)
+	return nil
+}
+
+func (p *PubSubJobService) PublishJobHeld(_ context.Context, job *aggregator.Job) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.JobHelds = append(p.JobHelds, job)
+	return nil
+}
@@ -0,0 +1,75 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+type ScheduleRepository struct {
+	States map[uuid.UUID]*aggregator.ScheduleState
+	leases *LeaseRepository
+	lease  string
+	err    error
+}
+
+func NewScheduleRepository() *ScheduleRepository {
+	return &ScheduleRepository{
+		States: make(map[uuid.UUID]*aggregator.ScheduleState),
+	}
+}
+
+func (r *ScheduleRepository) Add(s *aggregator.ScheduleState) {
+	r.States[s.ChannelID] = s
+}
+
+// FenceWith checks writes against the token of the lease, as long as the lease was acquired
+func (r *ScheduleRepository) FenceWith(leases *LeaseRepository, lease string) {
+	r.leases = leases
+	r.lease = lease
+}
+
+func (r *ScheduleRepository) FailWith(err error) {
+	r.err = err
+}
+
+func (r *ScheduleRepository) Save(_ context.Context, s *aggregator.ScheduleState) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.leases != nil {
+		if l, ok := r.leases.Leases[r.lease]; ok && l.Token != s.Fence {
+			return fmt.Errorf("failed to save schedule of channel %s with fencing token %d: %w", s.ChannelID, s.Fence, infrastructure.ErrStaleFence)
+		}
+	}
+	if cur, ok := r.States[s.ChannelID]; ok && cur.Fence > s.Fence {
+		return fmt.Errorf("failed to save schedule of channel %s with fencing token %d: %w", s.ChannelID, s.Fence, infrastructure.ErrStaleFence)
+	}
+
+	c := *s
+	r.States[s.ChannelID] = &c
+	return nil
+}
+
+func (r *ScheduleRepository) All(_ context.Context) ([]*aggregator.ScheduleState, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	states := make([]*aggregator.ScheduleState, 0, len(r.States))
+	for _, s := range r.States {
+		c := *s
+		states = append(states, &c)
+	}
+
+	slices.SortFunc(states, func(a, b *aggregator.ScheduleState) int {
+		return a.NextRunAt.Compare(b.NextRunAt)
+	})
+
+	return states, nil
+}
@@ -0,0 +1,75 @@
+package testutils
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure"
+	"github.com/aviseu/jobs-backoffice/internal/app/infrastructure/aggregator"
+	"github.com/google/uuid"
+)
+
+type ScheduleRunRepository struct {
+	Runs map[uuid.UUID]*aggregator.ScheduleRun
+	err  error
+	m    sync.Mutex
+}
+
+func NewScheduleRunRepository() *ScheduleRunRepository {
+	return &ScheduleRunRepository{
+		Runs: make(map[uuid.UUID]*aggregator.ScheduleRun),
+	}
+}
+
+func (r *ScheduleRunRepository) Add(run *aggregator.ScheduleRun) {
+	r.Runs[run.ID] = run
+}
+
+func (r *ScheduleRunRepository) FailWith(err error) {
+	r.err = err
+}
+
+func (r *ScheduleRunRepository) Save(_ context.Context, run *aggregator.ScheduleRun) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.Runs[run.ID] = run
+	return nil
+}
+
+func (r *ScheduleRunRepository) GetRecent(_ context.Context, limit int) ([]*aggregator.ScheduleRun, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	runs := make([]*aggregator.ScheduleRun, 0, len(r.Runs))
+	for _, run := range r.Runs {
+		runs = append(runs, run)
+	}
+
+	slices.SortFunc(runs, func(a, b *aggregator.ScheduleRun) int {
+		return b.StartedAt.Compare(a.StartedAt)
+	})
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+
+	return runs, nil
+}
+
+func (r *ScheduleRunRepository) Find(_ context.Context, id uuid.UUID) (*aggregator.ScheduleRun, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	run, ok := r.Runs[id]
+	if !ok {
+		return nil, infrastructure.ErrScheduleRunNotFound
+	}
+
+	return run, nil
+}
@@ -27,6 +27,13 @@ module "jobsTopic" {
   topic_name = "jobs"
 }
 
+resource "google_storage_bucket" "archive" {
+  name                        = "aviseu-jobs-archive"
+  project                     = "aviseu-jobs"
+  location                    = "europe-west4"
+  uniform_bucket_level_access = true
+}
+
 module "frontend" {
   service_name            = "frontend"
   source                  = "github.com/aviseu/terraform//modules/gcp_cloud_run_service"
@@ -55,12 +62,14 @@ module "api" {
   is_public               = true
 
   environment_variables = {
-    "API_ADDR"               = "0.0.0.0:80"
-    "API_CORS"               = "0"
-    "DB_MAXOPENCONNS"        = "20"
-    "DB_MAXIDLECONNS"        = "20"
-    "PUBSUB_PROJECT_ID"      = "aviseu-jobs"
-    "PUBSUB_IMPORT_TOPIC_ID" = module.importsTopic.topic_name
+    "API_ADDR"                 = "0.0.0.0:80"
+    "API_CORS"                 = "0"
+    "DB_MAXOPENCONNS"          = "20"
+    "DB_MAXIDLECONNS"          = "20"
+    "BROKER_PUBSUB_PROJECT_ID" = "aviseu-jobs"
+    "BROKER_IMPORT_TOPIC"      = module.importsTopic.topic_name
+    "BROKER_JOB_TOPIC"         = "jobs"
+    "GATEWAY_PREVIEW_TIMEOUT"  = "4s"
   }
 
   sql_instances = length(module.database.connection_name) > 0 ? [
@@ -93,18 +102,22 @@ module "import" {
   is_public               = false
 
   environment_variables = {
-    "IMPORT_ADDR"                        = "0.0.0.0:80"
-    "DB_MAXOPENCONNS"                    = "20"
-    "DB_MAXIDLECONNS"                    = "20"
-    "PUBSUB_PROJECT_ID"                  = "aviseu-jobs"
-    "PUBSUB_JOB_TOPIC_ID"                = "jobs"
-    "IMPORT_MAX_CONNECTIONS"             = "1"
-    "GATEWAY_IMPORT_METRIC_BUFFER_SIZE"  = "10"
-    "GATEWAY_IMPORT_METRIC_WORKERS"      = "2"
-    "GATEWAY_IMPORT_PUBLISH_BUFFER_SIZE" = "10"
-    "GATEWAY_IMPORT_PUBLISH_WORKERS"     = "2"
-    "GATEWAY_IMPORT_JOB_BUFFER_SIZE"     = "10"
-    "GATEWAY_IMPORT_JOB_WORKERS"         = "2"
+    "IMPORT_ADDR"                          = "0.0.0.0:80"
+    "DB_MAXOPENCONNS"                      = "20"
+    "DB_MAXIDLECONNS"                      = "20"
+    "BROKER_PUBSUB_PROJECT_ID"             = "aviseu-jobs"
+    "BROKER_JOB_TOPIC"                     = "jobs"
+    "IMPORT_MAX_CONNECTIONS"               = "1"
+    "GATEWAY_IMPORT_METRIC_BUFFER_SIZE"    = "10"
+    "GATEWAY_IMPORT_METRIC_WORKERS"        = "2"
+    "GATEWAY_IMPORT_PUBLISH_BUFFER_SIZE"   = "10"
+    "GATEWAY_IMPORT_PUBLISH_WORKERS"       = "2"
+    "GATEWAY_IMPORT_JOB_BUFFER_SIZE"       = "10"
+    "GATEWAY_IMPORT_JOB_WORKERS"           = "2"
+    "GATEWAY_ARCHIVE_RETENTION"            = "168h"
+    "GATEWAY_GUARD_MAX_MISSING_PERCENTAGE" = "50"
+    "ARCHIVE_KIND"                         = "gcs"
+    "ARCHIVE_GCS_BUCKET"                   = google_storage_bucket.archive.name
   }
 
   sql_instances = length(module.database.connection_name) > 0 ? [
@@ -119,7 +132,8 @@ module "import" {
     "roles/cloudsql.client",
     "roles/secretmanager.secretAccessor",
     "roles/run.invoker",
-    "roles/pubsub.publisher"
+    "roles/pubsub.publisher",
+    "roles/storage.objectAdmin"
   ]
 }
 
@@ -146,8 +160,43 @@ module "schedule" {
   task_timeout_seconds = "600s"
 
   environment_variables = {
-    "PUBSUB_PROJECT_ID"      = "aviseu-jobs"
-    "PUBSUB_IMPORT_TOPIC_ID" = module.importsTopic.topic_name
+    "BROKER_PUBSUB_PROJECT_ID" = "aviseu-jobs"
+    "BROKER_IMPORT_TOPIC"      = module.importsTopic.topic_name
+  }
+
+  sql_instances = length(module.database.connection_name) > 0 ? [
+    module.database.connection_name
+  ] : []
+
+  secrets = {
+    "DB_DSN" : module.dsn.secret_id
+  }
+
+  service_account_roles = [
+    "roles/cloudsql.client",
+    "roles/pubsub.publisher",
+    "roles/secretmanager.secretAccessor"
+  ]
+}
+
+module "linkcheck" {
+  depends_on = [module.database, module.dsn]
+
+  job_name             = "linkcheck"
+  source               = "github.com/aviseu/terraform//modules/gcp_cloud_run_job"
+  project_id           = "aviseu-jobs"
+  region               = "europe-west4"
+  trigger_region       = "europe-west3"
+  container_image      = "europe-west4-docker.pkg.dev/aviseu-jobs/jobs/jobs-backoffice-linkcheck"
+  container_image_tag  = var.image_tag
+  task_timeout_seconds = "3600s"
+
+  environment_variables = {
+    "BROKER_PUBSUB_PROJECT_ID" = "aviseu-jobs"
+    "BROKER_JOB_TOPIC"         = "jobs"
+    "LINKCHECK_WORKERS"        = "10"
+    "LINKCHECK_HOST_INTERVAL"  = "1s"
+    "LINKCHECK_DEAD_AFTER"     = "3"
   }
 
   sql_instances = length(module.database.connection_name) > 0 ? [
@@ -164,3 +213,38 @@ module "schedule" {
     "roles/secretmanager.secretAccessor"
   ]
 }
+
+module "expire" {
+  depends_on = [module.database, module.dsn]
+
+  job_name             = "expire"
+  source               = "github.com/aviseu/terraform//modules/gcp_cloud_run_job"
+  project_id           = "aviseu-jobs"
+  region               = "europe-west4"
+  trigger_region       = "europe-west3"
+  container_image      = "europe-west4-docker.pkg.dev/aviseu-jobs/jobs/jobs-backoffice-expire"
+  container_image_tag  = var.image_tag
+  task_timeout_seconds = "600s"
+
+  environment_variables = {
+    "BROKER_PUBSUB_PROJECT_ID" = "aviseu-jobs"
+    "BROKER_JOB_TOPIC"         = "jobs"
+    "ARCHIVE_KIND"             = "gcs"
+    "ARCHIVE_GCS_BUCKET"       = google_storage_bucket.archive.name
+  }
+
+  sql_instances = length(module.database.connection_name) > 0 ? [
+    module.database.connection_name
+  ] : []
+
+  secrets = {
+    "DB_DSN" : module.dsn.secret_id
+  }
+
+  service_account_roles = [
+    "roles/cloudsql.client",
+    "roles/pubsub.publisher",
+    "roles/secretmanager.secretAccessor",
+    "roles/storage.objectAdmin"
+  ]
+}